@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/IGLOU-EU/go-wildcard/v2"
@@ -19,6 +20,9 @@ var TestSet = []struct {
 	{"These aren't the wildcard you're looking for", "These aren't the wildcard you're looking for"},
 	{"Th.e * the wildcard you?re looking fo?", "These aren't the wildcard you're looking for"},
 	{"*🤷🏾‍♂️*", "T🥵🤷🏾‍♂️🥓"},
+	{"These aren't the * you?re looking for", "These aren't the wildcard you're looking for"},
+	{"*wildcard?*", "These aren't the wildcard you're looking for"},
+	{"*you?re*", "These aren't the wildcard you're looking for"},
 }
 
 func BenchmarkRegex(b *testing.B) {
@@ -93,3 +97,124 @@ func BenchmarkMatchFromByte(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkMatchFold(b *testing.B) {
+	for i, t := range TestSet {
+		b.Run(fmt.Sprint(i), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				wildcard.MatchFold(t.pattern, t.input)
+			}
+		})
+	}
+}
+
+func BenchmarkMatchByGrapheme(b *testing.B) {
+	for i, t := range TestSet {
+		b.Run(fmt.Sprint(i), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				wildcard.MatchByGrapheme(t.pattern, t.input)
+			}
+		})
+	}
+}
+
+func BenchmarkFind(b *testing.B) {
+	for i, t := range TestSet {
+		b.Run(fmt.Sprint(i), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				wildcard.Find(t.pattern, t.input)
+			}
+		})
+	}
+}
+
+// BenchmarkPattern runs the TestSet with compiled patterns, which must be
+// at least as fast as the package level Match, MatchFromByte and MatchByRune.
+// MatchByRune compares an exact match as strings, so on a literal pattern
+// MatchRunes only gets close to it, comparing four bytes for each rune.
+func BenchmarkPattern(b *testing.B) {
+	for i, t := range TestSet {
+		p := wildcard.MustCompile(t.pattern)
+		input := []byte(t.input)
+		runes := []rune(t.input)
+
+		b.Run(fmt.Sprintf("Match/%d", i), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.Match(t.input)
+			}
+		})
+		b.Run(fmt.Sprintf("MatchBytes/%d", i), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.MatchBytes(input)
+			}
+		})
+		b.Run(fmt.Sprintf("MatchRunes/%d", i), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.MatchRunes(runes)
+			}
+		})
+	}
+}
+
+// aclPatterns mixes patterns indexed by prefix, suffix and inner literal.
+func aclPatterns(n int) []string {
+	patterns := make([]string, 0, n)
+	for i := 0; len(patterns) < n; i++ {
+		patterns = append(patterns,
+			fmt.Sprintf("tenants/t%d/buckets/*", i),
+			fmt.Sprintf("*/objects/k%d/*", i),
+			fmt.Sprintf("*user%d*", i),
+		)
+	}
+
+	return patterns[:n]
+}
+
+func BenchmarkPatternSet(b *testing.B) {
+	const key = "tenants/t42/buckets/photos/objects/k4242/v1.json"
+	patterns := aclPatterns(5000)
+
+	b.Run("Loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, pattern := range patterns {
+				wildcard.Match(pattern, key)
+			}
+		}
+	})
+
+	b.Run("Set", func(b *testing.B) {
+		set := wildcard.MustCompileSet(patterns...)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			set.Match(key)
+		}
+	})
+}
+
+// AdversarialSet holds patterns failing on a long input after trying many ways to match,
+// which take an exponential time to a backtracking matcher.
+// The time to match must only grow linearly with the input.
+var AdversarialSet = []struct {
+	name    string
+	pattern string
+	input   string
+}{
+	{"Stars", "*a*a*a*a*b", "a"},
+	{"Erotemes", strings.Repeat("?a", 16) + "b", "a"},
+	{"Groups", strings.Repeat("{a,aa}", 16) + "b", "a"},
+	{"StarGroups", strings.Repeat("{*,a}", 16) + "b", "a"},
+	{"Globstars", "**/a/**/a/**/a/**/b", "a/"},
+}
+
+func BenchmarkMatchAdversarial(b *testing.B) {
+	for _, t := range AdversarialSet {
+		for _, n := range []int{1000, 10000} {
+			input := strings.Repeat(t.input, n/len(t.input))
+			b.Run(fmt.Sprintf("%s/%d", t.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					wildcard.MatchPath(t.pattern, input)
+				}
+			})
+		}
+	}
+}
@@ -23,6 +23,10 @@ type BuildArgs struct {
 	COMPARISON_DOT      string
 	COMPARISON_QUESTION string
 	COMPARISON_STAR     string
+	COMPARISON_ESCAPE   string
+	COMPARISON_CLASS    string
+	COMPARISON_BRACE    string
+	CLUSTER_MAX         string
 	ARG_TYPE            string
 	CLUSTER_TYPE        string
 }
@@ -61,24 +65,36 @@ func main() {
 			COMPARISON_DOT:      "'.'",
 			COMPARISON_QUESTION: "'?'",
 			COMPARISON_STAR:     "'*'",
+			COMPARISON_ESCAPE:   "'\\\\'",
+			COMPARISON_CLASS:    "'['",
+			COMPARISON_BRACE:    "'{'",
 			ARG_TYPE:            "string",
 			CLUSTER_TYPE:        "byte",
+			CLUSTER_MAX:         "unicode.MaxASCII",
 		},
 		{
 			FUNC_NAME:           "matchByByte",
 			COMPARISON_DOT:      "'.'",
 			COMPARISON_QUESTION: "'?'",
 			COMPARISON_STAR:     "'*'",
+			COMPARISON_ESCAPE:   "'\\\\'",
+			COMPARISON_CLASS:    "'['",
+			COMPARISON_BRACE:    "'{'",
 			ARG_TYPE:            "[]byte",
 			CLUSTER_TYPE:        "byte",
+			CLUSTER_MAX:         "unicode.MaxASCII",
 		},
 		{
 			FUNC_NAME:           "matchByRunes",
 			COMPARISON_DOT:      "'.'",
 			COMPARISON_QUESTION: "'?'",
 			COMPARISON_STAR:     "'*'",
+			COMPARISON_ESCAPE:   "'\\\\'",
+			COMPARISON_CLASS:    "'['",
+			COMPARISON_BRACE:    "'{'",
 			ARG_TYPE:            "[]rune",
 			CLUSTER_TYPE:        "rune",
+			CLUSTER_MAX:         "unicode.MaxRune",
 		},
 	}
 
@@ -128,6 +144,10 @@ func main() {
 		function = strings.ReplaceAll(function, "__COMPARISON_DOT__", args.COMPARISON_DOT)
 		function = strings.ReplaceAll(function, "__COMPARISON_QUESTION__", args.COMPARISON_QUESTION)
 		function = strings.ReplaceAll(function, "__COMPARISON_STAR__", args.COMPARISON_STAR)
+		function = strings.ReplaceAll(function, "__COMPARISON_ESCAPE__", args.COMPARISON_ESCAPE)
+		function = strings.ReplaceAll(function, "__COMPARISON_CLASS__", args.COMPARISON_CLASS)
+		function = strings.ReplaceAll(function, "__COMPARISON_BRACE__", args.COMPARISON_BRACE)
+		function = strings.ReplaceAll(function, "__CLUSTER_MAX__", args.CLUSTER_MAX)
 		function = strings.ReplaceAll(function, "__ARG_TYPE__", args.ARG_TYPE)
 		function = strings.ReplaceAll(function, "__CLUSTER_TYPE__", args.CLUSTER_TYPE)
 
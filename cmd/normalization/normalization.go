@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const outputFile = "normalization_tables.go"
+
+// ucdVersion is the version of the Unicode Character Database the tables are built from.
+// It is pinned rather than taken from the unicode package, which follows the Go toolchain,
+// so the tables only change when it is updated here.
+const ucdVersion = "17.0.0"
+
+var ucd = flag.String("ucd", "https://www.unicode.org/Public/"+ucdVersion+"/ucd",
+	"URL or directory of the Unicode Character Database "+ucdVersion)
+
+var doNotEdit = []string{
+	"// Code generated by cmd/normalization/normalization.go; DO NOT EDIT.",
+	"// This file was generated using UnicodeData.txt and CompositionExclusions.txt",
+	"// from the Unicode Character Database %s\n",
+}
+
+type character struct {
+	class     int
+	compat    bool
+	decompose []rune
+}
+
+func main() {
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetOutput(os.Stdout)
+	log.Println("Building " + outputFile + " from " + *ucd)
+
+	chars := map[rune]*character{}
+	err := parse("UnicodeData.txt", func(fields []string) {
+		if len(fields) < 6 {
+			return
+		}
+
+		c := &character{}
+		c.class, _ = strconv.Atoi(fields[3])
+		for _, f := range strings.Fields(fields[5]) {
+			if strings.HasPrefix(f, "<") {
+				c.compat = true
+				continue
+			}
+			c.decompose = append(c.decompose, hex(f))
+		}
+
+		if c.class != 0 || len(c.decompose) != 0 {
+			chars[hex(fields[0])] = c
+		}
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	excluded := map[rune]bool{}
+	err = parse("CompositionExclusions.txt", func(fields []string) {
+		excluded[hex(fields[0])] = true
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	class := func(r rune) int {
+		if c, ok := chars[r]; ok {
+			return c.class
+		}
+		return 0
+	}
+
+	var full func(r rune, compat bool) []rune
+	full = func(r rune, compat bool) []rune {
+		c, ok := chars[r]
+		if !ok || len(c.decompose) == 0 || (c.compat && !compat) {
+			return []rune{r}
+		}
+
+		var d []rune
+		for _, r := range c.decompose {
+			d = append(d, full(r, compat)...)
+		}
+		return d
+	}
+
+	var runes []rune
+	for r := range chars {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, strings.Join(doNotEdit, "\n"), ucdVersion)
+	out.WriteString("\npackage wildcard\n\n")
+
+	// Canonical combining classes, as ranges of consecutive runes.
+	out.WriteString("var combiningClasses = []combiningClass{\n")
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		c := class(r)
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[j-1]+1 && class(runes[j]) == c {
+			j++
+		}
+		if c != 0 {
+			fmt.Fprintf(&out, "{0x%04X, 0x%04X, %d},\n", r, runes[j-1], c)
+		}
+		i = j
+	}
+	out.WriteString("}\n\n")
+
+	// Full decompositions, the compatibility table holds every rune whose
+	// compatibility decomposition differs from the rune itself.
+	for _, compat := range []bool{false, true} {
+		name := "canonical"
+		if compat {
+			name = "compatibility"
+		}
+
+		var data []rune
+		var entries bytes.Buffer
+		for _, r := range runes {
+			d := full(r, compat)
+			if len(d) == 1 && d[0] == r {
+				continue
+			}
+
+			fmt.Fprintf(&entries, "{0x%04X, %d, %d},\n", r, len(data), len(data)+len(d))
+			data = append(data, d...)
+		}
+
+		fmt.Fprintf(&out, "var %sDecompositions = []decomposition{\n%s}\n\n", name, entries.String())
+		fmt.Fprintf(&out, "var %sRunes = []rune{", name)
+		for i, r := range data {
+			if i%12 == 0 {
+				out.WriteString("\n")
+			}
+			fmt.Fprintf(&out, "0x%04X, ", r)
+		}
+		out.WriteString("\n}\n\n")
+	}
+
+	// Primary composites, the canonical pairs which are not fully excluded.
+	type pair struct{ a, b, c rune }
+	var pairs []pair
+	for _, r := range runes {
+		c := chars[r]
+		if c.compat || len(c.decompose) != 2 || excluded[r] || c.class != 0 || class(c.decompose[0]) != 0 {
+			continue
+		}
+		pairs = append(pairs, pair{c.decompose[0], c.decompose[1], r})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].a != pairs[j].a {
+			return pairs[i].a < pairs[j].a
+		}
+		return pairs[i].b < pairs[j].b
+	})
+
+	out.WriteString("var compositions = []composition{\n")
+	for _, p := range pairs {
+		fmt.Fprintf(&out, "{0x%04X, 0x%04X, 0x%04X},\n", p.a, p.b, p.c)
+	}
+	out.WriteString("}\n")
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(outputFile, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Output saved in " + outputFile + "\n")
+}
+
+// parse calls fn with the semicolon separated fields of every data line of a UCD file.
+func parse(name string, fn func(fields []string)) error {
+	var r io.Reader
+	if strings.HasPrefix(*ucd, "http") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s: %s", name, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(filepath.Join(*ucd, name))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+
+	return scanner.Err()
+}
+
+func hex(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrBadDialect indicates a dialect has a metacharacter which is not ASCII,
+// is used twice, or is one of the "[]{}," of bracket expressions and groups.
+var ErrBadDialect = errors.New("invalid dialect")
+
+// Dialect chooses the metacharacters of the patterns, where 0 disables one,
+// like Dialect{Star: '*', ExactlyOne: '_', Escape: '\\'} for patterns where
+// '?' and '.' are literals, as in hostnames and versions.
+// The bracket expressions and groups keep their syntax.
+type Dialect struct {
+	// Star matches any sequence of characters, even empty.
+	Star byte
+	// ZeroOrOne matches zero or one character.
+	ZeroOrOne byte
+	// ExactlyOne matches exactly one character.
+	ExactlyOne byte
+	// Escape makes the next character a literal.
+	Escape byte
+}
+
+// DefaultDialect is the dialect of Match and the other functions of the package.
+var DefaultDialect = Dialect{Star: '*', ZeroOrOne: '?', ExactlyOne: '.', Escape: '\\'}
+
+// Match returns true if the pattern of the dialect matches the string s, using byte comparison.
+// It returns false if the dialect is invalid.
+func (d Dialect) Match(pattern, s string) bool {
+	if d == DefaultDialect {
+		return Match(pattern, s)
+	}
+
+	translated, err := d.Translate(pattern)
+	return err == nil && Match(translated, s)
+}
+
+// MatchByRune returns true if the pattern of the dialect matches the string s, using rune comparison.
+// It returns false if the dialect is invalid.
+func (d Dialect) MatchByRune(pattern, s string) bool {
+	if d == DefaultDialect {
+		return MatchByRune(pattern, s)
+	}
+
+	translated, err := d.Translate(pattern)
+	return err == nil && MatchByRune(translated, s)
+}
+
+// Compile translates the pattern of the dialect and compiles it, so it is matched
+// as fast as a pattern of the default dialect.
+func (d Dialect) Compile(pattern string) (*Pattern, error) {
+	translated, err := d.Translate(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return Compile(translated)
+}
+
+// Translate returns the pattern of the dialect written in the default dialect,
+// to be used with the other functions of the package. It returns ErrBadDialect
+// if the dialect is invalid.
+func (d Dialect) Translate(pattern string) (string, error) {
+	if err := d.validate(); err != nil {
+		return "", err
+	}
+	if d == DefaultDialect {
+		return pattern, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(pattern) + 4)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == 0:
+			writeLiteral(&b, c)
+		case c == d.Escape:
+			i++
+			if i == len(pattern) {
+				// A lone escape stays malformed.
+				b.WriteByte('\\')
+				break
+			}
+			if strings.IndexByte(`*?.\[{},`, pattern[i]) != -1 {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(pattern[i])
+		case c == d.Star:
+			b.WriteByte('*')
+		case c == d.ZeroOrOne:
+			b.WriteByte('?')
+		case c == d.ExactlyOne:
+			b.WriteByte('.')
+		case c == '[':
+			// A bracket expression is copied as is, with its own '\' escapes.
+			end, _ := matchByStringClass(pattern, i, 0, false)
+			if end == -1 {
+				b.WriteByte(c)
+				break
+			}
+			b.WriteString(pattern[i : end+1])
+			i = end
+		default:
+			writeLiteral(&b, c)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// validate returns ErrBadDialect if the dialect is invalid.
+func (d Dialect) validate() error {
+	var seen [128]bool
+	for _, c := range [...]byte{d.Star, d.ZeroOrOne, d.ExactlyOne, d.Escape} {
+		switch {
+		case c == 0:
+			continue
+		case c > 127, seen[c], strings.IndexByte("[]{},", c) != -1:
+			return ErrBadDialect
+		}
+		seen[c] = true
+	}
+
+	return nil
+}
+
+// writeLiteral writes c, escaped if it is a metacharacter of the default dialect.
+// The group delimiters are kept, as a dialect doesn't change the groups.
+func writeLiteral(b *strings.Builder, c byte) {
+	switch c {
+	case '*', '?', '.', '\\':
+		b.WriteByte('\\')
+	}
+	b.WriteByte(c)
+}
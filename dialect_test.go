@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestDialect validates the patterns written with other metacharacters
+func TestDialect(t *testing.T) {
+	hostname := Dialect{Star: '*', ExactlyOne: '_', Escape: '\\'}
+	percent := Dialect{Star: '%', ZeroOrOne: '~', ExactlyOne: '_', Escape: '!'}
+
+	cases := []struct {
+		dialect    Dialect
+		pattern    string
+		translated string
+		s          string
+		result     bool
+	}{
+		{hostname, "api.example.com", `api\.example\.com`, "api.example.com", true},
+		{hostname, "api.example.com", `api\.example\.com`, "apixexample.com", false},
+		{hostname, "v1.?_", `v1\.\?.`, "v1.?3", true},
+		{hostname, "*.example.com", `*\.example\.com`, "a.b.example.com", true},
+		{percent, "100!%", "100%", "100%", true},
+		{percent, "100!%", "100%", "1000", false},
+		{percent, "%.log", `*\.log`, "app.log", true},
+		{percent, "a~b*_", `a?b\*.`, "ab*c", true},
+		{percent, `C:\dir\%`, `C:\\dir\\*`, `C:\dir\file`, true},
+		{percent, "{a,b!,c}_", `{a,b\,c}.`, "b,c1", true},
+		{percent, "[!a-z]%", "[!a-z]*", "Abc", true},
+		{percent, "!", `\`, "!", false},
+	}
+
+	for i, c := range cases {
+		translated, err := c.dialect.Translate(c.pattern)
+		if err != nil || translated != c.translated {
+			t.Errorf("Test %d: Expected `%s`, found `%s` (%v); With Pattern: `%s`", i+1, c.translated, translated, err, c.pattern)
+		}
+		if result := c.dialect.Match(c.pattern, c.s); result != c.result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := c.dialect.MatchByRune(c.pattern, c.s); result != c.result {
+			t.Errorf("Test %d: Expected `%v` by rune, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+func TestDialectInvalid(t *testing.T) {
+	for _, d := range []Dialect{
+		{Star: '*', ZeroOrOne: '*'},
+		{Star: '{'},
+		{ExactlyOne: ','},
+		{Escape: 0xE9},
+	} {
+		if _, err := d.Translate("a"); !errors.Is(err, ErrBadDialect) {
+			t.Errorf("Expected `%v`, found `%v`; With Dialect: `%+v`", ErrBadDialect, err, d)
+		}
+		if d.Match("a", "a") {
+			t.Errorf("Expected no match with Dialect: `%+v`", d)
+		}
+	}
+
+	if _, err := (Dialect{Star: '%'}).Compile("[a"); !errors.Is(err, ErrBadPattern) {
+		t.Errorf("Expected `%v`, found `%v`", ErrBadPattern, err)
+	}
+	if translated, err := DefaultDialect.Translate(`a*b?c.\d`); err != nil || translated != `a*b?c.\d` {
+		t.Errorf("Expected the default dialect to keep the pattern, found `%s` (%v)", translated, err)
+	}
+}
+
+// FuzzDialect compares the patterns of the default dialect with the same patterns
+// written in another one.
+func FuzzDialect(f *testing.F) {
+	f.Add(`a*b?c.\*{d,e}`, "axb1*d")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		if strings.ContainsAny(pattern, "%~_![\x00") {
+			return
+		}
+
+		var b strings.Builder
+		for i := 0; i < len(pattern); i++ {
+			switch {
+			case pattern[i] == '\\' && i+1 < len(pattern):
+				// The metacharacters of the default dialect are literals in the other one.
+				i++
+				if strings.IndexByte(`*?.\`, pattern[i]) == -1 {
+					b.WriteByte('!')
+				}
+				b.WriteByte(pattern[i])
+			default:
+				b.WriteString(strings.NewReplacer("*", "%", "?", "~", ".", "_", `\`, "!").Replace(pattern[i : i+1]))
+			}
+		}
+		translated := b.String()
+		percent := Dialect{Star: '%', ZeroOrOne: '~', ExactlyOne: '_', Escape: '!'}
+		if result := percent.Match(translated, s); result != Match(pattern, s) {
+			t.Fatalf("Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", !result, result, translated, s)
+		}
+	})
+}
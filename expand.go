@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+// Expand returns the patterns obtained by replacing every brace group
+// of the pattern with each of its alternatives, in order.
+// For example "logs/{app,worker}-*.log" expands to "logs/app-*.log" and "logs/worker-*.log".
+// A pattern without group, or with an unclosed one, is returned as is.
+func Expand(pattern string) []string {
+	start := braceIndex(pattern)
+	if start == -1 {
+		return []string{pattern}
+	}
+
+	var expanded []string
+	prefix, suffix := pattern[:start], pattern[matchByStringClose(pattern, start)+1:]
+	for i := start; pattern[i] != '}'; {
+		end := matchByStringNext(pattern, i+1)
+		expanded = append(expanded, Expand(prefix+pattern[i+1:end]+suffix)...)
+		i = end
+	}
+
+	return expanded
+}
+
+// braceIndex returns the index of the first group of the pattern that is
+// neither escaped nor inside a bracket expression, or -1 if there is none
+// or if it is not closed.
+func braceIndex(pattern string) int {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '[':
+			if end, _ := matchByStringClass(pattern, i, 0, false); end != -1 {
+				i = end
+			}
+		case '{':
+			if matchByStringClose(pattern, i) == -1 {
+				return -1
+			}
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+// Find returns the byte offsets of the leftmost substring of text matched by
+// the pattern, or -1, -1 if there is none. When several substrings start there,
+// it is the shortest one, following the leftmost-shortest policy of MatchSubmatch,
+// so a trailing '*' matches nothing. It uses byte comparison, like Match.
+// It doesn't allocate unless the pattern is long.
+func Find(pattern, text string) (start, end int) {
+	var buf [smallProgram]inst
+	p := program{pattern: pattern, separator: -1}
+	p.insts = p.compile(buf[:0])
+
+	return find(&p, text)
+}
+
+// FindAll returns the byte offsets of the successive non-overlapping substrings
+// of text matched by the pattern, as found by Find. An empty match next to the
+// previous match is ignored. If n >= 0, it returns at most n matches.
+// It returns nil if there is no match.
+func FindAll(pattern, text string, n int) [][]int {
+	var buf [smallProgram]inst
+	p := program{pattern: pattern, separator: -1}
+	p.insts = p.compile(buf[:0])
+
+	return findAll(&p, text, n)
+}
+
+// findAll returns the offsets of the successive non-overlapping matches
+// of the program p in s, at most n if n >= 0.
+func findAll[T string | []byte](p *program, s T, n int) [][]int {
+	var matches [][]int
+	for pos, last := 0, -1; pos <= len(s) && (n < 0 || len(matches) < n); {
+		start, end := find(p, s[pos:])
+		if start == -1 {
+			break
+		}
+
+		start, end = start+pos, end+pos
+		if start == end && start == last {
+			pos = start + 1
+			continue
+		}
+
+		matches = append(matches, []int{start, end})
+		last, pos = end, end
+	}
+
+	return matches
+}
+
+// Contains reports whether a substring of text is matched by the pattern.
+// It is like Match with the pattern between two '*', without allocation.
+func Contains(pattern, text string) bool {
+	start, _ := Find(pattern, text)
+	return start != -1
+}
+
+// FindFromByte is like Find, with byte slices.
+func FindFromByte(pattern, text []byte) (start, end int) {
+	var buf [smallProgram]inst
+	p := program{pattern: string(pattern), separator: -1}
+	p.insts = p.compile(buf[:0])
+
+	return find(&p, text)
+}
+
+// FindAllFromByte is like FindAll, with byte slices.
+func FindAllFromByte(pattern, text []byte, n int) [][]int {
+	var buf [smallProgram]inst
+	p := program{pattern: string(pattern), separator: -1}
+	p.insts = p.compile(buf[:0])
+
+	return findAll(&p, text, n)
+}
+
+// ContainsFromByte is like Contains, with byte slices.
+func ContainsFromByte(pattern, text []byte) bool {
+	start, _ := FindFromByte(pattern, text)
+	return start != -1
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestFind validates the leftmost-shortest unanchored search
+func TestFind(t *testing.T) {
+	cases := []struct {
+		text       string
+		pattern    string
+		start, end int
+	}{
+		{"", "", 0, 0},
+		{"abc", "", 0, 0},
+		{"abc", "*", 0, 0},
+		{"", "?", 0, 0},
+		{"", ".", -1, -1},
+		{"abc", "x", -1, -1},
+		{"abc", "b?", 1, 2},
+		{"abc", "b.", 1, 3},
+		{"xxabcxx", "a.c", 2, 5},
+		{"abcabc", "a*c", 0, 3},
+		{"abcabc", "c*a", 2, 4},
+		{"hotdog", "{cat,dog}", 3, 6},
+		{"ab12cd", "[0-9][0-9]", 2, 4},
+		{"ab1cd", "[0-9][0-9]", -1, -1},
+		{"a.b", `\.`, 1, 2},
+		{"ts=1 user=bob action=delete ok", "user=* action=delete", 5, 27},
+		{"ts=1 user=bob action=update ok", "user=* action=delete", -1, -1},
+		{"T🥵🤷🏾‍♂️🥓", "🤷🏾‍♂️", 5, 22},
+	}
+
+	for i, c := range cases {
+		if start, end := Find(c.pattern, c.text); start != c.start || end != c.end {
+			t.Errorf("Test %d: Expected `%d, %d`, found `%d, %d`; With Pattern: `%s` and Text: `%s`", i+1, c.start, c.end, start, end, c.pattern, c.text)
+		}
+		if start, end := FindFromByte([]byte(c.pattern), []byte(c.text)); start != c.start || end != c.end {
+			t.Errorf("Test %d: FindFromByte expected `%d, %d`, found `%d, %d`; With Pattern: `%s` and Text: `%s`", i+1, c.start, c.end, start, end, c.pattern, c.text)
+		}
+		if result := Contains(c.pattern, c.text); result != (c.start != -1) {
+			t.Errorf("Test %d: Contains expected `%v`, found `%v`; With Pattern: `%s` and Text: `%s`", i+1, c.start != -1, result, c.pattern, c.text)
+		}
+		if result := ContainsFromByte([]byte(c.pattern), []byte(c.text)); result != (c.start != -1) {
+			t.Errorf("Test %d: ContainsFromByte expected `%v`, found `%v`; With Pattern: `%s` and Text: `%s`", i+1, c.start != -1, result, c.pattern, c.text)
+		}
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	cases := []struct {
+		text    string
+		pattern string
+		n       int
+		matches [][]int
+	}{
+		{"abc", "x", -1, nil},
+		{"aab", "a?", -1, [][]int{{0, 1}, {1, 2}}},
+		{"ab", "*", -1, [][]int{{0, 0}, {1, 1}, {2, 2}}},
+		{"a1b2c3", "[0-9]", -1, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"a1b2c3", "[0-9]", 2, [][]int{{1, 2}, {3, 4}}},
+		{"a1b2c3", "[0-9]", 0, nil},
+		{"key=1 key=22", "key=*{ ,2}", -1, [][]int{{0, 6}, {6, 11}}},
+	}
+
+	for i, c := range cases {
+		if matches := FindAll(c.pattern, c.text, c.n); !reflect.DeepEqual(matches, c.matches) {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and Text: `%s`", i+1, c.matches, matches, c.pattern, c.text)
+		}
+		if matches := FindAllFromByte([]byte(c.pattern), []byte(c.text), c.n); !reflect.DeepEqual(matches, c.matches) {
+			t.Errorf("Test %d: FindAllFromByte expected `%v`, found `%v`; With Pattern: `%s` and Text: `%s`", i+1, c.matches, matches, c.pattern, c.text)
+		}
+	}
+}
+
+func TestFindAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(10, func() {
+		Find("user=* action=delete", "ts=1 user=bob action=delete ok")
+		Contains("*.{go,mod}", "see wildcard.go")
+		ContainsFromByte([]byte("a?c"), []byte("xxabcxx"))
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocation, found `%v`", allocs)
+	}
+}
+
+func FuzzFind(f *testing.F) {
+	f.Add("a*b?c.", "xxaXbc!yy")
+	f.Fuzz(func(t *testing.T, pattern, text string) {
+		start, end := Find(pattern, text)
+		if start == -1 {
+			// A trailing '\' would escape the star.
+			if !strings.HasSuffix(pattern, `\`) && Match("*"+pattern+"*", text) {
+				t.Fatalf("Find(%q, %q) found nothing, but Match with stars matches", pattern, text)
+			}
+			return
+		}
+		if start > end || end > len(text) {
+			t.Fatalf("Find(%q, %q) returned invalid offsets %d, %d", pattern, text, start, end)
+		}
+	})
+}
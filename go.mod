@@ -1,3 +1,3 @@
 module github.com/IGLOU-EU/go-wildcard/v2
 
-go 1.16
+go 1.18
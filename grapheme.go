@@ -0,0 +1,650 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// MatchByGrapheme returns true if the pattern matches the string s.
+// It compares extended grapheme clusters as defined by Unicode UAX #29,
+// so "." and "?" consume one user-perceived character, like "🤷🏾‍♂️"
+// or an "e" followed by a combining accent.
+// In bracket expressions, a cluster of several runes only matches itself,
+// and the combining marks following a wildcard are part of it.
+// Note that it incurs allocation and more CPU usage than MatchByRune.
+func MatchByGrapheme(pattern, s string) bool {
+	if pattern == "" {
+		return s == pattern
+	}
+	if pattern == "*" {
+		return true
+	}
+
+	ids := make(map[string]rune)
+	return matchByRunes(patternGraphemes(pattern, ids), graphemes(s, ids), -1, false)
+}
+
+// graphemes returns the extended grapheme clusters of s as runes.
+// A cluster of a single rune is the rune itself, longer ones get an identifier
+// above unicode.MaxRune, shared through ids between the pattern and the string.
+func graphemes(s string, ids map[string]rune) []rune {
+	clusters := make([]rune, 0, utf8.RuneCountInString(s))
+	for len(s) > 0 {
+		r, n := grapheme(s, ids)
+		clusters = append(clusters, r)
+		s = s[n:]
+	}
+
+	return clusters
+}
+
+// patternGraphemes is graphemes for a pattern, where only the literal runs are clustered.
+// The metacharacters are kept as is, and the combining marks following a '*', '?' or '.'
+// are part of the wildcard, so "." matches the same clusters as ".\u0301".
+func patternGraphemes(pattern string, ids map[string]rune) []rune {
+	clusters := make([]rune, 0, utf8.RuneCountInString(pattern))
+
+	// class is the index of the ']' ending the bracket expression being read, or -1,
+	// and groups the number of open groups.
+	class, groups := -1, 0
+	for i := 0; i < len(pattern); {
+		switch c := pattern[i]; {
+		case class != -1 && i == class:
+			class = -1
+		case class != -1 && c != '\\':
+			r, n := grapheme(pattern[i:class], ids)
+			clusters = append(clusters, r)
+			i += n
+			continue
+		case c == '*' || c == '?' || c == '.':
+			clusters = append(clusters, rune(c))
+			i += graphemeLen(pattern[i:])
+			continue
+		case c == '\\':
+			clusters = append(clusters, '\\')
+			if i++; i == len(pattern) {
+				continue
+			}
+			r, n := grapheme(pattern[i:patternLiteralEnd(pattern, i+1, class, groups)], ids)
+			clusters = append(clusters, r)
+			i += n
+			continue
+		case c == '[':
+			class, _ = matchByStringClass(pattern, i, 0, false)
+		case c == '{':
+			groups++
+		case groups > 0 && c == '}':
+			groups--
+		case groups > 0 && c == ',':
+		default:
+			r, n := grapheme(pattern[i:patternLiteralEnd(pattern, i+1, class, groups)], ids)
+			clusters = append(clusters, r)
+			i += n
+			continue
+		}
+
+		clusters = append(clusters, rune(pattern[i]))
+		i++
+	}
+
+	return clusters
+}
+
+// patternLiteralEnd returns the index of the first metacharacter of pattern from i,
+// or its length, where the literal run being read ends.
+func patternLiteralEnd(pattern string, i, class, groups int) int {
+	for ; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case class != -1:
+			if i == class || c == '\\' {
+				return i
+			}
+		case c == '*', c == '?', c == '.', c == '\\', c == '[', c == '{':
+			return i
+		case groups > 0 && (c == ',' || c == '}'):
+			return i
+		}
+	}
+
+	return i
+}
+
+// grapheme returns the extended grapheme cluster at the start of s as a rune,
+// as described by graphemes, and its length in bytes.
+func grapheme(s string, ids map[string]rune) (rune, int) {
+	n := graphemeLen(s)
+	r, size := utf8.DecodeRuneInString(s)
+	if size != n {
+		id, ok := ids[s[:n]]
+		if !ok {
+			id = unicode.MaxRune + 1 + rune(len(ids))
+			ids[s[:n]] = id
+		}
+		r = id
+	}
+
+	return r, n
+}
+
+// graphemeProperty is the Grapheme_Cluster_Break property of a rune,
+// with Extended_Pictographic as an extra value.
+type graphemeProperty uint8
+
+const (
+	gbOther graphemeProperty = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+	gbPictographic
+)
+
+// graphemeLen returns the length in bytes of the extended grapheme cluster
+// at the start of s, following the boundary rules of Unicode UAX #29.
+func graphemeLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	prev := graphemePropertyOf(r)
+
+	// emoji is set while the cluster ends with Extended_Pictographic Extend* ZWJ?,
+	// regional counts the Regional_Indicator runes ending the cluster,
+	// conjunct is set while it ends with an Indic_Conjunct_Break Consonant [Extend Linker]*,
+	// and linked once a Linker is among them.
+	emoji := prev == gbPictographic
+	regional := 0
+	if prev == gbRegionalIndicator {
+		regional = 1
+	}
+	conjunct, linked := graphemeConjunctOf(r, prev) == conjunctConsonant, false
+
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		next := graphemePropertyOf(r)
+		incb := graphemeConjunctOf(r, next)
+		if graphemeBreak(prev, next, emoji, regional, linked && incb == conjunctConsonant) {
+			break
+		}
+
+		switch next {
+		case gbPictographic:
+			emoji = true
+		case gbExtend, gbZWJ:
+			emoji = emoji && prev != gbZWJ
+		default:
+			emoji = false
+		}
+		if next == gbRegionalIndicator {
+			regional++
+		} else {
+			regional = 0
+		}
+		switch incb {
+		case conjunctConsonant:
+			conjunct, linked = true, false
+		case conjunctLinker:
+			linked = conjunct
+		case conjunctExtend:
+		default:
+			conjunct, linked = false, false
+		}
+
+		prev = next
+		n += size
+	}
+
+	return n
+}
+
+// graphemeBreak reports whether there is a cluster boundary between two runes,
+// where linked is set if next is an Indic consonant joined by a Linker to the cluster.
+func graphemeBreak(prev, next graphemeProperty, emoji bool, regional int, linked bool) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return false
+	case prev == gbControl || prev == gbCR || prev == gbLF: // GB4
+		return true
+	case next == gbControl || next == gbCR || next == gbLF: // GB5
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return false
+	case next == gbExtend || next == gbZWJ || next == gbSpacingMark: // GB9, GB9a
+		return false
+	case prev == gbPrepend: // GB9b
+		return false
+	case linked: // GB9c
+		return false
+	case prev == gbZWJ && next == gbPictographic && emoji: // GB11
+		return false
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator: // GB12, GB13
+		return regional%2 == 0
+	}
+
+	return true // GB999
+}
+
+// graphemePropertyOf returns the grapheme property of r, derived from the
+// general categories of the unicode package and the tables below, which
+// follow Unicode 17.0.0 as testdata/GraphemeBreakTest.txt does.
+func graphemePropertyOf(r rune) graphemeProperty {
+	switch {
+	case r < 0x7f && r >= 0x20:
+		return gbOther
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200d:
+		return gbZWJ
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gbL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6, r == 0x16d63, r >= 0x16d67 && r <= 0x16d6a:
+		return gbV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gbT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gbRegionalIndicator
+	case unicode.Is(graphemePrepend, r):
+		return gbPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend), r >= 0x1f3fb && r <= 0x1f3ff:
+		// Grapheme_Extend and the emoji modifiers.
+		return gbExtend
+	case unicode.Is(graphemeSpacingMark, r):
+		return gbSpacingMark
+	case unicode.Is(unicode.Mc, r) && !unicode.Is(graphemeNotSpacingMark, r):
+		return gbSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gbControl
+	case unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r) && !unicode.IsLetter(r):
+		// The unassigned default ignorable code points.
+		return gbControl
+	case unicode.Is(graphemePictographic, r):
+		return gbPictographic
+	}
+
+	return gbOther
+}
+
+// conjunctProperty is the Indic_Conjunct_Break property of a rune.
+type conjunctProperty uint8
+
+const (
+	conjunctNone conjunctProperty = iota
+	conjunctConsonant
+	conjunctLinker
+	conjunctExtend
+)
+
+// graphemeConjunctOf returns the Indic_Conjunct_Break property of r,
+// whose grapheme property is p. Extend holds the runes with the Extend
+// and ZWJ grapheme properties, but the linkers and the zero width non-joiner.
+func graphemeConjunctOf(r rune, p graphemeProperty) conjunctProperty {
+	switch {
+	case r < 0x0300:
+		return conjunctNone
+	case unicode.Is(graphemeLinker, r):
+		return conjunctLinker
+	case (p == gbExtend || p == gbZWJ) && r != 0x200c:
+		return conjunctExtend
+	case unicode.Is(graphemeConsonant, r):
+		return conjunctConsonant
+	}
+
+	return conjunctNone
+}
+
+// graphemePrepend holds the runes with the Prepend property.
+var graphemePrepend = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
+		{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
+		{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+		{Lo: 0x110cd, Hi: 0x110cd, Stride: 1},
+		{Lo: 0x111c2, Hi: 0x111c3, Stride: 1},
+		{Lo: 0x113d1, Hi: 0x113d1, Stride: 1},
+		{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+		{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+		{Lo: 0x11a84, Hi: 0x11a89, Stride: 1},
+		{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+		{Lo: 0x11f02, Hi: 0x11f02, Stride: 1},
+	},
+}
+
+// graphemeSpacingMark holds the runes with the SpacingMark property
+// which are not spacing combining marks.
+var graphemeSpacingMark = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0e33, Hi: 0x0e33, Stride: 1},
+		{Lo: 0x0eb3, Hi: 0x0eb3, Stride: 1},
+	},
+}
+
+// graphemeNotSpacingMark holds the spacing combining marks
+// without the SpacingMark property.
+var graphemeNotSpacingMark = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x102b, Hi: 0x102c, Stride: 1},
+		{Lo: 0x1038, Hi: 0x1038, Stride: 1},
+		{Lo: 0x1062, Hi: 0x1064, Stride: 1},
+		{Lo: 0x1067, Hi: 0x106d, Stride: 1},
+		{Lo: 0x1083, Hi: 0x1083, Stride: 1},
+		{Lo: 0x1087, Hi: 0x108c, Stride: 1},
+		{Lo: 0x108f, Hi: 0x108f, Stride: 1},
+		{Lo: 0x109a, Hi: 0x109c, Stride: 1},
+		{Lo: 0x1a61, Hi: 0x1a61, Stride: 1},
+		{Lo: 0x1a63, Hi: 0x1a64, Stride: 1},
+		{Lo: 0xaa7b, Hi: 0xaa7b, Stride: 1},
+		{Lo: 0xaa7d, Hi: 0xaa7d, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x11720, Hi: 0x11721, Stride: 1},
+	},
+}
+
+// graphemeLinker holds the runes with the Indic_Conjunct_Break=Linker property,
+// the viramas joining two consonants into a conjunct.
+var graphemeLinker = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x094d, Hi: 0x094d, Stride: 1},
+		{Lo: 0x09cd, Hi: 0x09cd, Stride: 1},
+		{Lo: 0x0acd, Hi: 0x0acd, Stride: 1},
+		{Lo: 0x0b4d, Hi: 0x0b4d, Stride: 1},
+		{Lo: 0x0c4d, Hi: 0x0c4d, Stride: 1},
+		{Lo: 0x0d4d, Hi: 0x0d4d, Stride: 1},
+		{Lo: 0x1039, Hi: 0x1039, Stride: 1},
+		{Lo: 0x17d2, Hi: 0x17d2, Stride: 1},
+		{Lo: 0x1a60, Hi: 0x1a60, Stride: 1},
+		{Lo: 0x1b44, Hi: 0x1b44, Stride: 1},
+		{Lo: 0x1bab, Hi: 0x1bab, Stride: 1},
+		{Lo: 0xa9c0, Hi: 0xa9c0, Stride: 1},
+		{Lo: 0xaaf6, Hi: 0xaaf6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10a3f, Hi: 0x10a3f, Stride: 1},
+		{Lo: 0x11133, Hi: 0x11133, Stride: 1},
+		{Lo: 0x113d0, Hi: 0x113d0, Stride: 1},
+		{Lo: 0x1193e, Hi: 0x1193e, Stride: 1},
+		{Lo: 0x11a47, Hi: 0x11a47, Stride: 1},
+		{Lo: 0x11a99, Hi: 0x11a99, Stride: 1},
+		{Lo: 0x11f42, Hi: 0x11f42, Stride: 1},
+	},
+}
+
+// graphemeConsonant holds the runes with the Indic_Conjunct_Break=Consonant property.
+var graphemeConsonant = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0915, Hi: 0x0939, Stride: 1},
+		{Lo: 0x0958, Hi: 0x095f, Stride: 1},
+		{Lo: 0x0978, Hi: 0x097f, Stride: 1},
+		{Lo: 0x0995, Hi: 0x09a8, Stride: 1},
+		{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+		{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+		{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+		{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+		{Lo: 0x09df, Hi: 0x09df, Stride: 1},
+		{Lo: 0x09f0, Hi: 0x09f1, Stride: 1},
+		{Lo: 0x0a95, Hi: 0x0aa8, Stride: 1},
+		{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+		{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+		{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+		{Lo: 0x0af9, Hi: 0x0af9, Stride: 1},
+		{Lo: 0x0b15, Hi: 0x0b28, Stride: 1},
+		{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+		{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+		{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+		{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+		{Lo: 0x0b5f, Hi: 0x0b5f, Stride: 1},
+		{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+		{Lo: 0x0c15, Hi: 0x0c28, Stride: 1},
+		{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+		{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+		{Lo: 0x0d15, Hi: 0x0d3a, Stride: 1},
+		{Lo: 0x1000, Hi: 0x102a, Stride: 1},
+		{Lo: 0x103f, Hi: 0x103f, Stride: 1},
+		{Lo: 0x1050, Hi: 0x1055, Stride: 1},
+		{Lo: 0x105a, Hi: 0x105d, Stride: 1},
+		{Lo: 0x1061, Hi: 0x1061, Stride: 1},
+		{Lo: 0x1065, Hi: 0x1066, Stride: 1},
+		{Lo: 0x106e, Hi: 0x1070, Stride: 1},
+		{Lo: 0x1075, Hi: 0x1081, Stride: 1},
+		{Lo: 0x108e, Hi: 0x108e, Stride: 1},
+		{Lo: 0x1780, Hi: 0x17b3, Stride: 1},
+		{Lo: 0x1a20, Hi: 0x1a54, Stride: 1},
+		{Lo: 0x1b0b, Hi: 0x1b0c, Stride: 1},
+		{Lo: 0x1b13, Hi: 0x1b33, Stride: 1},
+		{Lo: 0x1b45, Hi: 0x1b4c, Stride: 1},
+		{Lo: 0x1b83, Hi: 0x1ba0, Stride: 1},
+		{Lo: 0x1bae, Hi: 0x1baf, Stride: 1},
+		{Lo: 0x1bbb, Hi: 0x1bbd, Stride: 1},
+		{Lo: 0xa989, Hi: 0xa98b, Stride: 1},
+		{Lo: 0xa98f, Hi: 0xa9b2, Stride: 1},
+		{Lo: 0xa9e0, Hi: 0xa9e4, Stride: 1},
+		{Lo: 0xa9e7, Hi: 0xa9ef, Stride: 1},
+		{Lo: 0xa9fa, Hi: 0xa9fe, Stride: 1},
+		{Lo: 0xaa60, Hi: 0xaa6f, Stride: 1},
+		{Lo: 0xaa71, Hi: 0xaa73, Stride: 1},
+		{Lo: 0xaa7a, Hi: 0xaa7a, Stride: 1},
+		{Lo: 0xaa7e, Hi: 0xaa7f, Stride: 1},
+		{Lo: 0xaae0, Hi: 0xaaea, Stride: 1},
+		{Lo: 0xabc0, Hi: 0xabda, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10a00, Hi: 0x10a00, Stride: 1},
+		{Lo: 0x10a10, Hi: 0x10a13, Stride: 1},
+		{Lo: 0x10a15, Hi: 0x10a17, Stride: 1},
+		{Lo: 0x10a19, Hi: 0x10a35, Stride: 1},
+		{Lo: 0x11103, Hi: 0x11126, Stride: 1},
+		{Lo: 0x11144, Hi: 0x11144, Stride: 1},
+		{Lo: 0x11147, Hi: 0x11147, Stride: 1},
+		{Lo: 0x11380, Hi: 0x11389, Stride: 1},
+		{Lo: 0x1138b, Hi: 0x1138b, Stride: 1},
+		{Lo: 0x1138e, Hi: 0x1138e, Stride: 1},
+		{Lo: 0x11390, Hi: 0x113b5, Stride: 1},
+		{Lo: 0x11900, Hi: 0x11906, Stride: 1},
+		{Lo: 0x11909, Hi: 0x11909, Stride: 1},
+		{Lo: 0x1190c, Hi: 0x11913, Stride: 1},
+		{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+		{Lo: 0x11918, Hi: 0x1192f, Stride: 1},
+		{Lo: 0x11a00, Hi: 0x11a00, Stride: 1},
+		{Lo: 0x11a0b, Hi: 0x11a32, Stride: 1},
+		{Lo: 0x11a50, Hi: 0x11a50, Stride: 1},
+		{Lo: 0x11a5c, Hi: 0x11a83, Stride: 1},
+		{Lo: 0x11f04, Hi: 0x11f10, Stride: 1},
+		{Lo: 0x11f12, Hi: 0x11f33, Stride: 1},
+	},
+}
+
+// graphemePictographic holds the runes with the Extended_Pictographic property.
+var graphemePictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2604, Stride: 1},
+		{Lo: 0x260e, Hi: 0x260e, Stride: 1},
+		{Lo: 0x2611, Hi: 0x2611, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2618, Hi: 0x2618, Stride: 1},
+		{Lo: 0x261d, Hi: 0x261d, Stride: 1},
+		{Lo: 0x2620, Hi: 0x2620, Stride: 1},
+		{Lo: 0x2622, Hi: 0x2623, Stride: 1},
+		{Lo: 0x2626, Hi: 0x2626, Stride: 1},
+		{Lo: 0x262a, Hi: 0x262a, Stride: 1},
+		{Lo: 0x262e, Hi: 0x262f, Stride: 1},
+		{Lo: 0x2638, Hi: 0x263a, Stride: 1},
+		{Lo: 0x2640, Hi: 0x2640, Stride: 1},
+		{Lo: 0x2642, Hi: 0x2642, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x265f, Hi: 0x2660, Stride: 1},
+		{Lo: 0x2663, Hi: 0x2663, Stride: 1},
+		{Lo: 0x2665, Hi: 0x2666, Stride: 1},
+		{Lo: 0x2668, Hi: 0x2668, Stride: 1},
+		{Lo: 0x267b, Hi: 0x267b, Stride: 1},
+		{Lo: 0x267e, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2692, Hi: 0x2697, Stride: 1},
+		{Lo: 0x2699, Hi: 0x2699, Stride: 1},
+		{Lo: 0x269b, Hi: 0x269c, Stride: 1},
+		{Lo: 0x26a0, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26a7, Hi: 0x26a7, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26b0, Hi: 0x26b1, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26c8, Hi: 0x26c8, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26cf, Stride: 1},
+		{Lo: 0x26d1, Hi: 0x26d1, Stride: 1},
+		{Lo: 0x26d3, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26e9, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f0, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26f7, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2702, Hi: 0x2702, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x270d, Stride: 1},
+		{Lo: 0x270f, Hi: 0x270f, Stride: 1},
+		{Lo: 0x2712, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2764, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f02c, Hi: 0x1f02f, Stride: 1},
+		{Lo: 0x1f094, Hi: 0x1f09f, Stride: 1},
+		{Lo: 0x1f0af, Hi: 0x1f0b0, Stride: 1},
+		{Lo: 0x1f0c0, Hi: 0x1f0c0, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0d0, Stride: 1},
+		{Lo: 0x1f0f6, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f170, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1ae, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+		{Lo: 0x1f249, Hi: 0x1f25f, Stride: 1},
+		{Lo: 0x1f266, Hi: 0x1f321, Stride: 1},
+		{Lo: 0x1f324, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f396, Hi: 0x1f397, Stride: 1},
+		{Lo: 0x1f399, Hi: 0x1f39b, Stride: 1},
+		{Lo: 0x1f39e, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f3, Hi: 0x1f3f5, Stride: 1},
+		{Lo: 0x1f3f7, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1f4fd, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f549, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f56f, Hi: 0x1f570, Stride: 1},
+		{Lo: 0x1f573, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f587, Hi: 0x1f587, Stride: 1},
+		{Lo: 0x1f58a, Hi: 0x1f58d, Stride: 1},
+		{Lo: 0x1f590, Hi: 0x1f590, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a5, Stride: 1},
+		{Lo: 0x1f5a8, Hi: 0x1f5a8, Stride: 1},
+		{Lo: 0x1f5b1, Hi: 0x1f5b2, Stride: 1},
+		{Lo: 0x1f5bc, Hi: 0x1f5bc, Stride: 1},
+		{Lo: 0x1f5c2, Hi: 0x1f5c4, Stride: 1},
+		{Lo: 0x1f5d1, Hi: 0x1f5d3, Stride: 1},
+		{Lo: 0x1f5dc, Hi: 0x1f5de, Stride: 1},
+		{Lo: 0x1f5e1, Hi: 0x1f5e1, Stride: 1},
+		{Lo: 0x1f5e3, Hi: 0x1f5e3, Stride: 1},
+		{Lo: 0x1f5e8, Hi: 0x1f5e8, Stride: 1},
+		{Lo: 0x1f5ef, Hi: 0x1f5ef, Stride: 1},
+		{Lo: 0x1f5f3, Hi: 0x1f5f3, Stride: 1},
+		{Lo: 0x1f5fa, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cb, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6e5, Stride: 1},
+		{Lo: 0x1f6e9, Hi: 0x1f6e9, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6f0, Stride: 1},
+		{Lo: 0x1f6f3, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f7da, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8af, Stride: 1},
+		{Lo: 0x1f8bc, Hi: 0x1f8bf, Stride: 1},
+		{Lo: 0x1f8c2, Hi: 0x1f8cf, Stride: 1},
+		{Lo: 0x1f8d9, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa58, Hi: 0x1fa5f, Stride: 1},
+		{Lo: 0x1fa6e, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+	LatinOffset: 2,
+}
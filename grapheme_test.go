@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestGraphemes validates the extended grapheme cluster boundaries
+func TestGraphemes(t *testing.T) {
+	cases := []struct {
+		s        string
+		clusters []string
+	}{
+		{"", nil},
+		{"abc", []string{"a", "b", "c"}},
+		{"\r\n\n", []string{"\r\n", "\n"}},
+		{"e\u0301a", []string{"e\u0301", "a"}},
+		{"\u0301a", []string{"\u0301", "a"}},
+		{"a\u200d", []string{"a\u200d"}},
+		{"🤷🏾‍♂️🥓", []string{"🤷🏾‍♂️", "🥓"}},
+		{"👨‍👩‍👧", []string{"👨‍👩‍👧"}},
+		{"a\u200d🥓", []string{"a\u200d", "🥓"}},
+		{"🇫🇷🇩🇪🇮", []string{"🇫🇷", "🇩🇪", "🇮"}},
+		{"각한", []string{"각", "한"}},
+		{"क्षि", []string{"क्षि"}},
+		{"क्‍ष", []string{"क्‍ष"}},
+		{"क्́ष", []string{"क्́ष"}},
+		{"कष", []string{"क", "ष"}},
+		{"क́ष", []string{"क́", "ष"}},
+		{"्ष", []string{"्", "ष"}},
+		{"\u0600a", []string{"\u0600a"}},
+		{"a\u0e33", []string{"a\u0e33"}},
+		{"\x00\u0301", []string{"\x00", "\u0301"}},
+	}
+
+	for i, c := range cases {
+		var clusters []string
+		for s := c.s; s != ""; {
+			n := graphemeLen(s)
+			clusters = append(clusters, s[:n])
+			s = s[n:]
+		}
+
+		if !reflect.DeepEqual(clusters, c.clusters) {
+			t.Errorf("Test %d: Expected `%q`, found `%q`; With String: `%s`", i+1, c.clusters, clusters, c.s)
+		}
+	}
+}
+
+// TestGraphemeBreakTest validates the boundaries with the tests of Unicode
+func TestGraphemeBreakTest(t *testing.T) {
+	f, err := os.Open("testdata/GraphemeBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(fields) == "" {
+			continue
+		}
+
+		var s strings.Builder
+		var expected []string
+		for _, field := range strings.Fields(fields) {
+			switch field {
+			case "÷":
+				if s.Len() > 0 {
+					expected = append(expected, s.String())
+					s.Reset()
+				}
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatalf("Line %d: %v", line, err)
+				}
+				s.WriteRune(rune(r))
+			}
+		}
+
+		var clusters []string
+		for s := strings.Join(expected, ""); s != ""; {
+			n := graphemeLen(s)
+			clusters = append(clusters, s[:n])
+			s = s[n:]
+		}
+		if !reflect.DeepEqual(clusters, expected) {
+			t.Errorf("Line %d: Expected `%+q`, found `%+q`", line, expected, clusters)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestMatchByGrapheme validates that wildcards consume
+// a whole extended grapheme cluster
+func TestMatchByGrapheme(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		result  bool
+	}{
+		{"", "", true},
+		{"", "*", true},
+		{"", "?", true},
+		{"", ".", false},
+		{"a", "", false},
+		{"abcba", "a?c?a", true},
+		{"abcba", "a.c.", false},
+		{"T🥵🤷🏾‍♂️🥓", "*🤷🏾‍♂️*", true},
+		{"T🥵🤷🏾‍♂️🥓", "T.🤷🏾‍♂️.", true},
+		{"T🥵🤷🏾‍♂️🥓", "T..?", true},
+		{"T🥵🤷🏾‍♂️🥓", "T...", true},
+		{"T🥵🤷🏾‍♂️🥓", "T....", false},
+		{"T🥵🤷🏾‍♂️🥓", "T.🤷*", false},
+		{"cafe\u0301", "caf.", true},
+		{"cafe\u0301", "cafe*", false},
+		{"cafe\u0301", "caf[e\u0301]", true},
+		{"cafe\u0301", "caf[e]", false},
+		{"cafe\u0301", "caf\u00e9", false},
+		{"🇫🇷🇩🇪", "..", true},
+		{"🇫🇷🇩🇪", "🇫🇷.", true},
+		{"🇫🇷🇩🇪", ".🇩🇪", true},
+		{"한국어", "한?어", true},
+		{"\r\n", ".", true},
+		{"a.b", `a\.b`, true},
+		{"axb", `a\.b`, false},
+		{"x👨‍👩‍👧.log", "{x,y}?.log", true},
+		{"क्ष", ".", true},
+		{"क्ष", "..", false},
+		{"ab", "a.\u0301", true},
+		{"ab\u0301", "a.\u0301", true},
+		{"abc", "a.\u0301", false},
+		{"abc", "a*\u0301", true},
+		{"abc", "*\u0301c", true},
+		{"axb", "a?\u0301b", true},
+		{"ab", "a?\u0301b", true},
+		{"*\u0301", "\\*\u0301", true},
+		{"*", "\\*\u0301", false},
+		{".\u0301", "[.\u0301]", true},
+		{"e\u0301", "[.\u0301]", false},
+		{"e\u0301x", "{e\u0301,a}x", true},
+		{"e\u0301x", "{e\u0301,a}\u0301x", false},
+		{"e\u0301,x", "e\u0301,?", true},
+	}
+
+	for i, c := range cases {
+		if result := MatchByGrapheme(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
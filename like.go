@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+// MatchLike returns true if the pattern matches the string s like the SQL predicate
+// "s LIKE pattern ESCAPE escape": '%' matches any sequence of characters, even empty,
+// '_' matches exactly one character, and every other character matches itself.
+// The escape character makes the next one a literal, like "100\%" with '\';
+// 0 means no escape character, as when the ESCAPE clause is omitted in standard SQL,
+// while PostgreSQL uses '\' by default.
+// A pattern ending with the escape character never matches, where SQL raises an error.
+// It uses rune comparison, like LIKE on UTF-8 text.
+func MatchLike(pattern, s string, escape rune) bool {
+	return matchLike(pattern, s, escape, false)
+}
+
+// MatchILike is like MatchLike, ignoring the case like the ILIKE predicate of PostgreSQL,
+// under Unicode simple case folding.
+func MatchILike(pattern, s string, escape rune) bool {
+	return matchLike(pattern, s, escape, true)
+}
+
+func matchLike(pattern, s string, escape rune, fold bool) bool {
+	translated, ok := translateLike(pattern, escape)
+	if !ok {
+		return false
+	}
+	if len(translated) == 0 {
+		return s == ""
+	}
+
+	return matchByRunes(translated, []rune(s), -1, fold)
+}
+
+// translateLike returns the LIKE pattern written with the wildcards of matchByRunes,
+// or false if it ends with the escape character.
+func translateLike(pattern string, escape rune) ([]rune, bool) {
+	translated := make([]rune, 0, len(pattern)+4)
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case escape != 0 && r == escape:
+			escaped = true
+			continue
+		case r == '%':
+			translated = append(translated, '*')
+			continue
+		case r == '_':
+			translated = append(translated, '.')
+			continue
+		}
+
+		switch r {
+		case '*', '?', '.', '\\', '[', '{', '}', ',':
+			translated = append(translated, '\\')
+		}
+		translated = append(translated, r)
+	}
+
+	return translated, !escaped
+}
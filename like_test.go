@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestMatchLike validates the conformance with the <like predicate> of the SQL standard
+// and the LIKE and ILIKE of PostgreSQL
+func TestMatchLike(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		escape  rune
+		like    bool
+		ilike   bool
+	}{
+		// The examples of the PostgreSQL documentation.
+		{"abc", "abc", 0, true, true},
+		{"abc", "a%", 0, true, true},
+		{"abc", "_b_", 0, true, true},
+		{"abc", "c", 0, false, false},
+
+		// '%' matches any sequence, even empty, and '_' exactly one character.
+		{"", "", 0, true, true},
+		{"", "%", 0, true, true},
+		{"", "_", 0, false, false},
+		{"a", "", 0, false, false},
+		{"abc", "%%", 0, true, true},
+		{"abc", "%_%", 0, true, true},
+		{"abc", "___", 0, true, true},
+		{"abc", "____", 0, false, false},
+		{"abc", "__", 0, false, false},
+		{"abc", "%c", 0, true, true},
+		{"abc", "%b", 0, false, false},
+		{"abcbc", "a%bc", 0, true, true},
+		{"a\nb", "a_b", 0, true, true},
+		{"a\nb", "a%", 0, true, true},
+
+		// '_' matches a character, not a byte.
+		{"é", "_", 0, true, true},
+		{"日本", "__", 0, true, true},
+		{"日本", "_", 0, false, false},
+
+		// The metacharacters of the patterns of the package are literals.
+		{"a*c", "a*c", 0, true, true},
+		{"abc", "a*c", 0, false, false},
+		{"a?c", "a?c", 0, true, true},
+		{"ac", "a?c", 0, false, false},
+		{"a.c", "a.c", 0, true, true},
+		{"abc", "a.c", 0, false, false},
+		{"[a]", "[a]", 0, true, true},
+		{"a", "[a]", 0, false, false},
+		{"{a,b}", "{a,b}", 0, true, true},
+		{"a", "{a,b}", 0, false, false},
+		{`a\b`, `a\b`, 0, true, true},
+		{`a\b`, `a\\b`, 0, false, false},
+
+		// The escape character makes the next one a literal.
+		{"100%", `100\%`, '\\', true, true},
+		{"1000", `100\%`, '\\', false, false},
+		{"a_b", `a\_b`, '\\', true, true},
+		{"axb", `a\_b`, '\\', false, false},
+		{`a\b`, `a\\b`, '\\', true, true},
+		{"ab", `a\b`, '\\', true, true},
+		{"100%", "100!%", '!', true, true},
+		{"100!", "100!!", '!', true, true},
+		{"100%", `100\%`, '!', false, false},
+		{"50%_off", "%!%!_%", '!', true, true},
+		{"50% off", "%!%!_%", '!', false, false},
+		{"a%b", "a§%b", '§', true, true},
+		{"a*b", `a\*b`, '\\', true, true},
+		{"a%b", "a%b", 'x', true, true},
+		{"a%b", "axxb", 'x', false, false},
+		{"axb", "axxb", 'x', true, true},
+
+		// A pattern ending with the escape character is an error in SQL.
+		{`a\`, `a\`, '\\', false, false},
+		{"a", `a\`, '\\', false, false},
+		{"a!", "a!!!", '!', false, false},
+
+		// ILIKE ignores the case.
+		{"ABC", "abc", 0, false, true},
+		{"AbC", "a%c", 0, false, true},
+		{"ÉCOLE", "école", 0, false, true},
+		{"École", "_cole", 0, true, true},
+		{"STRASSE", "straße", 0, false, false},
+		{"100%X", `100\%x`, '\\', false, true},
+	}
+
+	for i, c := range cases {
+		if result := MatchLike(c.pattern, c.s, c.escape); result != c.like {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.like, result, c.pattern, c.s)
+		}
+		if result := MatchILike(c.pattern, c.s, c.escape); result != c.ilike {
+			t.Errorf("Test %d: Expected `%v` ignoring the case, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.ilike, result, c.pattern, c.s)
+		}
+	}
+}
+
+// FuzzMatchLike compares with the regular expression of the LIKE pattern.
+func FuzzMatchLike(f *testing.F) {
+	f.Add(`50\%_o%{f,*}`, "50%_off*", '\\')
+	f.Fuzz(func(t *testing.T, pattern, s string, escape rune) {
+		if !utf8.ValidString(pattern) || !utf8.ValidString(s) || escape == utf8.RuneError {
+			return
+		}
+
+		var b strings.Builder
+		b.WriteString(`(?s)^`)
+		escaped := false
+		for _, r := range pattern {
+			switch {
+			case escaped:
+				escaped = false
+				b.WriteString(regexp.QuoteMeta(string(r)))
+			case escape != 0 && r == escape:
+				escaped = true
+			case r == '%':
+				b.WriteString(`.*`)
+			case r == '_':
+				b.WriteString(`.`)
+			default:
+				b.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+		b.WriteString(`$`)
+
+		expected := !escaped && regexp.MustCompile(b.String()).MatchString(s)
+		if result := MatchLike(pattern, s, escape); result != expected {
+			t.Fatalf("Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", expected, result, pattern, s)
+		}
+		expected = !escaped && regexp.MustCompile(`(?i)`+b.String()).MatchString(s)
+		if result := MatchILike(pattern, s, escape); result != expected {
+			t.Fatalf("Expected `%v` ignoring the case, found `%v`; With Pattern: `%s` and String: `%s`", expected, result, pattern, s)
+		}
+	})
+}
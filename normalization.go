@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Normalization is a Unicode normalization form, applied to the pattern
+// and the string before matching them with rune comparison.
+type Normalization uint8
+
+const (
+	// NoNormalization compares the runes as they are.
+	NoNormalization Normalization = iota
+
+	// NFC matches canonically equivalent strings, so a precomposed "é"
+	// matches an "e" followed by the combining acute accent U+0301.
+	NFC
+
+	// NFKC also matches compatibility equivalent strings,
+	// so the ligature "ﬁ" matches "fi" and the superscript "²" matches "2".
+	// The pattern characters becoming a wildcard by compatibility,
+	// like the fullwidth asterisk "＊", stay literal.
+	NFKC
+)
+
+// Hangul syllables are composed and decomposed algorithmically.
+const (
+	hangulBase  = 0xac00
+	hangulL     = 0x1100
+	hangulV     = 0x1161
+	hangulT     = 0x11a7
+	hangulCount = 11172
+	hangulVT    = 588 // hangulVCount * hangulTCount
+	hangulTs    = 28  // hangulTCount
+)
+
+// The tables of normalization_tables.go are built from the Unicode Character
+// Database by cmd/normalization, which downloads it, so it is run by hand
+// with `go run cmd/normalization/normalization.go` rather than go generate.
+type combiningClass struct {
+	lo, hi rune
+	class  uint8
+}
+
+type decomposition struct {
+	r          rune
+	start, end uint16
+}
+
+type composition struct {
+	a, b, c rune
+}
+
+// normalize returns the runes of s in the normalization form n.
+// With pattern set, the decomposed characters which have a special meaning
+// in a pattern are escaped. s is never modified.
+func (n Normalization) normalize(s []rune, pattern bool) []rune {
+	if n == NoNormalization {
+		return s
+	}
+
+	ascii := true
+	for _, r := range s {
+		if r >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+
+	// Full decomposition
+	out := make([]rune, 0, len(s)+len(s)/2)
+	escaped := false
+	for _, r := range s {
+		d := n.decompose(r)
+		if d == nil {
+			out = append(out, r)
+			escaped = pattern && r == '\\' && !escaped
+			continue
+		}
+
+		for i, c := range d {
+			if pattern && isSpecial(c) && (i > 0 || !escaped) {
+				out = append(out, '\\')
+			}
+			out = append(out, c)
+		}
+		escaped = false
+	}
+
+	// Canonical ordering of the combining marks
+	for i := 1; i < len(out); i++ {
+		class := combiningClassOf(out[i])
+		if class == 0 {
+			continue
+		}
+		for j := i; j > 0 && combiningClassOf(out[j-1]) > class; j-- {
+			out[j], out[j-1] = out[j-1], out[j]
+		}
+	}
+
+	// Canonical composition
+	starter, last := 0, combiningClassOf(out[0])
+	if last != 0 {
+		last = 256
+	}
+	w := 1
+	for _, c := range out[1:] {
+		class := combiningClassOf(c)
+		if composed, ok := compose(out[starter], c); ok && (last < class || last == 0) {
+			out[starter] = composed
+			continue
+		}
+
+		if class == 0 {
+			starter = w
+		}
+		last = class
+		out[w] = c
+		w++
+	}
+
+	return out[:w]
+}
+
+// decompose returns the full decomposition of r, nil if r does not decompose.
+func (n Normalization) decompose(r rune) []rune {
+	if r < 0xa0 {
+		return nil
+	}
+	if r >= hangulBase && r < hangulBase+hangulCount {
+		i := r - hangulBase
+		if i%hangulTs == 0 {
+			return []rune{hangulL + i/hangulVT, hangulV + i%hangulVT/hangulTs}
+		}
+		return []rune{hangulL + i/hangulVT, hangulV + i%hangulVT/hangulTs, hangulT + i%hangulTs}
+	}
+
+	table, runes := canonicalDecompositions, canonicalRunes
+	if n == NFKC {
+		table, runes = compatibilityDecompositions, compatibilityRunes
+	}
+
+	i := sort.Search(len(table), func(i int) bool { return table[i].r >= r })
+	if i == len(table) || table[i].r != r {
+		return nil
+	}
+
+	return runes[table[i].start:table[i].end]
+}
+
+// compose returns the primary composite of the pair a and b.
+func compose(a, b rune) (rune, bool) {
+	switch {
+	case a >= hangulL && a < hangulL+19 && b >= hangulV && b < hangulV+21:
+		return hangulBase + (a-hangulL)*hangulVT + (b-hangulV)*hangulTs, true
+	case a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%hangulTs == 0 &&
+		b > hangulT && b < hangulT+hangulTs:
+		return a + b - hangulT, true
+	case b < 0x300:
+		return 0, false
+	}
+
+	i := sort.Search(len(compositions), func(i int) bool {
+		c := compositions[i]
+		return c.a > a || c.a == a && c.b >= b
+	})
+	if i == len(compositions) || compositions[i].a != a || compositions[i].b != b {
+		return 0, false
+	}
+
+	return compositions[i].c, true
+}
+
+// combiningClassOf returns the canonical combining class of r.
+func combiningClassOf(r rune) int {
+	if r < 0x300 {
+		return 0
+	}
+
+	i := sort.Search(len(combiningClasses), func(i int) bool { return combiningClasses[i].hi >= r })
+	if i == len(combiningClasses) || combiningClasses[i].lo > r {
+		return 0
+	}
+
+	return int(combiningClasses[i].class)
+}
+
+// isSpecial reports whether c has a special meaning in a pattern.
+func isSpecial(c rune) bool {
+	switch c {
+	case '*', '?', '.', '\\', '[', ']', '{', '}', ',', '!', '^', '-':
+		return true
+	}
+
+	return false
+}
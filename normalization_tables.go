@@ -0,0 +1,10437 @@
+// Code generated by cmd/normalization/normalization.go; DO NOT EDIT.
+// This file was generated using UnicodeData.txt and CompositionExclusions.txt
+// from the Unicode Character Database 17.0.0
+
+package wildcard
+
+var combiningClasses = []combiningClass{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0897, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ADC, 230},
+	{0x1ADD, 0x1ADD, 220},
+	{0x1AE0, 0x1AE5, 230},
+	{0x1AE6, 0x1AE6, 220},
+	{0x1AE7, 0x1AEA, 230},
+	{0x1AEB, 0x1AEB, 234},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10D69, 0x10D6D, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFA, 0x10EFB, 220},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x113CE, 0x113D0, 9},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x1612F, 0x1612F, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E5EE, 0x1E5EE, 230},
+	{0x1E5EF, 0x1E5EF, 220},
+	{0x1E6E3, 0x1E6E3, 230},
+	{0x1E6E6, 0x1E6E6, 230},
+	{0x1E6EE, 0x1E6EF, 230},
+	{0x1E6F5, 0x1E6F5, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+var canonicalDecompositions = []decomposition{
+	{0x00C0, 0, 2},
+	{0x00C1, 2, 4},
+	{0x00C2, 4, 6},
+	{0x00C3, 6, 8},
+	{0x00C4, 8, 10},
+	{0x00C5, 10, 12},
+	{0x00C7, 12, 14},
+	{0x00C8, 14, 16},
+	{0x00C9, 16, 18},
+	{0x00CA, 18, 20},
+	{0x00CB, 20, 22},
+	{0x00CC, 22, 24},
+	{0x00CD, 24, 26},
+	{0x00CE, 26, 28},
+	{0x00CF, 28, 30},
+	{0x00D1, 30, 32},
+	{0x00D2, 32, 34},
+	{0x00D3, 34, 36},
+	{0x00D4, 36, 38},
+	{0x00D5, 38, 40},
+	{0x00D6, 40, 42},
+	{0x00D9, 42, 44},
+	{0x00DA, 44, 46},
+	{0x00DB, 46, 48},
+	{0x00DC, 48, 50},
+	{0x00DD, 50, 52},
+	{0x00E0, 52, 54},
+	{0x00E1, 54, 56},
+	{0x00E2, 56, 58},
+	{0x00E3, 58, 60},
+	{0x00E4, 60, 62},
+	{0x00E5, 62, 64},
+	{0x00E7, 64, 66},
+	{0x00E8, 66, 68},
+	{0x00E9, 68, 70},
+	{0x00EA, 70, 72},
+	{0x00EB, 72, 74},
+	{0x00EC, 74, 76},
+	{0x00ED, 76, 78},
+	{0x00EE, 78, 80},
+	{0x00EF, 80, 82},
+	{0x00F1, 82, 84},
+	{0x00F2, 84, 86},
+	{0x00F3, 86, 88},
+	{0x00F4, 88, 90},
+	{0x00F5, 90, 92},
+	{0x00F6, 92, 94},
+	{0x00F9, 94, 96},
+	{0x00FA, 96, 98},
+	{0x00FB, 98, 100},
+	{0x00FC, 100, 102},
+	{0x00FD, 102, 104},
+	{0x00FF, 104, 106},
+	{0x0100, 106, 108},
+	{0x0101, 108, 110},
+	{0x0102, 110, 112},
+	{0x0103, 112, 114},
+	{0x0104, 114, 116},
+	{0x0105, 116, 118},
+	{0x0106, 118, 120},
+	{0x0107, 120, 122},
+	{0x0108, 122, 124},
+	{0x0109, 124, 126},
+	{0x010A, 126, 128},
+	{0x010B, 128, 130},
+	{0x010C, 130, 132},
+	{0x010D, 132, 134},
+	{0x010E, 134, 136},
+	{0x010F, 136, 138},
+	{0x0112, 138, 140},
+	{0x0113, 140, 142},
+	{0x0114, 142, 144},
+	{0x0115, 144, 146},
+	{0x0116, 146, 148},
+	{0x0117, 148, 150},
+	{0x0118, 150, 152},
+	{0x0119, 152, 154},
+	{0x011A, 154, 156},
+	{0x011B, 156, 158},
+	{0x011C, 158, 160},
+	{0x011D, 160, 162},
+	{0x011E, 162, 164},
+	{0x011F, 164, 166},
+	{0x0120, 166, 168},
+	{0x0121, 168, 170},
+	{0x0122, 170, 172},
+	{0x0123, 172, 174},
+	{0x0124, 174, 176},
+	{0x0125, 176, 178},
+	{0x0128, 178, 180},
+	{0x0129, 180, 182},
+	{0x012A, 182, 184},
+	{0x012B, 184, 186},
+	{0x012C, 186, 188},
+	{0x012D, 188, 190},
+	{0x012E, 190, 192},
+	{0x012F, 192, 194},
+	{0x0130, 194, 196},
+	{0x0134, 196, 198},
+	{0x0135, 198, 200},
+	{0x0136, 200, 202},
+	{0x0137, 202, 204},
+	{0x0139, 204, 206},
+	{0x013A, 206, 208},
+	{0x013B, 208, 210},
+	{0x013C, 210, 212},
+	{0x013D, 212, 214},
+	{0x013E, 214, 216},
+	{0x0143, 216, 218},
+	{0x0144, 218, 220},
+	{0x0145, 220, 222},
+	{0x0146, 222, 224},
+	{0x0147, 224, 226},
+	{0x0148, 226, 228},
+	{0x014C, 228, 230},
+	{0x014D, 230, 232},
+	{0x014E, 232, 234},
+	{0x014F, 234, 236},
+	{0x0150, 236, 238},
+	{0x0151, 238, 240},
+	{0x0154, 240, 242},
+	{0x0155, 242, 244},
+	{0x0156, 244, 246},
+	{0x0157, 246, 248},
+	{0x0158, 248, 250},
+	{0x0159, 250, 252},
+	{0x015A, 252, 254},
+	{0x015B, 254, 256},
+	{0x015C, 256, 258},
+	{0x015D, 258, 260},
+	{0x015E, 260, 262},
+	{0x015F, 262, 264},
+	{0x0160, 264, 266},
+	{0x0161, 266, 268},
+	{0x0162, 268, 270},
+	{0x0163, 270, 272},
+	{0x0164, 272, 274},
+	{0x0165, 274, 276},
+	{0x0168, 276, 278},
+	{0x0169, 278, 280},
+	{0x016A, 280, 282},
+	{0x016B, 282, 284},
+	{0x016C, 284, 286},
+	{0x016D, 286, 288},
+	{0x016E, 288, 290},
+	{0x016F, 290, 292},
+	{0x0170, 292, 294},
+	{0x0171, 294, 296},
+	{0x0172, 296, 298},
+	{0x0173, 298, 300},
+	{0x0174, 300, 302},
+	{0x0175, 302, 304},
+	{0x0176, 304, 306},
+	{0x0177, 306, 308},
+	{0x0178, 308, 310},
+	{0x0179, 310, 312},
+	{0x017A, 312, 314},
+	{0x017B, 314, 316},
+	{0x017C, 316, 318},
+	{0x017D, 318, 320},
+	{0x017E, 320, 322},
+	{0x01A0, 322, 324},
+	{0x01A1, 324, 326},
+	{0x01AF, 326, 328},
+	{0x01B0, 328, 330},
+	{0x01CD, 330, 332},
+	{0x01CE, 332, 334},
+	{0x01CF, 334, 336},
+	{0x01D0, 336, 338},
+	{0x01D1, 338, 340},
+	{0x01D2, 340, 342},
+	{0x01D3, 342, 344},
+	{0x01D4, 344, 346},
+	{0x01D5, 346, 349},
+	{0x01D6, 349, 352},
+	{0x01D7, 352, 355},
+	{0x01D8, 355, 358},
+	{0x01D9, 358, 361},
+	{0x01DA, 361, 364},
+	{0x01DB, 364, 367},
+	{0x01DC, 367, 370},
+	{0x01DE, 370, 373},
+	{0x01DF, 373, 376},
+	{0x01E0, 376, 379},
+	{0x01E1, 379, 382},
+	{0x01E2, 382, 384},
+	{0x01E3, 384, 386},
+	{0x01E6, 386, 388},
+	{0x01E7, 388, 390},
+	{0x01E8, 390, 392},
+	{0x01E9, 392, 394},
+	{0x01EA, 394, 396},
+	{0x01EB, 396, 398},
+	{0x01EC, 398, 401},
+	{0x01ED, 401, 404},
+	{0x01EE, 404, 406},
+	{0x01EF, 406, 408},
+	{0x01F0, 408, 410},
+	{0x01F4, 410, 412},
+	{0x01F5, 412, 414},
+	{0x01F8, 414, 416},
+	{0x01F9, 416, 418},
+	{0x01FA, 418, 421},
+	{0x01FB, 421, 424},
+	{0x01FC, 424, 426},
+	{0x01FD, 426, 428},
+	{0x01FE, 428, 430},
+	{0x01FF, 430, 432},
+	{0x0200, 432, 434},
+	{0x0201, 434, 436},
+	{0x0202, 436, 438},
+	{0x0203, 438, 440},
+	{0x0204, 440, 442},
+	{0x0205, 442, 444},
+	{0x0206, 444, 446},
+	{0x0207, 446, 448},
+	{0x0208, 448, 450},
+	{0x0209, 450, 452},
+	{0x020A, 452, 454},
+	{0x020B, 454, 456},
+	{0x020C, 456, 458},
+	{0x020D, 458, 460},
+	{0x020E, 460, 462},
+	{0x020F, 462, 464},
+	{0x0210, 464, 466},
+	{0x0211, 466, 468},
+	{0x0212, 468, 470},
+	{0x0213, 470, 472},
+	{0x0214, 472, 474},
+	{0x0215, 474, 476},
+	{0x0216, 476, 478},
+	{0x0217, 478, 480},
+	{0x0218, 480, 482},
+	{0x0219, 482, 484},
+	{0x021A, 484, 486},
+	{0x021B, 486, 488},
+	{0x021E, 488, 490},
+	{0x021F, 490, 492},
+	{0x0226, 492, 494},
+	{0x0227, 494, 496},
+	{0x0228, 496, 498},
+	{0x0229, 498, 500},
+	{0x022A, 500, 503},
+	{0x022B, 503, 506},
+	{0x022C, 506, 509},
+	{0x022D, 509, 512},
+	{0x022E, 512, 514},
+	{0x022F, 514, 516},
+	{0x0230, 516, 519},
+	{0x0231, 519, 522},
+	{0x0232, 522, 524},
+	{0x0233, 524, 526},
+	{0x0340, 526, 527},
+	{0x0341, 527, 528},
+	{0x0343, 528, 529},
+	{0x0344, 529, 531},
+	{0x0374, 531, 532},
+	{0x037E, 532, 533},
+	{0x0385, 533, 535},
+	{0x0386, 535, 537},
+	{0x0387, 537, 538},
+	{0x0388, 538, 540},
+	{0x0389, 540, 542},
+	{0x038A, 542, 544},
+	{0x038C, 544, 546},
+	{0x038E, 546, 548},
+	{0x038F, 548, 550},
+	{0x0390, 550, 553},
+	{0x03AA, 553, 555},
+	{0x03AB, 555, 557},
+	{0x03AC, 557, 559},
+	{0x03AD, 559, 561},
+	{0x03AE, 561, 563},
+	{0x03AF, 563, 565},
+	{0x03B0, 565, 568},
+	{0x03CA, 568, 570},
+	{0x03CB, 570, 572},
+	{0x03CC, 572, 574},
+	{0x03CD, 574, 576},
+	{0x03CE, 576, 578},
+	{0x03D3, 578, 580},
+	{0x03D4, 580, 582},
+	{0x0400, 582, 584},
+	{0x0401, 584, 586},
+	{0x0403, 586, 588},
+	{0x0407, 588, 590},
+	{0x040C, 590, 592},
+	{0x040D, 592, 594},
+	{0x040E, 594, 596},
+	{0x0419, 596, 598},
+	{0x0439, 598, 600},
+	{0x0450, 600, 602},
+	{0x0451, 602, 604},
+	{0x0453, 604, 606},
+	{0x0457, 606, 608},
+	{0x045C, 608, 610},
+	{0x045D, 610, 612},
+	{0x045E, 612, 614},
+	{0x0476, 614, 616},
+	{0x0477, 616, 618},
+	{0x04C1, 618, 620},
+	{0x04C2, 620, 622},
+	{0x04D0, 622, 624},
+	{0x04D1, 624, 626},
+	{0x04D2, 626, 628},
+	{0x04D3, 628, 630},
+	{0x04D6, 630, 632},
+	{0x04D7, 632, 634},
+	{0x04DA, 634, 636},
+	{0x04DB, 636, 638},
+	{0x04DC, 638, 640},
+	{0x04DD, 640, 642},
+	{0x04DE, 642, 644},
+	{0x04DF, 644, 646},
+	{0x04E2, 646, 648},
+	{0x04E3, 648, 650},
+	{0x04E4, 650, 652},
+	{0x04E5, 652, 654},
+	{0x04E6, 654, 656},
+	{0x04E7, 656, 658},
+	{0x04EA, 658, 660},
+	{0x04EB, 660, 662},
+	{0x04EC, 662, 664},
+	{0x04ED, 664, 666},
+	{0x04EE, 666, 668},
+	{0x04EF, 668, 670},
+	{0x04F0, 670, 672},
+	{0x04F1, 672, 674},
+	{0x04F2, 674, 676},
+	{0x04F3, 676, 678},
+	{0x04F4, 678, 680},
+	{0x04F5, 680, 682},
+	{0x04F8, 682, 684},
+	{0x04F9, 684, 686},
+	{0x0622, 686, 688},
+	{0x0623, 688, 690},
+	{0x0624, 690, 692},
+	{0x0625, 692, 694},
+	{0x0626, 694, 696},
+	{0x06C0, 696, 698},
+	{0x06C2, 698, 700},
+	{0x06D3, 700, 702},
+	{0x0929, 702, 704},
+	{0x0931, 704, 706},
+	{0x0934, 706, 708},
+	{0x0958, 708, 710},
+	{0x0959, 710, 712},
+	{0x095A, 712, 714},
+	{0x095B, 714, 716},
+	{0x095C, 716, 718},
+	{0x095D, 718, 720},
+	{0x095E, 720, 722},
+	{0x095F, 722, 724},
+	{0x09CB, 724, 726},
+	{0x09CC, 726, 728},
+	{0x09DC, 728, 730},
+	{0x09DD, 730, 732},
+	{0x09DF, 732, 734},
+	{0x0A33, 734, 736},
+	{0x0A36, 736, 738},
+	{0x0A59, 738, 740},
+	{0x0A5A, 740, 742},
+	{0x0A5B, 742, 744},
+	{0x0A5E, 744, 746},
+	{0x0B48, 746, 748},
+	{0x0B4B, 748, 750},
+	{0x0B4C, 750, 752},
+	{0x0B5C, 752, 754},
+	{0x0B5D, 754, 756},
+	{0x0B94, 756, 758},
+	{0x0BCA, 758, 760},
+	{0x0BCB, 760, 762},
+	{0x0BCC, 762, 764},
+	{0x0C48, 764, 766},
+	{0x0CC0, 766, 768},
+	{0x0CC7, 768, 770},
+	{0x0CC8, 770, 772},
+	{0x0CCA, 772, 774},
+	{0x0CCB, 774, 777},
+	{0x0D4A, 777, 779},
+	{0x0D4B, 779, 781},
+	{0x0D4C, 781, 783},
+	{0x0DDA, 783, 785},
+	{0x0DDC, 785, 787},
+	{0x0DDD, 787, 790},
+	{0x0DDE, 790, 792},
+	{0x0F43, 792, 794},
+	{0x0F4D, 794, 796},
+	{0x0F52, 796, 798},
+	{0x0F57, 798, 800},
+	{0x0F5C, 800, 802},
+	{0x0F69, 802, 804},
+	{0x0F73, 804, 806},
+	{0x0F75, 806, 808},
+	{0x0F76, 808, 810},
+	{0x0F78, 810, 812},
+	{0x0F81, 812, 814},
+	{0x0F93, 814, 816},
+	{0x0F9D, 816, 818},
+	{0x0FA2, 818, 820},
+	{0x0FA7, 820, 822},
+	{0x0FAC, 822, 824},
+	{0x0FB9, 824, 826},
+	{0x1026, 826, 828},
+	{0x1B06, 828, 830},
+	{0x1B08, 830, 832},
+	{0x1B0A, 832, 834},
+	{0x1B0C, 834, 836},
+	{0x1B0E, 836, 838},
+	{0x1B12, 838, 840},
+	{0x1B3B, 840, 842},
+	{0x1B3D, 842, 844},
+	{0x1B40, 844, 846},
+	{0x1B41, 846, 848},
+	{0x1B43, 848, 850},
+	{0x1E00, 850, 852},
+	{0x1E01, 852, 854},
+	{0x1E02, 854, 856},
+	{0x1E03, 856, 858},
+	{0x1E04, 858, 860},
+	{0x1E05, 860, 862},
+	{0x1E06, 862, 864},
+	{0x1E07, 864, 866},
+	{0x1E08, 866, 869},
+	{0x1E09, 869, 872},
+	{0x1E0A, 872, 874},
+	{0x1E0B, 874, 876},
+	{0x1E0C, 876, 878},
+	{0x1E0D, 878, 880},
+	{0x1E0E, 880, 882},
+	{0x1E0F, 882, 884},
+	{0x1E10, 884, 886},
+	{0x1E11, 886, 888},
+	{0x1E12, 888, 890},
+	{0x1E13, 890, 892},
+	{0x1E14, 892, 895},
+	{0x1E15, 895, 898},
+	{0x1E16, 898, 901},
+	{0x1E17, 901, 904},
+	{0x1E18, 904, 906},
+	{0x1E19, 906, 908},
+	{0x1E1A, 908, 910},
+	{0x1E1B, 910, 912},
+	{0x1E1C, 912, 915},
+	{0x1E1D, 915, 918},
+	{0x1E1E, 918, 920},
+	{0x1E1F, 920, 922},
+	{0x1E20, 922, 924},
+	{0x1E21, 924, 926},
+	{0x1E22, 926, 928},
+	{0x1E23, 928, 930},
+	{0x1E24, 930, 932},
+	{0x1E25, 932, 934},
+	{0x1E26, 934, 936},
+	{0x1E27, 936, 938},
+	{0x1E28, 938, 940},
+	{0x1E29, 940, 942},
+	{0x1E2A, 942, 944},
+	{0x1E2B, 944, 946},
+	{0x1E2C, 946, 948},
+	{0x1E2D, 948, 950},
+	{0x1E2E, 950, 953},
+	{0x1E2F, 953, 956},
+	{0x1E30, 956, 958},
+	{0x1E31, 958, 960},
+	{0x1E32, 960, 962},
+	{0x1E33, 962, 964},
+	{0x1E34, 964, 966},
+	{0x1E35, 966, 968},
+	{0x1E36, 968, 970},
+	{0x1E37, 970, 972},
+	{0x1E38, 972, 975},
+	{0x1E39, 975, 978},
+	{0x1E3A, 978, 980},
+	{0x1E3B, 980, 982},
+	{0x1E3C, 982, 984},
+	{0x1E3D, 984, 986},
+	{0x1E3E, 986, 988},
+	{0x1E3F, 988, 990},
+	{0x1E40, 990, 992},
+	{0x1E41, 992, 994},
+	{0x1E42, 994, 996},
+	{0x1E43, 996, 998},
+	{0x1E44, 998, 1000},
+	{0x1E45, 1000, 1002},
+	{0x1E46, 1002, 1004},
+	{0x1E47, 1004, 1006},
+	{0x1E48, 1006, 1008},
+	{0x1E49, 1008, 1010},
+	{0x1E4A, 1010, 1012},
+	{0x1E4B, 1012, 1014},
+	{0x1E4C, 1014, 1017},
+	{0x1E4D, 1017, 1020},
+	{0x1E4E, 1020, 1023},
+	{0x1E4F, 1023, 1026},
+	{0x1E50, 1026, 1029},
+	{0x1E51, 1029, 1032},
+	{0x1E52, 1032, 1035},
+	{0x1E53, 1035, 1038},
+	{0x1E54, 1038, 1040},
+	{0x1E55, 1040, 1042},
+	{0x1E56, 1042, 1044},
+	{0x1E57, 1044, 1046},
+	{0x1E58, 1046, 1048},
+	{0x1E59, 1048, 1050},
+	{0x1E5A, 1050, 1052},
+	{0x1E5B, 1052, 1054},
+	{0x1E5C, 1054, 1057},
+	{0x1E5D, 1057, 1060},
+	{0x1E5E, 1060, 1062},
+	{0x1E5F, 1062, 1064},
+	{0x1E60, 1064, 1066},
+	{0x1E61, 1066, 1068},
+	{0x1E62, 1068, 1070},
+	{0x1E63, 1070, 1072},
+	{0x1E64, 1072, 1075},
+	{0x1E65, 1075, 1078},
+	{0x1E66, 1078, 1081},
+	{0x1E67, 1081, 1084},
+	{0x1E68, 1084, 1087},
+	{0x1E69, 1087, 1090},
+	{0x1E6A, 1090, 1092},
+	{0x1E6B, 1092, 1094},
+	{0x1E6C, 1094, 1096},
+	{0x1E6D, 1096, 1098},
+	{0x1E6E, 1098, 1100},
+	{0x1E6F, 1100, 1102},
+	{0x1E70, 1102, 1104},
+	{0x1E71, 1104, 1106},
+	{0x1E72, 1106, 1108},
+	{0x1E73, 1108, 1110},
+	{0x1E74, 1110, 1112},
+	{0x1E75, 1112, 1114},
+	{0x1E76, 1114, 1116},
+	{0x1E77, 1116, 1118},
+	{0x1E78, 1118, 1121},
+	{0x1E79, 1121, 1124},
+	{0x1E7A, 1124, 1127},
+	{0x1E7B, 1127, 1130},
+	{0x1E7C, 1130, 1132},
+	{0x1E7D, 1132, 1134},
+	{0x1E7E, 1134, 1136},
+	{0x1E7F, 1136, 1138},
+	{0x1E80, 1138, 1140},
+	{0x1E81, 1140, 1142},
+	{0x1E82, 1142, 1144},
+	{0x1E83, 1144, 1146},
+	{0x1E84, 1146, 1148},
+	{0x1E85, 1148, 1150},
+	{0x1E86, 1150, 1152},
+	{0x1E87, 1152, 1154},
+	{0x1E88, 1154, 1156},
+	{0x1E89, 1156, 1158},
+	{0x1E8A, 1158, 1160},
+	{0x1E8B, 1160, 1162},
+	{0x1E8C, 1162, 1164},
+	{0x1E8D, 1164, 1166},
+	{0x1E8E, 1166, 1168},
+	{0x1E8F, 1168, 1170},
+	{0x1E90, 1170, 1172},
+	{0x1E91, 1172, 1174},
+	{0x1E92, 1174, 1176},
+	{0x1E93, 1176, 1178},
+	{0x1E94, 1178, 1180},
+	{0x1E95, 1180, 1182},
+	{0x1E96, 1182, 1184},
+	{0x1E97, 1184, 1186},
+	{0x1E98, 1186, 1188},
+	{0x1E99, 1188, 1190},
+	{0x1E9B, 1190, 1192},
+	{0x1EA0, 1192, 1194},
+	{0x1EA1, 1194, 1196},
+	{0x1EA2, 1196, 1198},
+	{0x1EA3, 1198, 1200},
+	{0x1EA4, 1200, 1203},
+	{0x1EA5, 1203, 1206},
+	{0x1EA6, 1206, 1209},
+	{0x1EA7, 1209, 1212},
+	{0x1EA8, 1212, 1215},
+	{0x1EA9, 1215, 1218},
+	{0x1EAA, 1218, 1221},
+	{0x1EAB, 1221, 1224},
+	{0x1EAC, 1224, 1227},
+	{0x1EAD, 1227, 1230},
+	{0x1EAE, 1230, 1233},
+	{0x1EAF, 1233, 1236},
+	{0x1EB0, 1236, 1239},
+	{0x1EB1, 1239, 1242},
+	{0x1EB2, 1242, 1245},
+	{0x1EB3, 1245, 1248},
+	{0x1EB4, 1248, 1251},
+	{0x1EB5, 1251, 1254},
+	{0x1EB6, 1254, 1257},
+	{0x1EB7, 1257, 1260},
+	{0x1EB8, 1260, 1262},
+	{0x1EB9, 1262, 1264},
+	{0x1EBA, 1264, 1266},
+	{0x1EBB, 1266, 1268},
+	{0x1EBC, 1268, 1270},
+	{0x1EBD, 1270, 1272},
+	{0x1EBE, 1272, 1275},
+	{0x1EBF, 1275, 1278},
+	{0x1EC0, 1278, 1281},
+	{0x1EC1, 1281, 1284},
+	{0x1EC2, 1284, 1287},
+	{0x1EC3, 1287, 1290},
+	{0x1EC4, 1290, 1293},
+	{0x1EC5, 1293, 1296},
+	{0x1EC6, 1296, 1299},
+	{0x1EC7, 1299, 1302},
+	{0x1EC8, 1302, 1304},
+	{0x1EC9, 1304, 1306},
+	{0x1ECA, 1306, 1308},
+	{0x1ECB, 1308, 1310},
+	{0x1ECC, 1310, 1312},
+	{0x1ECD, 1312, 1314},
+	{0x1ECE, 1314, 1316},
+	{0x1ECF, 1316, 1318},
+	{0x1ED0, 1318, 1321},
+	{0x1ED1, 1321, 1324},
+	{0x1ED2, 1324, 1327},
+	{0x1ED3, 1327, 1330},
+	{0x1ED4, 1330, 1333},
+	{0x1ED5, 1333, 1336},
+	{0x1ED6, 1336, 1339},
+	{0x1ED7, 1339, 1342},
+	{0x1ED8, 1342, 1345},
+	{0x1ED9, 1345, 1348},
+	{0x1EDA, 1348, 1351},
+	{0x1EDB, 1351, 1354},
+	{0x1EDC, 1354, 1357},
+	{0x1EDD, 1357, 1360},
+	{0x1EDE, 1360, 1363},
+	{0x1EDF, 1363, 1366},
+	{0x1EE0, 1366, 1369},
+	{0x1EE1, 1369, 1372},
+	{0x1EE2, 1372, 1375},
+	{0x1EE3, 1375, 1378},
+	{0x1EE4, 1378, 1380},
+	{0x1EE5, 1380, 1382},
+	{0x1EE6, 1382, 1384},
+	{0x1EE7, 1384, 1386},
+	{0x1EE8, 1386, 1389},
+	{0x1EE9, 1389, 1392},
+	{0x1EEA, 1392, 1395},
+	{0x1EEB, 1395, 1398},
+	{0x1EEC, 1398, 1401},
+	{0x1EED, 1401, 1404},
+	{0x1EEE, 1404, 1407},
+	{0x1EEF, 1407, 1410},
+	{0x1EF0, 1410, 1413},
+	{0x1EF1, 1413, 1416},
+	{0x1EF2, 1416, 1418},
+	{0x1EF3, 1418, 1420},
+	{0x1EF4, 1420, 1422},
+	{0x1EF5, 1422, 1424},
+	{0x1EF6, 1424, 1426},
+	{0x1EF7, 1426, 1428},
+	{0x1EF8, 1428, 1430},
+	{0x1EF9, 1430, 1432},
+	{0x1F00, 1432, 1434},
+	{0x1F01, 1434, 1436},
+	{0x1F02, 1436, 1439},
+	{0x1F03, 1439, 1442},
+	{0x1F04, 1442, 1445},
+	{0x1F05, 1445, 1448},
+	{0x1F06, 1448, 1451},
+	{0x1F07, 1451, 1454},
+	{0x1F08, 1454, 1456},
+	{0x1F09, 1456, 1458},
+	{0x1F0A, 1458, 1461},
+	{0x1F0B, 1461, 1464},
+	{0x1F0C, 1464, 1467},
+	{0x1F0D, 1467, 1470},
+	{0x1F0E, 1470, 1473},
+	{0x1F0F, 1473, 1476},
+	{0x1F10, 1476, 1478},
+	{0x1F11, 1478, 1480},
+	{0x1F12, 1480, 1483},
+	{0x1F13, 1483, 1486},
+	{0x1F14, 1486, 1489},
+	{0x1F15, 1489, 1492},
+	{0x1F18, 1492, 1494},
+	{0x1F19, 1494, 1496},
+	{0x1F1A, 1496, 1499},
+	{0x1F1B, 1499, 1502},
+	{0x1F1C, 1502, 1505},
+	{0x1F1D, 1505, 1508},
+	{0x1F20, 1508, 1510},
+	{0x1F21, 1510, 1512},
+	{0x1F22, 1512, 1515},
+	{0x1F23, 1515, 1518},
+	{0x1F24, 1518, 1521},
+	{0x1F25, 1521, 1524},
+	{0x1F26, 1524, 1527},
+	{0x1F27, 1527, 1530},
+	{0x1F28, 1530, 1532},
+	{0x1F29, 1532, 1534},
+	{0x1F2A, 1534, 1537},
+	{0x1F2B, 1537, 1540},
+	{0x1F2C, 1540, 1543},
+	{0x1F2D, 1543, 1546},
+	{0x1F2E, 1546, 1549},
+	{0x1F2F, 1549, 1552},
+	{0x1F30, 1552, 1554},
+	{0x1F31, 1554, 1556},
+	{0x1F32, 1556, 1559},
+	{0x1F33, 1559, 1562},
+	{0x1F34, 1562, 1565},
+	{0x1F35, 1565, 1568},
+	{0x1F36, 1568, 1571},
+	{0x1F37, 1571, 1574},
+	{0x1F38, 1574, 1576},
+	{0x1F39, 1576, 1578},
+	{0x1F3A, 1578, 1581},
+	{0x1F3B, 1581, 1584},
+	{0x1F3C, 1584, 1587},
+	{0x1F3D, 1587, 1590},
+	{0x1F3E, 1590, 1593},
+	{0x1F3F, 1593, 1596},
+	{0x1F40, 1596, 1598},
+	{0x1F41, 1598, 1600},
+	{0x1F42, 1600, 1603},
+	{0x1F43, 1603, 1606},
+	{0x1F44, 1606, 1609},
+	{0x1F45, 1609, 1612},
+	{0x1F48, 1612, 1614},
+	{0x1F49, 1614, 1616},
+	{0x1F4A, 1616, 1619},
+	{0x1F4B, 1619, 1622},
+	{0x1F4C, 1622, 1625},
+	{0x1F4D, 1625, 1628},
+	{0x1F50, 1628, 1630},
+	{0x1F51, 1630, 1632},
+	{0x1F52, 1632, 1635},
+	{0x1F53, 1635, 1638},
+	{0x1F54, 1638, 1641},
+	{0x1F55, 1641, 1644},
+	{0x1F56, 1644, 1647},
+	{0x1F57, 1647, 1650},
+	{0x1F59, 1650, 1652},
+	{0x1F5B, 1652, 1655},
+	{0x1F5D, 1655, 1658},
+	{0x1F5F, 1658, 1661},
+	{0x1F60, 1661, 1663},
+	{0x1F61, 1663, 1665},
+	{0x1F62, 1665, 1668},
+	{0x1F63, 1668, 1671},
+	{0x1F64, 1671, 1674},
+	{0x1F65, 1674, 1677},
+	{0x1F66, 1677, 1680},
+	{0x1F67, 1680, 1683},
+	{0x1F68, 1683, 1685},
+	{0x1F69, 1685, 1687},
+	{0x1F6A, 1687, 1690},
+	{0x1F6B, 1690, 1693},
+	{0x1F6C, 1693, 1696},
+	{0x1F6D, 1696, 1699},
+	{0x1F6E, 1699, 1702},
+	{0x1F6F, 1702, 1705},
+	{0x1F70, 1705, 1707},
+	{0x1F71, 1707, 1709},
+	{0x1F72, 1709, 1711},
+	{0x1F73, 1711, 1713},
+	{0x1F74, 1713, 1715},
+	{0x1F75, 1715, 1717},
+	{0x1F76, 1717, 1719},
+	{0x1F77, 1719, 1721},
+	{0x1F78, 1721, 1723},
+	{0x1F79, 1723, 1725},
+	{0x1F7A, 1725, 1727},
+	{0x1F7B, 1727, 1729},
+	{0x1F7C, 1729, 1731},
+	{0x1F7D, 1731, 1733},
+	{0x1F80, 1733, 1736},
+	{0x1F81, 1736, 1739},
+	{0x1F82, 1739, 1743},
+	{0x1F83, 1743, 1747},
+	{0x1F84, 1747, 1751},
+	{0x1F85, 1751, 1755},
+	{0x1F86, 1755, 1759},
+	{0x1F87, 1759, 1763},
+	{0x1F88, 1763, 1766},
+	{0x1F89, 1766, 1769},
+	{0x1F8A, 1769, 1773},
+	{0x1F8B, 1773, 1777},
+	{0x1F8C, 1777, 1781},
+	{0x1F8D, 1781, 1785},
+	{0x1F8E, 1785, 1789},
+	{0x1F8F, 1789, 1793},
+	{0x1F90, 1793, 1796},
+	{0x1F91, 1796, 1799},
+	{0x1F92, 1799, 1803},
+	{0x1F93, 1803, 1807},
+	{0x1F94, 1807, 1811},
+	{0x1F95, 1811, 1815},
+	{0x1F96, 1815, 1819},
+	{0x1F97, 1819, 1823},
+	{0x1F98, 1823, 1826},
+	{0x1F99, 1826, 1829},
+	{0x1F9A, 1829, 1833},
+	{0x1F9B, 1833, 1837},
+	{0x1F9C, 1837, 1841},
+	{0x1F9D, 1841, 1845},
+	{0x1F9E, 1845, 1849},
+	{0x1F9F, 1849, 1853},
+	{0x1FA0, 1853, 1856},
+	{0x1FA1, 1856, 1859},
+	{0x1FA2, 1859, 1863},
+	{0x1FA3, 1863, 1867},
+	{0x1FA4, 1867, 1871},
+	{0x1FA5, 1871, 1875},
+	{0x1FA6, 1875, 1879},
+	{0x1FA7, 1879, 1883},
+	{0x1FA8, 1883, 1886},
+	{0x1FA9, 1886, 1889},
+	{0x1FAA, 1889, 1893},
+	{0x1FAB, 1893, 1897},
+	{0x1FAC, 1897, 1901},
+	{0x1FAD, 1901, 1905},
+	{0x1FAE, 1905, 1909},
+	{0x1FAF, 1909, 1913},
+	{0x1FB0, 1913, 1915},
+	{0x1FB1, 1915, 1917},
+	{0x1FB2, 1917, 1920},
+	{0x1FB3, 1920, 1922},
+	{0x1FB4, 1922, 1925},
+	{0x1FB6, 1925, 1927},
+	{0x1FB7, 1927, 1930},
+	{0x1FB8, 1930, 1932},
+	{0x1FB9, 1932, 1934},
+	{0x1FBA, 1934, 1936},
+	{0x1FBB, 1936, 1938},
+	{0x1FBC, 1938, 1940},
+	{0x1FBE, 1940, 1941},
+	{0x1FC1, 1941, 1943},
+	{0x1FC2, 1943, 1946},
+	{0x1FC3, 1946, 1948},
+	{0x1FC4, 1948, 1951},
+	{0x1FC6, 1951, 1953},
+	{0x1FC7, 1953, 1956},
+	{0x1FC8, 1956, 1958},
+	{0x1FC9, 1958, 1960},
+	{0x1FCA, 1960, 1962},
+	{0x1FCB, 1962, 1964},
+	{0x1FCC, 1964, 1966},
+	{0x1FCD, 1966, 1968},
+	{0x1FCE, 1968, 1970},
+	{0x1FCF, 1970, 1972},
+	{0x1FD0, 1972, 1974},
+	{0x1FD1, 1974, 1976},
+	{0x1FD2, 1976, 1979},
+	{0x1FD3, 1979, 1982},
+	{0x1FD6, 1982, 1984},
+	{0x1FD7, 1984, 1987},
+	{0x1FD8, 1987, 1989},
+	{0x1FD9, 1989, 1991},
+	{0x1FDA, 1991, 1993},
+	{0x1FDB, 1993, 1995},
+	{0x1FDD, 1995, 1997},
+	{0x1FDE, 1997, 1999},
+	{0x1FDF, 1999, 2001},
+	{0x1FE0, 2001, 2003},
+	{0x1FE1, 2003, 2005},
+	{0x1FE2, 2005, 2008},
+	{0x1FE3, 2008, 2011},
+	{0x1FE4, 2011, 2013},
+	{0x1FE5, 2013, 2015},
+	{0x1FE6, 2015, 2017},
+	{0x1FE7, 2017, 2020},
+	{0x1FE8, 2020, 2022},
+	{0x1FE9, 2022, 2024},
+	{0x1FEA, 2024, 2026},
+	{0x1FEB, 2026, 2028},
+	{0x1FEC, 2028, 2030},
+	{0x1FED, 2030, 2032},
+	{0x1FEE, 2032, 2034},
+	{0x1FEF, 2034, 2035},
+	{0x1FF2, 2035, 2038},
+	{0x1FF3, 2038, 2040},
+	{0x1FF4, 2040, 2043},
+	{0x1FF6, 2043, 2045},
+	{0x1FF7, 2045, 2048},
+	{0x1FF8, 2048, 2050},
+	{0x1FF9, 2050, 2052},
+	{0x1FFA, 2052, 2054},
+	{0x1FFB, 2054, 2056},
+	{0x1FFC, 2056, 2058},
+	{0x1FFD, 2058, 2059},
+	{0x2000, 2059, 2060},
+	{0x2001, 2060, 2061},
+	{0x2126, 2061, 2062},
+	{0x212A, 2062, 2063},
+	{0x212B, 2063, 2065},
+	{0x219A, 2065, 2067},
+	{0x219B, 2067, 2069},
+	{0x21AE, 2069, 2071},
+	{0x21CD, 2071, 2073},
+	{0x21CE, 2073, 2075},
+	{0x21CF, 2075, 2077},
+	{0x2204, 2077, 2079},
+	{0x2209, 2079, 2081},
+	{0x220C, 2081, 2083},
+	{0x2224, 2083, 2085},
+	{0x2226, 2085, 2087},
+	{0x2241, 2087, 2089},
+	{0x2244, 2089, 2091},
+	{0x2247, 2091, 2093},
+	{0x2249, 2093, 2095},
+	{0x2260, 2095, 2097},
+	{0x2262, 2097, 2099},
+	{0x226D, 2099, 2101},
+	{0x226E, 2101, 2103},
+	{0x226F, 2103, 2105},
+	{0x2270, 2105, 2107},
+	{0x2271, 2107, 2109},
+	{0x2274, 2109, 2111},
+	{0x2275, 2111, 2113},
+	{0x2278, 2113, 2115},
+	{0x2279, 2115, 2117},
+	{0x2280, 2117, 2119},
+	{0x2281, 2119, 2121},
+	{0x2284, 2121, 2123},
+	{0x2285, 2123, 2125},
+	{0x2288, 2125, 2127},
+	{0x2289, 2127, 2129},
+	{0x22AC, 2129, 2131},
+	{0x22AD, 2131, 2133},
+	{0x22AE, 2133, 2135},
+	{0x22AF, 2135, 2137},
+	{0x22E0, 2137, 2139},
+	{0x22E1, 2139, 2141},
+	{0x22E2, 2141, 2143},
+	{0x22E3, 2143, 2145},
+	{0x22EA, 2145, 2147},
+	{0x22EB, 2147, 2149},
+	{0x22EC, 2149, 2151},
+	{0x22ED, 2151, 2153},
+	{0x2329, 2153, 2154},
+	{0x232A, 2154, 2155},
+	{0x2ADC, 2155, 2157},
+	{0x304C, 2157, 2159},
+	{0x304E, 2159, 2161},
+	{0x3050, 2161, 2163},
+	{0x3052, 2163, 2165},
+	{0x3054, 2165, 2167},
+	{0x3056, 2167, 2169},
+	{0x3058, 2169, 2171},
+	{0x305A, 2171, 2173},
+	{0x305C, 2173, 2175},
+	{0x305E, 2175, 2177},
+	{0x3060, 2177, 2179},
+	{0x3062, 2179, 2181},
+	{0x3065, 2181, 2183},
+	{0x3067, 2183, 2185},
+	{0x3069, 2185, 2187},
+	{0x3070, 2187, 2189},
+	{0x3071, 2189, 2191},
+	{0x3073, 2191, 2193},
+	{0x3074, 2193, 2195},
+	{0x3076, 2195, 2197},
+	{0x3077, 2197, 2199},
+	{0x3079, 2199, 2201},
+	{0x307A, 2201, 2203},
+	{0x307C, 2203, 2205},
+	{0x307D, 2205, 2207},
+	{0x3094, 2207, 2209},
+	{0x309E, 2209, 2211},
+	{0x30AC, 2211, 2213},
+	{0x30AE, 2213, 2215},
+	{0x30B0, 2215, 2217},
+	{0x30B2, 2217, 2219},
+	{0x30B4, 2219, 2221},
+	{0x30B6, 2221, 2223},
+	{0x30B8, 2223, 2225},
+	{0x30BA, 2225, 2227},
+	{0x30BC, 2227, 2229},
+	{0x30BE, 2229, 2231},
+	{0x30C0, 2231, 2233},
+	{0x30C2, 2233, 2235},
+	{0x30C5, 2235, 2237},
+	{0x30C7, 2237, 2239},
+	{0x30C9, 2239, 2241},
+	{0x30D0, 2241, 2243},
+	{0x30D1, 2243, 2245},
+	{0x30D3, 2245, 2247},
+	{0x30D4, 2247, 2249},
+	{0x30D6, 2249, 2251},
+	{0x30D7, 2251, 2253},
+	{0x30D9, 2253, 2255},
+	{0x30DA, 2255, 2257},
+	{0x30DC, 2257, 2259},
+	{0x30DD, 2259, 2261},
+	{0x30F4, 2261, 2263},
+	{0x30F7, 2263, 2265},
+	{0x30F8, 2265, 2267},
+	{0x30F9, 2267, 2269},
+	{0x30FA, 2269, 2271},
+	{0x30FE, 2271, 2273},
+	{0xF900, 2273, 2274},
+	{0xF901, 2274, 2275},
+	{0xF902, 2275, 2276},
+	{0xF903, 2276, 2277},
+	{0xF904, 2277, 2278},
+	{0xF905, 2278, 2279},
+	{0xF906, 2279, 2280},
+	{0xF907, 2280, 2281},
+	{0xF908, 2281, 2282},
+	{0xF909, 2282, 2283},
+	{0xF90A, 2283, 2284},
+	{0xF90B, 2284, 2285},
+	{0xF90C, 2285, 2286},
+	{0xF90D, 2286, 2287},
+	{0xF90E, 2287, 2288},
+	{0xF90F, 2288, 2289},
+	{0xF910, 2289, 2290},
+	{0xF911, 2290, 2291},
+	{0xF912, 2291, 2292},
+	{0xF913, 2292, 2293},
+	{0xF914, 2293, 2294},
+	{0xF915, 2294, 2295},
+	{0xF916, 2295, 2296},
+	{0xF917, 2296, 2297},
+	{0xF918, 2297, 2298},
+	{0xF919, 2298, 2299},
+	{0xF91A, 2299, 2300},
+	{0xF91B, 2300, 2301},
+	{0xF91C, 2301, 2302},
+	{0xF91D, 2302, 2303},
+	{0xF91E, 2303, 2304},
+	{0xF91F, 2304, 2305},
+	{0xF920, 2305, 2306},
+	{0xF921, 2306, 2307},
+	{0xF922, 2307, 2308},
+	{0xF923, 2308, 2309},
+	{0xF924, 2309, 2310},
+	{0xF925, 2310, 2311},
+	{0xF926, 2311, 2312},
+	{0xF927, 2312, 2313},
+	{0xF928, 2313, 2314},
+	{0xF929, 2314, 2315},
+	{0xF92A, 2315, 2316},
+	{0xF92B, 2316, 2317},
+	{0xF92C, 2317, 2318},
+	{0xF92D, 2318, 2319},
+	{0xF92E, 2319, 2320},
+	{0xF92F, 2320, 2321},
+	{0xF930, 2321, 2322},
+	{0xF931, 2322, 2323},
+	{0xF932, 2323, 2324},
+	{0xF933, 2324, 2325},
+	{0xF934, 2325, 2326},
+	{0xF935, 2326, 2327},
+	{0xF936, 2327, 2328},
+	{0xF937, 2328, 2329},
+	{0xF938, 2329, 2330},
+	{0xF939, 2330, 2331},
+	{0xF93A, 2331, 2332},
+	{0xF93B, 2332, 2333},
+	{0xF93C, 2333, 2334},
+	{0xF93D, 2334, 2335},
+	{0xF93E, 2335, 2336},
+	{0xF93F, 2336, 2337},
+	{0xF940, 2337, 2338},
+	{0xF941, 2338, 2339},
+	{0xF942, 2339, 2340},
+	{0xF943, 2340, 2341},
+	{0xF944, 2341, 2342},
+	{0xF945, 2342, 2343},
+	{0xF946, 2343, 2344},
+	{0xF947, 2344, 2345},
+	{0xF948, 2345, 2346},
+	{0xF949, 2346, 2347},
+	{0xF94A, 2347, 2348},
+	{0xF94B, 2348, 2349},
+	{0xF94C, 2349, 2350},
+	{0xF94D, 2350, 2351},
+	{0xF94E, 2351, 2352},
+	{0xF94F, 2352, 2353},
+	{0xF950, 2353, 2354},
+	{0xF951, 2354, 2355},
+	{0xF952, 2355, 2356},
+	{0xF953, 2356, 2357},
+	{0xF954, 2357, 2358},
+	{0xF955, 2358, 2359},
+	{0xF956, 2359, 2360},
+	{0xF957, 2360, 2361},
+	{0xF958, 2361, 2362},
+	{0xF959, 2362, 2363},
+	{0xF95A, 2363, 2364},
+	{0xF95B, 2364, 2365},
+	{0xF95C, 2365, 2366},
+	{0xF95D, 2366, 2367},
+	{0xF95E, 2367, 2368},
+	{0xF95F, 2368, 2369},
+	{0xF960, 2369, 2370},
+	{0xF961, 2370, 2371},
+	{0xF962, 2371, 2372},
+	{0xF963, 2372, 2373},
+	{0xF964, 2373, 2374},
+	{0xF965, 2374, 2375},
+	{0xF966, 2375, 2376},
+	{0xF967, 2376, 2377},
+	{0xF968, 2377, 2378},
+	{0xF969, 2378, 2379},
+	{0xF96A, 2379, 2380},
+	{0xF96B, 2380, 2381},
+	{0xF96C, 2381, 2382},
+	{0xF96D, 2382, 2383},
+	{0xF96E, 2383, 2384},
+	{0xF96F, 2384, 2385},
+	{0xF970, 2385, 2386},
+	{0xF971, 2386, 2387},
+	{0xF972, 2387, 2388},
+	{0xF973, 2388, 2389},
+	{0xF974, 2389, 2390},
+	{0xF975, 2390, 2391},
+	{0xF976, 2391, 2392},
+	{0xF977, 2392, 2393},
+	{0xF978, 2393, 2394},
+	{0xF979, 2394, 2395},
+	{0xF97A, 2395, 2396},
+	{0xF97B, 2396, 2397},
+	{0xF97C, 2397, 2398},
+	{0xF97D, 2398, 2399},
+	{0xF97E, 2399, 2400},
+	{0xF97F, 2400, 2401},
+	{0xF980, 2401, 2402},
+	{0xF981, 2402, 2403},
+	{0xF982, 2403, 2404},
+	{0xF983, 2404, 2405},
+	{0xF984, 2405, 2406},
+	{0xF985, 2406, 2407},
+	{0xF986, 2407, 2408},
+	{0xF987, 2408, 2409},
+	{0xF988, 2409, 2410},
+	{0xF989, 2410, 2411},
+	{0xF98A, 2411, 2412},
+	{0xF98B, 2412, 2413},
+	{0xF98C, 2413, 2414},
+	{0xF98D, 2414, 2415},
+	{0xF98E, 2415, 2416},
+	{0xF98F, 2416, 2417},
+	{0xF990, 2417, 2418},
+	{0xF991, 2418, 2419},
+	{0xF992, 2419, 2420},
+	{0xF993, 2420, 2421},
+	{0xF994, 2421, 2422},
+	{0xF995, 2422, 2423},
+	{0xF996, 2423, 2424},
+	{0xF997, 2424, 2425},
+	{0xF998, 2425, 2426},
+	{0xF999, 2426, 2427},
+	{0xF99A, 2427, 2428},
+	{0xF99B, 2428, 2429},
+	{0xF99C, 2429, 2430},
+	{0xF99D, 2430, 2431},
+	{0xF99E, 2431, 2432},
+	{0xF99F, 2432, 2433},
+	{0xF9A0, 2433, 2434},
+	{0xF9A1, 2434, 2435},
+	{0xF9A2, 2435, 2436},
+	{0xF9A3, 2436, 2437},
+	{0xF9A4, 2437, 2438},
+	{0xF9A5, 2438, 2439},
+	{0xF9A6, 2439, 2440},
+	{0xF9A7, 2440, 2441},
+	{0xF9A8, 2441, 2442},
+	{0xF9A9, 2442, 2443},
+	{0xF9AA, 2443, 2444},
+	{0xF9AB, 2444, 2445},
+	{0xF9AC, 2445, 2446},
+	{0xF9AD, 2446, 2447},
+	{0xF9AE, 2447, 2448},
+	{0xF9AF, 2448, 2449},
+	{0xF9B0, 2449, 2450},
+	{0xF9B1, 2450, 2451},
+	{0xF9B2, 2451, 2452},
+	{0xF9B3, 2452, 2453},
+	{0xF9B4, 2453, 2454},
+	{0xF9B5, 2454, 2455},
+	{0xF9B6, 2455, 2456},
+	{0xF9B7, 2456, 2457},
+	{0xF9B8, 2457, 2458},
+	{0xF9B9, 2458, 2459},
+	{0xF9BA, 2459, 2460},
+	{0xF9BB, 2460, 2461},
+	{0xF9BC, 2461, 2462},
+	{0xF9BD, 2462, 2463},
+	{0xF9BE, 2463, 2464},
+	{0xF9BF, 2464, 2465},
+	{0xF9C0, 2465, 2466},
+	{0xF9C1, 2466, 2467},
+	{0xF9C2, 2467, 2468},
+	{0xF9C3, 2468, 2469},
+	{0xF9C4, 2469, 2470},
+	{0xF9C5, 2470, 2471},
+	{0xF9C6, 2471, 2472},
+	{0xF9C7, 2472, 2473},
+	{0xF9C8, 2473, 2474},
+	{0xF9C9, 2474, 2475},
+	{0xF9CA, 2475, 2476},
+	{0xF9CB, 2476, 2477},
+	{0xF9CC, 2477, 2478},
+	{0xF9CD, 2478, 2479},
+	{0xF9CE, 2479, 2480},
+	{0xF9CF, 2480, 2481},
+	{0xF9D0, 2481, 2482},
+	{0xF9D1, 2482, 2483},
+	{0xF9D2, 2483, 2484},
+	{0xF9D3, 2484, 2485},
+	{0xF9D4, 2485, 2486},
+	{0xF9D5, 2486, 2487},
+	{0xF9D6, 2487, 2488},
+	{0xF9D7, 2488, 2489},
+	{0xF9D8, 2489, 2490},
+	{0xF9D9, 2490, 2491},
+	{0xF9DA, 2491, 2492},
+	{0xF9DB, 2492, 2493},
+	{0xF9DC, 2493, 2494},
+	{0xF9DD, 2494, 2495},
+	{0xF9DE, 2495, 2496},
+	{0xF9DF, 2496, 2497},
+	{0xF9E0, 2497, 2498},
+	{0xF9E1, 2498, 2499},
+	{0xF9E2, 2499, 2500},
+	{0xF9E3, 2500, 2501},
+	{0xF9E4, 2501, 2502},
+	{0xF9E5, 2502, 2503},
+	{0xF9E6, 2503, 2504},
+	{0xF9E7, 2504, 2505},
+	{0xF9E8, 2505, 2506},
+	{0xF9E9, 2506, 2507},
+	{0xF9EA, 2507, 2508},
+	{0xF9EB, 2508, 2509},
+	{0xF9EC, 2509, 2510},
+	{0xF9ED, 2510, 2511},
+	{0xF9EE, 2511, 2512},
+	{0xF9EF, 2512, 2513},
+	{0xF9F0, 2513, 2514},
+	{0xF9F1, 2514, 2515},
+	{0xF9F2, 2515, 2516},
+	{0xF9F3, 2516, 2517},
+	{0xF9F4, 2517, 2518},
+	{0xF9F5, 2518, 2519},
+	{0xF9F6, 2519, 2520},
+	{0xF9F7, 2520, 2521},
+	{0xF9F8, 2521, 2522},
+	{0xF9F9, 2522, 2523},
+	{0xF9FA, 2523, 2524},
+	{0xF9FB, 2524, 2525},
+	{0xF9FC, 2525, 2526},
+	{0xF9FD, 2526, 2527},
+	{0xF9FE, 2527, 2528},
+	{0xF9FF, 2528, 2529},
+	{0xFA00, 2529, 2530},
+	{0xFA01, 2530, 2531},
+	{0xFA02, 2531, 2532},
+	{0xFA03, 2532, 2533},
+	{0xFA04, 2533, 2534},
+	{0xFA05, 2534, 2535},
+	{0xFA06, 2535, 2536},
+	{0xFA07, 2536, 2537},
+	{0xFA08, 2537, 2538},
+	{0xFA09, 2538, 2539},
+	{0xFA0A, 2539, 2540},
+	{0xFA0B, 2540, 2541},
+	{0xFA0C, 2541, 2542},
+	{0xFA0D, 2542, 2543},
+	{0xFA10, 2543, 2544},
+	{0xFA12, 2544, 2545},
+	{0xFA15, 2545, 2546},
+	{0xFA16, 2546, 2547},
+	{0xFA17, 2547, 2548},
+	{0xFA18, 2548, 2549},
+	{0xFA19, 2549, 2550},
+	{0xFA1A, 2550, 2551},
+	{0xFA1B, 2551, 2552},
+	{0xFA1C, 2552, 2553},
+	{0xFA1D, 2553, 2554},
+	{0xFA1E, 2554, 2555},
+	{0xFA20, 2555, 2556},
+	{0xFA22, 2556, 2557},
+	{0xFA25, 2557, 2558},
+	{0xFA26, 2558, 2559},
+	{0xFA2A, 2559, 2560},
+	{0xFA2B, 2560, 2561},
+	{0xFA2C, 2561, 2562},
+	{0xFA2D, 2562, 2563},
+	{0xFA2E, 2563, 2564},
+	{0xFA2F, 2564, 2565},
+	{0xFA30, 2565, 2566},
+	{0xFA31, 2566, 2567},
+	{0xFA32, 2567, 2568},
+	{0xFA33, 2568, 2569},
+	{0xFA34, 2569, 2570},
+	{0xFA35, 2570, 2571},
+	{0xFA36, 2571, 2572},
+	{0xFA37, 2572, 2573},
+	{0xFA38, 2573, 2574},
+	{0xFA39, 2574, 2575},
+	{0xFA3A, 2575, 2576},
+	{0xFA3B, 2576, 2577},
+	{0xFA3C, 2577, 2578},
+	{0xFA3D, 2578, 2579},
+	{0xFA3E, 2579, 2580},
+	{0xFA3F, 2580, 2581},
+	{0xFA40, 2581, 2582},
+	{0xFA41, 2582, 2583},
+	{0xFA42, 2583, 2584},
+	{0xFA43, 2584, 2585},
+	{0xFA44, 2585, 2586},
+	{0xFA45, 2586, 2587},
+	{0xFA46, 2587, 2588},
+	{0xFA47, 2588, 2589},
+	{0xFA48, 2589, 2590},
+	{0xFA49, 2590, 2591},
+	{0xFA4A, 2591, 2592},
+	{0xFA4B, 2592, 2593},
+	{0xFA4C, 2593, 2594},
+	{0xFA4D, 2594, 2595},
+	{0xFA4E, 2595, 2596},
+	{0xFA4F, 2596, 2597},
+	{0xFA50, 2597, 2598},
+	{0xFA51, 2598, 2599},
+	{0xFA52, 2599, 2600},
+	{0xFA53, 2600, 2601},
+	{0xFA54, 2601, 2602},
+	{0xFA55, 2602, 2603},
+	{0xFA56, 2603, 2604},
+	{0xFA57, 2604, 2605},
+	{0xFA58, 2605, 2606},
+	{0xFA59, 2606, 2607},
+	{0xFA5A, 2607, 2608},
+	{0xFA5B, 2608, 2609},
+	{0xFA5C, 2609, 2610},
+	{0xFA5D, 2610, 2611},
+	{0xFA5E, 2611, 2612},
+	{0xFA5F, 2612, 2613},
+	{0xFA60, 2613, 2614},
+	{0xFA61, 2614, 2615},
+	{0xFA62, 2615, 2616},
+	{0xFA63, 2616, 2617},
+	{0xFA64, 2617, 2618},
+	{0xFA65, 2618, 2619},
+	{0xFA66, 2619, 2620},
+	{0xFA67, 2620, 2621},
+	{0xFA68, 2621, 2622},
+	{0xFA69, 2622, 2623},
+	{0xFA6A, 2623, 2624},
+	{0xFA6B, 2624, 2625},
+	{0xFA6C, 2625, 2626},
+	{0xFA6D, 2626, 2627},
+	{0xFA70, 2627, 2628},
+	{0xFA71, 2628, 2629},
+	{0xFA72, 2629, 2630},
+	{0xFA73, 2630, 2631},
+	{0xFA74, 2631, 2632},
+	{0xFA75, 2632, 2633},
+	{0xFA76, 2633, 2634},
+	{0xFA77, 2634, 2635},
+	{0xFA78, 2635, 2636},
+	{0xFA79, 2636, 2637},
+	{0xFA7A, 2637, 2638},
+	{0xFA7B, 2638, 2639},
+	{0xFA7C, 2639, 2640},
+	{0xFA7D, 2640, 2641},
+	{0xFA7E, 2641, 2642},
+	{0xFA7F, 2642, 2643},
+	{0xFA80, 2643, 2644},
+	{0xFA81, 2644, 2645},
+	{0xFA82, 2645, 2646},
+	{0xFA83, 2646, 2647},
+	{0xFA84, 2647, 2648},
+	{0xFA85, 2648, 2649},
+	{0xFA86, 2649, 2650},
+	{0xFA87, 2650, 2651},
+	{0xFA88, 2651, 2652},
+	{0xFA89, 2652, 2653},
+	{0xFA8A, 2653, 2654},
+	{0xFA8B, 2654, 2655},
+	{0xFA8C, 2655, 2656},
+	{0xFA8D, 2656, 2657},
+	{0xFA8E, 2657, 2658},
+	{0xFA8F, 2658, 2659},
+	{0xFA90, 2659, 2660},
+	{0xFA91, 2660, 2661},
+	{0xFA92, 2661, 2662},
+	{0xFA93, 2662, 2663},
+	{0xFA94, 2663, 2664},
+	{0xFA95, 2664, 2665},
+	{0xFA96, 2665, 2666},
+	{0xFA97, 2666, 2667},
+	{0xFA98, 2667, 2668},
+	{0xFA99, 2668, 2669},
+	{0xFA9A, 2669, 2670},
+	{0xFA9B, 2670, 2671},
+	{0xFA9C, 2671, 2672},
+	{0xFA9D, 2672, 2673},
+	{0xFA9E, 2673, 2674},
+	{0xFA9F, 2674, 2675},
+	{0xFAA0, 2675, 2676},
+	{0xFAA1, 2676, 2677},
+	{0xFAA2, 2677, 2678},
+	{0xFAA3, 2678, 2679},
+	{0xFAA4, 2679, 2680},
+	{0xFAA5, 2680, 2681},
+	{0xFAA6, 2681, 2682},
+	{0xFAA7, 2682, 2683},
+	{0xFAA8, 2683, 2684},
+	{0xFAA9, 2684, 2685},
+	{0xFAAA, 2685, 2686},
+	{0xFAAB, 2686, 2687},
+	{0xFAAC, 2687, 2688},
+	{0xFAAD, 2688, 2689},
+	{0xFAAE, 2689, 2690},
+	{0xFAAF, 2690, 2691},
+	{0xFAB0, 2691, 2692},
+	{0xFAB1, 2692, 2693},
+	{0xFAB2, 2693, 2694},
+	{0xFAB3, 2694, 2695},
+	{0xFAB4, 2695, 2696},
+	{0xFAB5, 2696, 2697},
+	{0xFAB6, 2697, 2698},
+	{0xFAB7, 2698, 2699},
+	{0xFAB8, 2699, 2700},
+	{0xFAB9, 2700, 2701},
+	{0xFABA, 2701, 2702},
+	{0xFABB, 2702, 2703},
+	{0xFABC, 2703, 2704},
+	{0xFABD, 2704, 2705},
+	{0xFABE, 2705, 2706},
+	{0xFABF, 2706, 2707},
+	{0xFAC0, 2707, 2708},
+	{0xFAC1, 2708, 2709},
+	{0xFAC2, 2709, 2710},
+	{0xFAC3, 2710, 2711},
+	{0xFAC4, 2711, 2712},
+	{0xFAC5, 2712, 2713},
+	{0xFAC6, 2713, 2714},
+	{0xFAC7, 2714, 2715},
+	{0xFAC8, 2715, 2716},
+	{0xFAC9, 2716, 2717},
+	{0xFACA, 2717, 2718},
+	{0xFACB, 2718, 2719},
+	{0xFACC, 2719, 2720},
+	{0xFACD, 2720, 2721},
+	{0xFACE, 2721, 2722},
+	{0xFACF, 2722, 2723},
+	{0xFAD0, 2723, 2724},
+	{0xFAD1, 2724, 2725},
+	{0xFAD2, 2725, 2726},
+	{0xFAD3, 2726, 2727},
+	{0xFAD4, 2727, 2728},
+	{0xFAD5, 2728, 2729},
+	{0xFAD6, 2729, 2730},
+	{0xFAD7, 2730, 2731},
+	{0xFAD8, 2731, 2732},
+	{0xFAD9, 2732, 2733},
+	{0xFB1D, 2733, 2735},
+	{0xFB1F, 2735, 2737},
+	{0xFB2A, 2737, 2739},
+	{0xFB2B, 2739, 2741},
+	{0xFB2C, 2741, 2744},
+	{0xFB2D, 2744, 2747},
+	{0xFB2E, 2747, 2749},
+	{0xFB2F, 2749, 2751},
+	{0xFB30, 2751, 2753},
+	{0xFB31, 2753, 2755},
+	{0xFB32, 2755, 2757},
+	{0xFB33, 2757, 2759},
+	{0xFB34, 2759, 2761},
+	{0xFB35, 2761, 2763},
+	{0xFB36, 2763, 2765},
+	{0xFB38, 2765, 2767},
+	{0xFB39, 2767, 2769},
+	{0xFB3A, 2769, 2771},
+	{0xFB3B, 2771, 2773},
+	{0xFB3C, 2773, 2775},
+	{0xFB3E, 2775, 2777},
+	{0xFB40, 2777, 2779},
+	{0xFB41, 2779, 2781},
+	{0xFB43, 2781, 2783},
+	{0xFB44, 2783, 2785},
+	{0xFB46, 2785, 2787},
+	{0xFB47, 2787, 2789},
+	{0xFB48, 2789, 2791},
+	{0xFB49, 2791, 2793},
+	{0xFB4A, 2793, 2795},
+	{0xFB4B, 2795, 2797},
+	{0xFB4C, 2797, 2799},
+	{0xFB4D, 2799, 2801},
+	{0xFB4E, 2801, 2803},
+	{0x105C9, 2803, 2805},
+	{0x105E4, 2805, 2807},
+	{0x1109A, 2807, 2809},
+	{0x1109C, 2809, 2811},
+	{0x110AB, 2811, 2813},
+	{0x1112E, 2813, 2815},
+	{0x1112F, 2815, 2817},
+	{0x1134B, 2817, 2819},
+	{0x1134C, 2819, 2821},
+	{0x11383, 2821, 2823},
+	{0x11385, 2823, 2825},
+	{0x1138E, 2825, 2827},
+	{0x11391, 2827, 2829},
+	{0x113C5, 2829, 2831},
+	{0x113C7, 2831, 2833},
+	{0x113C8, 2833, 2835},
+	{0x114BB, 2835, 2837},
+	{0x114BC, 2837, 2839},
+	{0x114BE, 2839, 2841},
+	{0x115BA, 2841, 2843},
+	{0x115BB, 2843, 2845},
+	{0x11938, 2845, 2847},
+	{0x16121, 2847, 2849},
+	{0x16122, 2849, 2851},
+	{0x16123, 2851, 2853},
+	{0x16124, 2853, 2855},
+	{0x16125, 2855, 2857},
+	{0x16126, 2857, 2860},
+	{0x16127, 2860, 2863},
+	{0x16128, 2863, 2866},
+	{0x16D68, 2866, 2868},
+	{0x16D69, 2868, 2870},
+	{0x16D6A, 2870, 2873},
+	{0x1D15E, 2873, 2875},
+	{0x1D15F, 2875, 2877},
+	{0x1D160, 2877, 2880},
+	{0x1D161, 2880, 2883},
+	{0x1D162, 2883, 2886},
+	{0x1D163, 2886, 2889},
+	{0x1D164, 2889, 2892},
+	{0x1D1BB, 2892, 2894},
+	{0x1D1BC, 2894, 2896},
+	{0x1D1BD, 2896, 2899},
+	{0x1D1BE, 2899, 2902},
+	{0x1D1BF, 2902, 2905},
+	{0x1D1C0, 2905, 2908},
+	{0x2F800, 2908, 2909},
+	{0x2F801, 2909, 2910},
+	{0x2F802, 2910, 2911},
+	{0x2F803, 2911, 2912},
+	{0x2F804, 2912, 2913},
+	{0x2F805, 2913, 2914},
+	{0x2F806, 2914, 2915},
+	{0x2F807, 2915, 2916},
+	{0x2F808, 2916, 2917},
+	{0x2F809, 2917, 2918},
+	{0x2F80A, 2918, 2919},
+	{0x2F80B, 2919, 2920},
+	{0x2F80C, 2920, 2921},
+	{0x2F80D, 2921, 2922},
+	{0x2F80E, 2922, 2923},
+	{0x2F80F, 2923, 2924},
+	{0x2F810, 2924, 2925},
+	{0x2F811, 2925, 2926},
+	{0x2F812, 2926, 2927},
+	{0x2F813, 2927, 2928},
+	{0x2F814, 2928, 2929},
+	{0x2F815, 2929, 2930},
+	{0x2F816, 2930, 2931},
+	{0x2F817, 2931, 2932},
+	{0x2F818, 2932, 2933},
+	{0x2F819, 2933, 2934},
+	{0x2F81A, 2934, 2935},
+	{0x2F81B, 2935, 2936},
+	{0x2F81C, 2936, 2937},
+	{0x2F81D, 2937, 2938},
+	{0x2F81E, 2938, 2939},
+	{0x2F81F, 2939, 2940},
+	{0x2F820, 2940, 2941},
+	{0x2F821, 2941, 2942},
+	{0x2F822, 2942, 2943},
+	{0x2F823, 2943, 2944},
+	{0x2F824, 2944, 2945},
+	{0x2F825, 2945, 2946},
+	{0x2F826, 2946, 2947},
+	{0x2F827, 2947, 2948},
+	{0x2F828, 2948, 2949},
+	{0x2F829, 2949, 2950},
+	{0x2F82A, 2950, 2951},
+	{0x2F82B, 2951, 2952},
+	{0x2F82C, 2952, 2953},
+	{0x2F82D, 2953, 2954},
+	{0x2F82E, 2954, 2955},
+	{0x2F82F, 2955, 2956},
+	{0x2F830, 2956, 2957},
+	{0x2F831, 2957, 2958},
+	{0x2F832, 2958, 2959},
+	{0x2F833, 2959, 2960},
+	{0x2F834, 2960, 2961},
+	{0x2F835, 2961, 2962},
+	{0x2F836, 2962, 2963},
+	{0x2F837, 2963, 2964},
+	{0x2F838, 2964, 2965},
+	{0x2F839, 2965, 2966},
+	{0x2F83A, 2966, 2967},
+	{0x2F83B, 2967, 2968},
+	{0x2F83C, 2968, 2969},
+	{0x2F83D, 2969, 2970},
+	{0x2F83E, 2970, 2971},
+	{0x2F83F, 2971, 2972},
+	{0x2F840, 2972, 2973},
+	{0x2F841, 2973, 2974},
+	{0x2F842, 2974, 2975},
+	{0x2F843, 2975, 2976},
+	{0x2F844, 2976, 2977},
+	{0x2F845, 2977, 2978},
+	{0x2F846, 2978, 2979},
+	{0x2F847, 2979, 2980},
+	{0x2F848, 2980, 2981},
+	{0x2F849, 2981, 2982},
+	{0x2F84A, 2982, 2983},
+	{0x2F84B, 2983, 2984},
+	{0x2F84C, 2984, 2985},
+	{0x2F84D, 2985, 2986},
+	{0x2F84E, 2986, 2987},
+	{0x2F84F, 2987, 2988},
+	{0x2F850, 2988, 2989},
+	{0x2F851, 2989, 2990},
+	{0x2F852, 2990, 2991},
+	{0x2F853, 2991, 2992},
+	{0x2F854, 2992, 2993},
+	{0x2F855, 2993, 2994},
+	{0x2F856, 2994, 2995},
+	{0x2F857, 2995, 2996},
+	{0x2F858, 2996, 2997},
+	{0x2F859, 2997, 2998},
+	{0x2F85A, 2998, 2999},
+	{0x2F85B, 2999, 3000},
+	{0x2F85C, 3000, 3001},
+	{0x2F85D, 3001, 3002},
+	{0x2F85E, 3002, 3003},
+	{0x2F85F, 3003, 3004},
+	{0x2F860, 3004, 3005},
+	{0x2F861, 3005, 3006},
+	{0x2F862, 3006, 3007},
+	{0x2F863, 3007, 3008},
+	{0x2F864, 3008, 3009},
+	{0x2F865, 3009, 3010},
+	{0x2F866, 3010, 3011},
+	{0x2F867, 3011, 3012},
+	{0x2F868, 3012, 3013},
+	{0x2F869, 3013, 3014},
+	{0x2F86A, 3014, 3015},
+	{0x2F86B, 3015, 3016},
+	{0x2F86C, 3016, 3017},
+	{0x2F86D, 3017, 3018},
+	{0x2F86E, 3018, 3019},
+	{0x2F86F, 3019, 3020},
+	{0x2F870, 3020, 3021},
+	{0x2F871, 3021, 3022},
+	{0x2F872, 3022, 3023},
+	{0x2F873, 3023, 3024},
+	{0x2F874, 3024, 3025},
+	{0x2F875, 3025, 3026},
+	{0x2F876, 3026, 3027},
+	{0x2F877, 3027, 3028},
+	{0x2F878, 3028, 3029},
+	{0x2F879, 3029, 3030},
+	{0x2F87A, 3030, 3031},
+	{0x2F87B, 3031, 3032},
+	{0x2F87C, 3032, 3033},
+	{0x2F87D, 3033, 3034},
+	{0x2F87E, 3034, 3035},
+	{0x2F87F, 3035, 3036},
+	{0x2F880, 3036, 3037},
+	{0x2F881, 3037, 3038},
+	{0x2F882, 3038, 3039},
+	{0x2F883, 3039, 3040},
+	{0x2F884, 3040, 3041},
+	{0x2F885, 3041, 3042},
+	{0x2F886, 3042, 3043},
+	{0x2F887, 3043, 3044},
+	{0x2F888, 3044, 3045},
+	{0x2F889, 3045, 3046},
+	{0x2F88A, 3046, 3047},
+	{0x2F88B, 3047, 3048},
+	{0x2F88C, 3048, 3049},
+	{0x2F88D, 3049, 3050},
+	{0x2F88E, 3050, 3051},
+	{0x2F88F, 3051, 3052},
+	{0x2F890, 3052, 3053},
+	{0x2F891, 3053, 3054},
+	{0x2F892, 3054, 3055},
+	{0x2F893, 3055, 3056},
+	{0x2F894, 3056, 3057},
+	{0x2F895, 3057, 3058},
+	{0x2F896, 3058, 3059},
+	{0x2F897, 3059, 3060},
+	{0x2F898, 3060, 3061},
+	{0x2F899, 3061, 3062},
+	{0x2F89A, 3062, 3063},
+	{0x2F89B, 3063, 3064},
+	{0x2F89C, 3064, 3065},
+	{0x2F89D, 3065, 3066},
+	{0x2F89E, 3066, 3067},
+	{0x2F89F, 3067, 3068},
+	{0x2F8A0, 3068, 3069},
+	{0x2F8A1, 3069, 3070},
+	{0x2F8A2, 3070, 3071},
+	{0x2F8A3, 3071, 3072},
+	{0x2F8A4, 3072, 3073},
+	{0x2F8A5, 3073, 3074},
+	{0x2F8A6, 3074, 3075},
+	{0x2F8A7, 3075, 3076},
+	{0x2F8A8, 3076, 3077},
+	{0x2F8A9, 3077, 3078},
+	{0x2F8AA, 3078, 3079},
+	{0x2F8AB, 3079, 3080},
+	{0x2F8AC, 3080, 3081},
+	{0x2F8AD, 3081, 3082},
+	{0x2F8AE, 3082, 3083},
+	{0x2F8AF, 3083, 3084},
+	{0x2F8B0, 3084, 3085},
+	{0x2F8B1, 3085, 3086},
+	{0x2F8B2, 3086, 3087},
+	{0x2F8B3, 3087, 3088},
+	{0x2F8B4, 3088, 3089},
+	{0x2F8B5, 3089, 3090},
+	{0x2F8B6, 3090, 3091},
+	{0x2F8B7, 3091, 3092},
+	{0x2F8B8, 3092, 3093},
+	{0x2F8B9, 3093, 3094},
+	{0x2F8BA, 3094, 3095},
+	{0x2F8BB, 3095, 3096},
+	{0x2F8BC, 3096, 3097},
+	{0x2F8BD, 3097, 3098},
+	{0x2F8BE, 3098, 3099},
+	{0x2F8BF, 3099, 3100},
+	{0x2F8C0, 3100, 3101},
+	{0x2F8C1, 3101, 3102},
+	{0x2F8C2, 3102, 3103},
+	{0x2F8C3, 3103, 3104},
+	{0x2F8C4, 3104, 3105},
+	{0x2F8C5, 3105, 3106},
+	{0x2F8C6, 3106, 3107},
+	{0x2F8C7, 3107, 3108},
+	{0x2F8C8, 3108, 3109},
+	{0x2F8C9, 3109, 3110},
+	{0x2F8CA, 3110, 3111},
+	{0x2F8CB, 3111, 3112},
+	{0x2F8CC, 3112, 3113},
+	{0x2F8CD, 3113, 3114},
+	{0x2F8CE, 3114, 3115},
+	{0x2F8CF, 3115, 3116},
+	{0x2F8D0, 3116, 3117},
+	{0x2F8D1, 3117, 3118},
+	{0x2F8D2, 3118, 3119},
+	{0x2F8D3, 3119, 3120},
+	{0x2F8D4, 3120, 3121},
+	{0x2F8D5, 3121, 3122},
+	{0x2F8D6, 3122, 3123},
+	{0x2F8D7, 3123, 3124},
+	{0x2F8D8, 3124, 3125},
+	{0x2F8D9, 3125, 3126},
+	{0x2F8DA, 3126, 3127},
+	{0x2F8DB, 3127, 3128},
+	{0x2F8DC, 3128, 3129},
+	{0x2F8DD, 3129, 3130},
+	{0x2F8DE, 3130, 3131},
+	{0x2F8DF, 3131, 3132},
+	{0x2F8E0, 3132, 3133},
+	{0x2F8E1, 3133, 3134},
+	{0x2F8E2, 3134, 3135},
+	{0x2F8E3, 3135, 3136},
+	{0x2F8E4, 3136, 3137},
+	{0x2F8E5, 3137, 3138},
+	{0x2F8E6, 3138, 3139},
+	{0x2F8E7, 3139, 3140},
+	{0x2F8E8, 3140, 3141},
+	{0x2F8E9, 3141, 3142},
+	{0x2F8EA, 3142, 3143},
+	{0x2F8EB, 3143, 3144},
+	{0x2F8EC, 3144, 3145},
+	{0x2F8ED, 3145, 3146},
+	{0x2F8EE, 3146, 3147},
+	{0x2F8EF, 3147, 3148},
+	{0x2F8F0, 3148, 3149},
+	{0x2F8F1, 3149, 3150},
+	{0x2F8F2, 3150, 3151},
+	{0x2F8F3, 3151, 3152},
+	{0x2F8F4, 3152, 3153},
+	{0x2F8F5, 3153, 3154},
+	{0x2F8F6, 3154, 3155},
+	{0x2F8F7, 3155, 3156},
+	{0x2F8F8, 3156, 3157},
+	{0x2F8F9, 3157, 3158},
+	{0x2F8FA, 3158, 3159},
+	{0x2F8FB, 3159, 3160},
+	{0x2F8FC, 3160, 3161},
+	{0x2F8FD, 3161, 3162},
+	{0x2F8FE, 3162, 3163},
+	{0x2F8FF, 3163, 3164},
+	{0x2F900, 3164, 3165},
+	{0x2F901, 3165, 3166},
+	{0x2F902, 3166, 3167},
+	{0x2F903, 3167, 3168},
+	{0x2F904, 3168, 3169},
+	{0x2F905, 3169, 3170},
+	{0x2F906, 3170, 3171},
+	{0x2F907, 3171, 3172},
+	{0x2F908, 3172, 3173},
+	{0x2F909, 3173, 3174},
+	{0x2F90A, 3174, 3175},
+	{0x2F90B, 3175, 3176},
+	{0x2F90C, 3176, 3177},
+	{0x2F90D, 3177, 3178},
+	{0x2F90E, 3178, 3179},
+	{0x2F90F, 3179, 3180},
+	{0x2F910, 3180, 3181},
+	{0x2F911, 3181, 3182},
+	{0x2F912, 3182, 3183},
+	{0x2F913, 3183, 3184},
+	{0x2F914, 3184, 3185},
+	{0x2F915, 3185, 3186},
+	{0x2F916, 3186, 3187},
+	{0x2F917, 3187, 3188},
+	{0x2F918, 3188, 3189},
+	{0x2F919, 3189, 3190},
+	{0x2F91A, 3190, 3191},
+	{0x2F91B, 3191, 3192},
+	{0x2F91C, 3192, 3193},
+	{0x2F91D, 3193, 3194},
+	{0x2F91E, 3194, 3195},
+	{0x2F91F, 3195, 3196},
+	{0x2F920, 3196, 3197},
+	{0x2F921, 3197, 3198},
+	{0x2F922, 3198, 3199},
+	{0x2F923, 3199, 3200},
+	{0x2F924, 3200, 3201},
+	{0x2F925, 3201, 3202},
+	{0x2F926, 3202, 3203},
+	{0x2F927, 3203, 3204},
+	{0x2F928, 3204, 3205},
+	{0x2F929, 3205, 3206},
+	{0x2F92A, 3206, 3207},
+	{0x2F92B, 3207, 3208},
+	{0x2F92C, 3208, 3209},
+	{0x2F92D, 3209, 3210},
+	{0x2F92E, 3210, 3211},
+	{0x2F92F, 3211, 3212},
+	{0x2F930, 3212, 3213},
+	{0x2F931, 3213, 3214},
+	{0x2F932, 3214, 3215},
+	{0x2F933, 3215, 3216},
+	{0x2F934, 3216, 3217},
+	{0x2F935, 3217, 3218},
+	{0x2F936, 3218, 3219},
+	{0x2F937, 3219, 3220},
+	{0x2F938, 3220, 3221},
+	{0x2F939, 3221, 3222},
+	{0x2F93A, 3222, 3223},
+	{0x2F93B, 3223, 3224},
+	{0x2F93C, 3224, 3225},
+	{0x2F93D, 3225, 3226},
+	{0x2F93E, 3226, 3227},
+	{0x2F93F, 3227, 3228},
+	{0x2F940, 3228, 3229},
+	{0x2F941, 3229, 3230},
+	{0x2F942, 3230, 3231},
+	{0x2F943, 3231, 3232},
+	{0x2F944, 3232, 3233},
+	{0x2F945, 3233, 3234},
+	{0x2F946, 3234, 3235},
+	{0x2F947, 3235, 3236},
+	{0x2F948, 3236, 3237},
+	{0x2F949, 3237, 3238},
+	{0x2F94A, 3238, 3239},
+	{0x2F94B, 3239, 3240},
+	{0x2F94C, 3240, 3241},
+	{0x2F94D, 3241, 3242},
+	{0x2F94E, 3242, 3243},
+	{0x2F94F, 3243, 3244},
+	{0x2F950, 3244, 3245},
+	{0x2F951, 3245, 3246},
+	{0x2F952, 3246, 3247},
+	{0x2F953, 3247, 3248},
+	{0x2F954, 3248, 3249},
+	{0x2F955, 3249, 3250},
+	{0x2F956, 3250, 3251},
+	{0x2F957, 3251, 3252},
+	{0x2F958, 3252, 3253},
+	{0x2F959, 3253, 3254},
+	{0x2F95A, 3254, 3255},
+	{0x2F95B, 3255, 3256},
+	{0x2F95C, 3256, 3257},
+	{0x2F95D, 3257, 3258},
+	{0x2F95E, 3258, 3259},
+	{0x2F95F, 3259, 3260},
+	{0x2F960, 3260, 3261},
+	{0x2F961, 3261, 3262},
+	{0x2F962, 3262, 3263},
+	{0x2F963, 3263, 3264},
+	{0x2F964, 3264, 3265},
+	{0x2F965, 3265, 3266},
+	{0x2F966, 3266, 3267},
+	{0x2F967, 3267, 3268},
+	{0x2F968, 3268, 3269},
+	{0x2F969, 3269, 3270},
+	{0x2F96A, 3270, 3271},
+	{0x2F96B, 3271, 3272},
+	{0x2F96C, 3272, 3273},
+	{0x2F96D, 3273, 3274},
+	{0x2F96E, 3274, 3275},
+	{0x2F96F, 3275, 3276},
+	{0x2F970, 3276, 3277},
+	{0x2F971, 3277, 3278},
+	{0x2F972, 3278, 3279},
+	{0x2F973, 3279, 3280},
+	{0x2F974, 3280, 3281},
+	{0x2F975, 3281, 3282},
+	{0x2F976, 3282, 3283},
+	{0x2F977, 3283, 3284},
+	{0x2F978, 3284, 3285},
+	{0x2F979, 3285, 3286},
+	{0x2F97A, 3286, 3287},
+	{0x2F97B, 3287, 3288},
+	{0x2F97C, 3288, 3289},
+	{0x2F97D, 3289, 3290},
+	{0x2F97E, 3290, 3291},
+	{0x2F97F, 3291, 3292},
+	{0x2F980, 3292, 3293},
+	{0x2F981, 3293, 3294},
+	{0x2F982, 3294, 3295},
+	{0x2F983, 3295, 3296},
+	{0x2F984, 3296, 3297},
+	{0x2F985, 3297, 3298},
+	{0x2F986, 3298, 3299},
+	{0x2F987, 3299, 3300},
+	{0x2F988, 3300, 3301},
+	{0x2F989, 3301, 3302},
+	{0x2F98A, 3302, 3303},
+	{0x2F98B, 3303, 3304},
+	{0x2F98C, 3304, 3305},
+	{0x2F98D, 3305, 3306},
+	{0x2F98E, 3306, 3307},
+	{0x2F98F, 3307, 3308},
+	{0x2F990, 3308, 3309},
+	{0x2F991, 3309, 3310},
+	{0x2F992, 3310, 3311},
+	{0x2F993, 3311, 3312},
+	{0x2F994, 3312, 3313},
+	{0x2F995, 3313, 3314},
+	{0x2F996, 3314, 3315},
+	{0x2F997, 3315, 3316},
+	{0x2F998, 3316, 3317},
+	{0x2F999, 3317, 3318},
+	{0x2F99A, 3318, 3319},
+	{0x2F99B, 3319, 3320},
+	{0x2F99C, 3320, 3321},
+	{0x2F99D, 3321, 3322},
+	{0x2F99E, 3322, 3323},
+	{0x2F99F, 3323, 3324},
+	{0x2F9A0, 3324, 3325},
+	{0x2F9A1, 3325, 3326},
+	{0x2F9A2, 3326, 3327},
+	{0x2F9A3, 3327, 3328},
+	{0x2F9A4, 3328, 3329},
+	{0x2F9A5, 3329, 3330},
+	{0x2F9A6, 3330, 3331},
+	{0x2F9A7, 3331, 3332},
+	{0x2F9A8, 3332, 3333},
+	{0x2F9A9, 3333, 3334},
+	{0x2F9AA, 3334, 3335},
+	{0x2F9AB, 3335, 3336},
+	{0x2F9AC, 3336, 3337},
+	{0x2F9AD, 3337, 3338},
+	{0x2F9AE, 3338, 3339},
+	{0x2F9AF, 3339, 3340},
+	{0x2F9B0, 3340, 3341},
+	{0x2F9B1, 3341, 3342},
+	{0x2F9B2, 3342, 3343},
+	{0x2F9B3, 3343, 3344},
+	{0x2F9B4, 3344, 3345},
+	{0x2F9B5, 3345, 3346},
+	{0x2F9B6, 3346, 3347},
+	{0x2F9B7, 3347, 3348},
+	{0x2F9B8, 3348, 3349},
+	{0x2F9B9, 3349, 3350},
+	{0x2F9BA, 3350, 3351},
+	{0x2F9BB, 3351, 3352},
+	{0x2F9BC, 3352, 3353},
+	{0x2F9BD, 3353, 3354},
+	{0x2F9BE, 3354, 3355},
+	{0x2F9BF, 3355, 3356},
+	{0x2F9C0, 3356, 3357},
+	{0x2F9C1, 3357, 3358},
+	{0x2F9C2, 3358, 3359},
+	{0x2F9C3, 3359, 3360},
+	{0x2F9C4, 3360, 3361},
+	{0x2F9C5, 3361, 3362},
+	{0x2F9C6, 3362, 3363},
+	{0x2F9C7, 3363, 3364},
+	{0x2F9C8, 3364, 3365},
+	{0x2F9C9, 3365, 3366},
+	{0x2F9CA, 3366, 3367},
+	{0x2F9CB, 3367, 3368},
+	{0x2F9CC, 3368, 3369},
+	{0x2F9CD, 3369, 3370},
+	{0x2F9CE, 3370, 3371},
+	{0x2F9CF, 3371, 3372},
+	{0x2F9D0, 3372, 3373},
+	{0x2F9D1, 3373, 3374},
+	{0x2F9D2, 3374, 3375},
+	{0x2F9D3, 3375, 3376},
+	{0x2F9D4, 3376, 3377},
+	{0x2F9D5, 3377, 3378},
+	{0x2F9D6, 3378, 3379},
+	{0x2F9D7, 3379, 3380},
+	{0x2F9D8, 3380, 3381},
+	{0x2F9D9, 3381, 3382},
+	{0x2F9DA, 3382, 3383},
+	{0x2F9DB, 3383, 3384},
+	{0x2F9DC, 3384, 3385},
+	{0x2F9DD, 3385, 3386},
+	{0x2F9DE, 3386, 3387},
+	{0x2F9DF, 3387, 3388},
+	{0x2F9E0, 3388, 3389},
+	{0x2F9E1, 3389, 3390},
+	{0x2F9E2, 3390, 3391},
+	{0x2F9E3, 3391, 3392},
+	{0x2F9E4, 3392, 3393},
+	{0x2F9E5, 3393, 3394},
+	{0x2F9E6, 3394, 3395},
+	{0x2F9E7, 3395, 3396},
+	{0x2F9E8, 3396, 3397},
+	{0x2F9E9, 3397, 3398},
+	{0x2F9EA, 3398, 3399},
+	{0x2F9EB, 3399, 3400},
+	{0x2F9EC, 3400, 3401},
+	{0x2F9ED, 3401, 3402},
+	{0x2F9EE, 3402, 3403},
+	{0x2F9EF, 3403, 3404},
+	{0x2F9F0, 3404, 3405},
+	{0x2F9F1, 3405, 3406},
+	{0x2F9F2, 3406, 3407},
+	{0x2F9F3, 3407, 3408},
+	{0x2F9F4, 3408, 3409},
+	{0x2F9F5, 3409, 3410},
+	{0x2F9F6, 3410, 3411},
+	{0x2F9F7, 3411, 3412},
+	{0x2F9F8, 3412, 3413},
+	{0x2F9F9, 3413, 3414},
+	{0x2F9FA, 3414, 3415},
+	{0x2F9FB, 3415, 3416},
+	{0x2F9FC, 3416, 3417},
+	{0x2F9FD, 3417, 3418},
+	{0x2F9FE, 3418, 3419},
+	{0x2F9FF, 3419, 3420},
+	{0x2FA00, 3420, 3421},
+	{0x2FA01, 3421, 3422},
+	{0x2FA02, 3422, 3423},
+	{0x2FA03, 3423, 3424},
+	{0x2FA04, 3424, 3425},
+	{0x2FA05, 3425, 3426},
+	{0x2FA06, 3426, 3427},
+	{0x2FA07, 3427, 3428},
+	{0x2FA08, 3428, 3429},
+	{0x2FA09, 3429, 3430},
+	{0x2FA0A, 3430, 3431},
+	{0x2FA0B, 3431, 3432},
+	{0x2FA0C, 3432, 3433},
+	{0x2FA0D, 3433, 3434},
+	{0x2FA0E, 3434, 3435},
+	{0x2FA0F, 3435, 3436},
+	{0x2FA10, 3436, 3437},
+	{0x2FA11, 3437, 3438},
+	{0x2FA12, 3438, 3439},
+	{0x2FA13, 3439, 3440},
+	{0x2FA14, 3440, 3441},
+	{0x2FA15, 3441, 3442},
+	{0x2FA16, 3442, 3443},
+	{0x2FA17, 3443, 3444},
+	{0x2FA18, 3444, 3445},
+	{0x2FA19, 3445, 3446},
+	{0x2FA1A, 3446, 3447},
+	{0x2FA1B, 3447, 3448},
+	{0x2FA1C, 3448, 3449},
+	{0x2FA1D, 3449, 3450},
+}
+
+var canonicalRunes = []rune{
+	0x0041, 0x0300, 0x0041, 0x0301, 0x0041, 0x0302, 0x0041, 0x0303, 0x0041, 0x0308, 0x0041, 0x030A,
+	0x0043, 0x0327, 0x0045, 0x0300, 0x0045, 0x0301, 0x0045, 0x0302, 0x0045, 0x0308, 0x0049, 0x0300,
+	0x0049, 0x0301, 0x0049, 0x0302, 0x0049, 0x0308, 0x004E, 0x0303, 0x004F, 0x0300, 0x004F, 0x0301,
+	0x004F, 0x0302, 0x004F, 0x0303, 0x004F, 0x0308, 0x0055, 0x0300, 0x0055, 0x0301, 0x0055, 0x0302,
+	0x0055, 0x0308, 0x0059, 0x0301, 0x0061, 0x0300, 0x0061, 0x0301, 0x0061, 0x0302, 0x0061, 0x0303,
+	0x0061, 0x0308, 0x0061, 0x030A, 0x0063, 0x0327, 0x0065, 0x0300, 0x0065, 0x0301, 0x0065, 0x0302,
+	0x0065, 0x0308, 0x0069, 0x0300, 0x0069, 0x0301, 0x0069, 0x0302, 0x0069, 0x0308, 0x006E, 0x0303,
+	0x006F, 0x0300, 0x006F, 0x0301, 0x006F, 0x0302, 0x006F, 0x0303, 0x006F, 0x0308, 0x0075, 0x0300,
+	0x0075, 0x0301, 0x0075, 0x0302, 0x0075, 0x0308, 0x0079, 0x0301, 0x0079, 0x0308, 0x0041, 0x0304,
+	0x0061, 0x0304, 0x0041, 0x0306, 0x0061, 0x0306, 0x0041, 0x0328, 0x0061, 0x0328, 0x0043, 0x0301,
+	0x0063, 0x0301, 0x0043, 0x0302, 0x0063, 0x0302, 0x0043, 0x0307, 0x0063, 0x0307, 0x0043, 0x030C,
+	0x0063, 0x030C, 0x0044, 0x030C, 0x0064, 0x030C, 0x0045, 0x0304, 0x0065, 0x0304, 0x0045, 0x0306,
+	0x0065, 0x0306, 0x0045, 0x0307, 0x0065, 0x0307, 0x0045, 0x0328, 0x0065, 0x0328, 0x0045, 0x030C,
+	0x0065, 0x030C, 0x0047, 0x0302, 0x0067, 0x0302, 0x0047, 0x0306, 0x0067, 0x0306, 0x0047, 0x0307,
+	0x0067, 0x0307, 0x0047, 0x0327, 0x0067, 0x0327, 0x0048, 0x0302, 0x0068, 0x0302, 0x0049, 0x0303,
+	0x0069, 0x0303, 0x0049, 0x0304, 0x0069, 0x0304, 0x0049, 0x0306, 0x0069, 0x0306, 0x0049, 0x0328,
+	0x0069, 0x0328, 0x0049, 0x0307, 0x004A, 0x0302, 0x006A, 0x0302, 0x004B, 0x0327, 0x006B, 0x0327,
+	0x004C, 0x0301, 0x006C, 0x0301, 0x004C, 0x0327, 0x006C, 0x0327, 0x004C, 0x030C, 0x006C, 0x030C,
+	0x004E, 0x0301, 0x006E, 0x0301, 0x004E, 0x0327, 0x006E, 0x0327, 0x004E, 0x030C, 0x006E, 0x030C,
+	0x004F, 0x0304, 0x006F, 0x0304, 0x004F, 0x0306, 0x006F, 0x0306, 0x004F, 0x030B, 0x006F, 0x030B,
+	0x0052, 0x0301, 0x0072, 0x0301, 0x0052, 0x0327, 0x0072, 0x0327, 0x0052, 0x030C, 0x0072, 0x030C,
+	0x0053, 0x0301, 0x0073, 0x0301, 0x0053, 0x0302, 0x0073, 0x0302, 0x0053, 0x0327, 0x0073, 0x0327,
+	0x0053, 0x030C, 0x0073, 0x030C, 0x0054, 0x0327, 0x0074, 0x0327, 0x0054, 0x030C, 0x0074, 0x030C,
+	0x0055, 0x0303, 0x0075, 0x0303, 0x0055, 0x0304, 0x0075, 0x0304, 0x0055, 0x0306, 0x0075, 0x0306,
+	0x0055, 0x030A, 0x0075, 0x030A, 0x0055, 0x030B, 0x0075, 0x030B, 0x0055, 0x0328, 0x0075, 0x0328,
+	0x0057, 0x0302, 0x0077, 0x0302, 0x0059, 0x0302, 0x0079, 0x0302, 0x0059, 0x0308, 0x005A, 0x0301,
+	0x007A, 0x0301, 0x005A, 0x0307, 0x007A, 0x0307, 0x005A, 0x030C, 0x007A, 0x030C, 0x004F, 0x031B,
+	0x006F, 0x031B, 0x0055, 0x031B, 0x0075, 0x031B, 0x0041, 0x030C, 0x0061, 0x030C, 0x0049, 0x030C,
+	0x0069, 0x030C, 0x004F, 0x030C, 0x006F, 0x030C, 0x0055, 0x030C, 0x0075, 0x030C, 0x0055, 0x0308,
+	0x0304, 0x0075, 0x0308, 0x0304, 0x0055, 0x0308, 0x0301, 0x0075, 0x0308, 0x0301, 0x0055, 0x0308,
+	0x030C, 0x0075, 0x0308, 0x030C, 0x0055, 0x0308, 0x0300, 0x0075, 0x0308, 0x0300, 0x0041, 0x0308,
+	0x0304, 0x0061, 0x0308, 0x0304, 0x0041, 0x0307, 0x0304, 0x0061, 0x0307, 0x0304, 0x00C6, 0x0304,
+	0x00E6, 0x0304, 0x0047, 0x030C, 0x0067, 0x030C, 0x004B, 0x030C, 0x006B, 0x030C, 0x004F, 0x0328,
+	0x006F, 0x0328, 0x004F, 0x0328, 0x0304, 0x006F, 0x0328, 0x0304, 0x01B7, 0x030C, 0x0292, 0x030C,
+	0x006A, 0x030C, 0x0047, 0x0301, 0x0067, 0x0301, 0x004E, 0x0300, 0x006E, 0x0300, 0x0041, 0x030A,
+	0x0301, 0x0061, 0x030A, 0x0301, 0x00C6, 0x0301, 0x00E6, 0x0301, 0x00D8, 0x0301, 0x00F8, 0x0301,
+	0x0041, 0x030F, 0x0061, 0x030F, 0x0041, 0x0311, 0x0061, 0x0311, 0x0045, 0x030F, 0x0065, 0x030F,
+	0x0045, 0x0311, 0x0065, 0x0311, 0x0049, 0x030F, 0x0069, 0x030F, 0x0049, 0x0311, 0x0069, 0x0311,
+	0x004F, 0x030F, 0x006F, 0x030F, 0x004F, 0x0311, 0x006F, 0x0311, 0x0052, 0x030F, 0x0072, 0x030F,
+	0x0052, 0x0311, 0x0072, 0x0311, 0x0055, 0x030F, 0x0075, 0x030F, 0x0055, 0x0311, 0x0075, 0x0311,
+	0x0053, 0x0326, 0x0073, 0x0326, 0x0054, 0x0326, 0x0074, 0x0326, 0x0048, 0x030C, 0x0068, 0x030C,
+	0x0041, 0x0307, 0x0061, 0x0307, 0x0045, 0x0327, 0x0065, 0x0327, 0x004F, 0x0308, 0x0304, 0x006F,
+	0x0308, 0x0304, 0x004F, 0x0303, 0x0304, 0x006F, 0x0303, 0x0304, 0x004F, 0x0307, 0x006F, 0x0307,
+	0x004F, 0x0307, 0x0304, 0x006F, 0x0307, 0x0304, 0x0059, 0x0304, 0x0079, 0x0304, 0x0300, 0x0301,
+	0x0313, 0x0308, 0x0301, 0x02B9, 0x003B, 0x00A8, 0x0301, 0x0391, 0x0301, 0x00B7, 0x0395, 0x0301,
+	0x0397, 0x0301, 0x0399, 0x0301, 0x039F, 0x0301, 0x03A5, 0x0301, 0x03A9, 0x0301, 0x03B9, 0x0308,
+	0x0301, 0x0399, 0x0308, 0x03A5, 0x0308, 0x03B1, 0x0301, 0x03B5, 0x0301, 0x03B7, 0x0301, 0x03B9,
+	0x0301, 0x03C5, 0x0308, 0x0301, 0x03B9, 0x0308, 0x03C5, 0x0308, 0x03BF, 0x0301, 0x03C5, 0x0301,
+	0x03C9, 0x0301, 0x03D2, 0x0301, 0x03D2, 0x0308, 0x0415, 0x0300, 0x0415, 0x0308, 0x0413, 0x0301,
+	0x0406, 0x0308, 0x041A, 0x0301, 0x0418, 0x0300, 0x0423, 0x0306, 0x0418, 0x0306, 0x0438, 0x0306,
+	0x0435, 0x0300, 0x0435, 0x0308, 0x0433, 0x0301, 0x0456, 0x0308, 0x043A, 0x0301, 0x0438, 0x0300,
+	0x0443, 0x0306, 0x0474, 0x030F, 0x0475, 0x030F, 0x0416, 0x0306, 0x0436, 0x0306, 0x0410, 0x0306,
+	0x0430, 0x0306, 0x0410, 0x0308, 0x0430, 0x0308, 0x0415, 0x0306, 0x0435, 0x0306, 0x04D8, 0x0308,
+	0x04D9, 0x0308, 0x0416, 0x0308, 0x0436, 0x0308, 0x0417, 0x0308, 0x0437, 0x0308, 0x0418, 0x0304,
+	0x0438, 0x0304, 0x0418, 0x0308, 0x0438, 0x0308, 0x041E, 0x0308, 0x043E, 0x0308, 0x04E8, 0x0308,
+	0x04E9, 0x0308, 0x042D, 0x0308, 0x044D, 0x0308, 0x0423, 0x0304, 0x0443, 0x0304, 0x0423, 0x0308,
+	0x0443, 0x0308, 0x0423, 0x030B, 0x0443, 0x030B, 0x0427, 0x0308, 0x0447, 0x0308, 0x042B, 0x0308,
+	0x044B, 0x0308, 0x0627, 0x0653, 0x0627, 0x0654, 0x0648, 0x0654, 0x0627, 0x0655, 0x064A, 0x0654,
+	0x06D5, 0x0654, 0x06C1, 0x0654, 0x06D2, 0x0654, 0x0928, 0x093C, 0x0930, 0x093C, 0x0933, 0x093C,
+	0x0915, 0x093C, 0x0916, 0x093C, 0x0917, 0x093C, 0x091C, 0x093C, 0x0921, 0x093C, 0x0922, 0x093C,
+	0x092B, 0x093C, 0x092F, 0x093C, 0x09C7, 0x09BE, 0x09C7, 0x09D7, 0x09A1, 0x09BC, 0x09A2, 0x09BC,
+	0x09AF, 0x09BC, 0x0A32, 0x0A3C, 0x0A38, 0x0A3C, 0x0A16, 0x0A3C, 0x0A17, 0x0A3C, 0x0A1C, 0x0A3C,
+	0x0A2B, 0x0A3C, 0x0B47, 0x0B56, 0x0B47, 0x0B3E, 0x0B47, 0x0B57, 0x0B21, 0x0B3C, 0x0B22, 0x0B3C,
+	0x0B92, 0x0BD7, 0x0BC6, 0x0BBE, 0x0BC7, 0x0BBE, 0x0BC6, 0x0BD7, 0x0C46, 0x0C56, 0x0CBF, 0x0CD5,
+	0x0CC6, 0x0CD5, 0x0CC6, 0x0CD6, 0x0CC6, 0x0CC2, 0x0CC6, 0x0CC2, 0x0CD5, 0x0D46, 0x0D3E, 0x0D47,
+	0x0D3E, 0x0D46, 0x0D57, 0x0DD9, 0x0DCA, 0x0DD9, 0x0DCF, 0x0DD9, 0x0DCF, 0x0DCA, 0x0DD9, 0x0DDF,
+	0x0F42, 0x0FB7, 0x0F4C, 0x0FB7, 0x0F51, 0x0FB7, 0x0F56, 0x0FB7, 0x0F5B, 0x0FB7, 0x0F40, 0x0FB5,
+	0x0F71, 0x0F72, 0x0F71, 0x0F74, 0x0FB2, 0x0F80, 0x0FB3, 0x0F80, 0x0F71, 0x0F80, 0x0F92, 0x0FB7,
+	0x0F9C, 0x0FB7, 0x0FA1, 0x0FB7, 0x0FA6, 0x0FB7, 0x0FAB, 0x0FB7, 0x0F90, 0x0FB5, 0x1025, 0x102E,
+	0x1B05, 0x1B35, 0x1B07, 0x1B35, 0x1B09, 0x1B35, 0x1B0B, 0x1B35, 0x1B0D, 0x1B35, 0x1B11, 0x1B35,
+	0x1B3A, 0x1B35, 0x1B3C, 0x1B35, 0x1B3E, 0x1B35, 0x1B3F, 0x1B35, 0x1B42, 0x1B35, 0x0041, 0x0325,
+	0x0061, 0x0325, 0x0042, 0x0307, 0x0062, 0x0307, 0x0042, 0x0323, 0x0062, 0x0323, 0x0042, 0x0331,
+	0x0062, 0x0331, 0x0043, 0x0327, 0x0301, 0x0063, 0x0327, 0x0301, 0x0044, 0x0307, 0x0064, 0x0307,
+	0x0044, 0x0323, 0x0064, 0x0323, 0x0044, 0x0331, 0x0064, 0x0331, 0x0044, 0x0327, 0x0064, 0x0327,
+	0x0044, 0x032D, 0x0064, 0x032D, 0x0045, 0x0304, 0x0300, 0x0065, 0x0304, 0x0300, 0x0045, 0x0304,
+	0x0301, 0x0065, 0x0304, 0x0301, 0x0045, 0x032D, 0x0065, 0x032D, 0x0045, 0x0330, 0x0065, 0x0330,
+	0x0045, 0x0327, 0x0306, 0x0065, 0x0327, 0x0306, 0x0046, 0x0307, 0x0066, 0x0307, 0x0047, 0x0304,
+	0x0067, 0x0304, 0x0048, 0x0307, 0x0068, 0x0307, 0x0048, 0x0323, 0x0068, 0x0323, 0x0048, 0x0308,
+	0x0068, 0x0308, 0x0048, 0x0327, 0x0068, 0x0327, 0x0048, 0x032E, 0x0068, 0x032E, 0x0049, 0x0330,
+	0x0069, 0x0330, 0x0049, 0x0308, 0x0301, 0x0069, 0x0308, 0x0301, 0x004B, 0x0301, 0x006B, 0x0301,
+	0x004B, 0x0323, 0x006B, 0x0323, 0x004B, 0x0331, 0x006B, 0x0331, 0x004C, 0x0323, 0x006C, 0x0323,
+	0x004C, 0x0323, 0x0304, 0x006C, 0x0323, 0x0304, 0x004C, 0x0331, 0x006C, 0x0331, 0x004C, 0x032D,
+	0x006C, 0x032D, 0x004D, 0x0301, 0x006D, 0x0301, 0x004D, 0x0307, 0x006D, 0x0307, 0x004D, 0x0323,
+	0x006D, 0x0323, 0x004E, 0x0307, 0x006E, 0x0307, 0x004E, 0x0323, 0x006E, 0x0323, 0x004E, 0x0331,
+	0x006E, 0x0331, 0x004E, 0x032D, 0x006E, 0x032D, 0x004F, 0x0303, 0x0301, 0x006F, 0x0303, 0x0301,
+	0x004F, 0x0303, 0x0308, 0x006F, 0x0303, 0x0308, 0x004F, 0x0304, 0x0300, 0x006F, 0x0304, 0x0300,
+	0x004F, 0x0304, 0x0301, 0x006F, 0x0304, 0x0301, 0x0050, 0x0301, 0x0070, 0x0301, 0x0050, 0x0307,
+	0x0070, 0x0307, 0x0052, 0x0307, 0x0072, 0x0307, 0x0052, 0x0323, 0x0072, 0x0323, 0x0052, 0x0323,
+	0x0304, 0x0072, 0x0323, 0x0304, 0x0052, 0x0331, 0x0072, 0x0331, 0x0053, 0x0307, 0x0073, 0x0307,
+	0x0053, 0x0323, 0x0073, 0x0323, 0x0053, 0x0301, 0x0307, 0x0073, 0x0301, 0x0307, 0x0053, 0x030C,
+	0x0307, 0x0073, 0x030C, 0x0307, 0x0053, 0x0323, 0x0307, 0x0073, 0x0323, 0x0307, 0x0054, 0x0307,
+	0x0074, 0x0307, 0x0054, 0x0323, 0x0074, 0x0323, 0x0054, 0x0331, 0x0074, 0x0331, 0x0054, 0x032D,
+	0x0074, 0x032D, 0x0055, 0x0324, 0x0075, 0x0324, 0x0055, 0x0330, 0x0075, 0x0330, 0x0055, 0x032D,
+	0x0075, 0x032D, 0x0055, 0x0303, 0x0301, 0x0075, 0x0303, 0x0301, 0x0055, 0x0304, 0x0308, 0x0075,
+	0x0304, 0x0308, 0x0056, 0x0303, 0x0076, 0x0303, 0x0056, 0x0323, 0x0076, 0x0323, 0x0057, 0x0300,
+	0x0077, 0x0300, 0x0057, 0x0301, 0x0077, 0x0301, 0x0057, 0x0308, 0x0077, 0x0308, 0x0057, 0x0307,
+	0x0077, 0x0307, 0x0057, 0x0323, 0x0077, 0x0323, 0x0058, 0x0307, 0x0078, 0x0307, 0x0058, 0x0308,
+	0x0078, 0x0308, 0x0059, 0x0307, 0x0079, 0x0307, 0x005A, 0x0302, 0x007A, 0x0302, 0x005A, 0x0323,
+	0x007A, 0x0323, 0x005A, 0x0331, 0x007A, 0x0331, 0x0068, 0x0331, 0x0074, 0x0308, 0x0077, 0x030A,
+	0x0079, 0x030A, 0x017F, 0x0307, 0x0041, 0x0323, 0x0061, 0x0323, 0x0041, 0x0309, 0x0061, 0x0309,
+	0x0041, 0x0302, 0x0301, 0x0061, 0x0302, 0x0301, 0x0041, 0x0302, 0x0300, 0x0061, 0x0302, 0x0300,
+	0x0041, 0x0302, 0x0309, 0x0061, 0x0302, 0x0309, 0x0041, 0x0302, 0x0303, 0x0061, 0x0302, 0x0303,
+	0x0041, 0x0323, 0x0302, 0x0061, 0x0323, 0x0302, 0x0041, 0x0306, 0x0301, 0x0061, 0x0306, 0x0301,
+	0x0041, 0x0306, 0x0300, 0x0061, 0x0306, 0x0300, 0x0041, 0x0306, 0x0309, 0x0061, 0x0306, 0x0309,
+	0x0041, 0x0306, 0x0303, 0x0061, 0x0306, 0x0303, 0x0041, 0x0323, 0x0306, 0x0061, 0x0323, 0x0306,
+	0x0045, 0x0323, 0x0065, 0x0323, 0x0045, 0x0309, 0x0065, 0x0309, 0x0045, 0x0303, 0x0065, 0x0303,
+	0x0045, 0x0302, 0x0301, 0x0065, 0x0302, 0x0301, 0x0045, 0x0302, 0x0300, 0x0065, 0x0302, 0x0300,
+	0x0045, 0x0302, 0x0309, 0x0065, 0x0302, 0x0309, 0x0045, 0x0302, 0x0303, 0x0065, 0x0302, 0x0303,
+	0x0045, 0x0323, 0x0302, 0x0065, 0x0323, 0x0302, 0x0049, 0x0309, 0x0069, 0x0309, 0x0049, 0x0323,
+	0x0069, 0x0323, 0x004F, 0x0323, 0x006F, 0x0323, 0x004F, 0x0309, 0x006F, 0x0309, 0x004F, 0x0302,
+	0x0301, 0x006F, 0x0302, 0x0301, 0x004F, 0x0302, 0x0300, 0x006F, 0x0302, 0x0300, 0x004F, 0x0302,
+	0x0309, 0x006F, 0x0302, 0x0309, 0x004F, 0x0302, 0x0303, 0x006F, 0x0302, 0x0303, 0x004F, 0x0323,
+	0x0302, 0x006F, 0x0323, 0x0302, 0x004F, 0x031B, 0x0301, 0x006F, 0x031B, 0x0301, 0x004F, 0x031B,
+	0x0300, 0x006F, 0x031B, 0x0300, 0x004F, 0x031B, 0x0309, 0x006F, 0x031B, 0x0309, 0x004F, 0x031B,
+	0x0303, 0x006F, 0x031B, 0x0303, 0x004F, 0x031B, 0x0323, 0x006F, 0x031B, 0x0323, 0x0055, 0x0323,
+	0x0075, 0x0323, 0x0055, 0x0309, 0x0075, 0x0309, 0x0055, 0x031B, 0x0301, 0x0075, 0x031B, 0x0301,
+	0x0055, 0x031B, 0x0300, 0x0075, 0x031B, 0x0300, 0x0055, 0x031B, 0x0309, 0x0075, 0x031B, 0x0309,
+	0x0055, 0x031B, 0x0303, 0x0075, 0x031B, 0x0303, 0x0055, 0x031B, 0x0323, 0x0075, 0x031B, 0x0323,
+	0x0059, 0x0300, 0x0079, 0x0300, 0x0059, 0x0323, 0x0079, 0x0323, 0x0059, 0x0309, 0x0079, 0x0309,
+	0x0059, 0x0303, 0x0079, 0x0303, 0x03B1, 0x0313, 0x03B1, 0x0314, 0x03B1, 0x0313, 0x0300, 0x03B1,
+	0x0314, 0x0300, 0x03B1, 0x0313, 0x0301, 0x03B1, 0x0314, 0x0301, 0x03B1, 0x0313, 0x0342, 0x03B1,
+	0x0314, 0x0342, 0x0391, 0x0313, 0x0391, 0x0314, 0x0391, 0x0313, 0x0300, 0x0391, 0x0314, 0x0300,
+	0x0391, 0x0313, 0x0301, 0x0391, 0x0314, 0x0301, 0x0391, 0x0313, 0x0342, 0x0391, 0x0314, 0x0342,
+	0x03B5, 0x0313, 0x03B5, 0x0314, 0x03B5, 0x0313, 0x0300, 0x03B5, 0x0314, 0x0300, 0x03B5, 0x0313,
+	0x0301, 0x03B5, 0x0314, 0x0301, 0x0395, 0x0313, 0x0395, 0x0314, 0x0395, 0x0313, 0x0300, 0x0395,
+	0x0314, 0x0300, 0x0395, 0x0313, 0x0301, 0x0395, 0x0314, 0x0301, 0x03B7, 0x0313, 0x03B7, 0x0314,
+	0x03B7, 0x0313, 0x0300, 0x03B7, 0x0314, 0x0300, 0x03B7, 0x0313, 0x0301, 0x03B7, 0x0314, 0x0301,
+	0x03B7, 0x0313, 0x0342, 0x03B7, 0x0314, 0x0342, 0x0397, 0x0313, 0x0397, 0x0314, 0x0397, 0x0313,
+	0x0300, 0x0397, 0x0314, 0x0300, 0x0397, 0x0313, 0x0301, 0x0397, 0x0314, 0x0301, 0x0397, 0x0313,
+	0x0342, 0x0397, 0x0314, 0x0342, 0x03B9, 0x0313, 0x03B9, 0x0314, 0x03B9, 0x0313, 0x0300, 0x03B9,
+	0x0314, 0x0300, 0x03B9, 0x0313, 0x0301, 0x03B9, 0x0314, 0x0301, 0x03B9, 0x0313, 0x0342, 0x03B9,
+	0x0314, 0x0342, 0x0399, 0x0313, 0x0399, 0x0314, 0x0399, 0x0313, 0x0300, 0x0399, 0x0314, 0x0300,
+	0x0399, 0x0313, 0x0301, 0x0399, 0x0314, 0x0301, 0x0399, 0x0313, 0x0342, 0x0399, 0x0314, 0x0342,
+	0x03BF, 0x0313, 0x03BF, 0x0314, 0x03BF, 0x0313, 0x0300, 0x03BF, 0x0314, 0x0300, 0x03BF, 0x0313,
+	0x0301, 0x03BF, 0x0314, 0x0301, 0x039F, 0x0313, 0x039F, 0x0314, 0x039F, 0x0313, 0x0300, 0x039F,
+	0x0314, 0x0300, 0x039F, 0x0313, 0x0301, 0x039F, 0x0314, 0x0301, 0x03C5, 0x0313, 0x03C5, 0x0314,
+	0x03C5, 0x0313, 0x0300, 0x03C5, 0x0314, 0x0300, 0x03C5, 0x0313, 0x0301, 0x03C5, 0x0314, 0x0301,
+	0x03C5, 0x0313, 0x0342, 0x03C5, 0x0314, 0x0342, 0x03A5, 0x0314, 0x03A5, 0x0314, 0x0300, 0x03A5,
+	0x0314, 0x0301, 0x03A5, 0x0314, 0x0342, 0x03C9, 0x0313, 0x03C9, 0x0314, 0x03C9, 0x0313, 0x0300,
+	0x03C9, 0x0314, 0x0300, 0x03C9, 0x0313, 0x0301, 0x03C9, 0x0314, 0x0301, 0x03C9, 0x0313, 0x0342,
+	0x03C9, 0x0314, 0x0342, 0x03A9, 0x0313, 0x03A9, 0x0314, 0x03A9, 0x0313, 0x0300, 0x03A9, 0x0314,
+	0x0300, 0x03A9, 0x0313, 0x0301, 0x03A9, 0x0314, 0x0301, 0x03A9, 0x0313, 0x0342, 0x03A9, 0x0314,
+	0x0342, 0x03B1, 0x0300, 0x03B1, 0x0301, 0x03B5, 0x0300, 0x03B5, 0x0301, 0x03B7, 0x0300, 0x03B7,
+	0x0301, 0x03B9, 0x0300, 0x03B9, 0x0301, 0x03BF, 0x0300, 0x03BF, 0x0301, 0x03C5, 0x0300, 0x03C5,
+	0x0301, 0x03C9, 0x0300, 0x03C9, 0x0301, 0x03B1, 0x0313, 0x0345, 0x03B1, 0x0314, 0x0345, 0x03B1,
+	0x0313, 0x0300, 0x0345, 0x03B1, 0x0314, 0x0300, 0x0345, 0x03B1, 0x0313, 0x0301, 0x0345, 0x03B1,
+	0x0314, 0x0301, 0x0345, 0x03B1, 0x0313, 0x0342, 0x0345, 0x03B1, 0x0314, 0x0342, 0x0345, 0x0391,
+	0x0313, 0x0345, 0x0391, 0x0314, 0x0345, 0x0391, 0x0313, 0x0300, 0x0345, 0x0391, 0x0314, 0x0300,
+	0x0345, 0x0391, 0x0313, 0x0301, 0x0345, 0x0391, 0x0314, 0x0301, 0x0345, 0x0391, 0x0313, 0x0342,
+	0x0345, 0x0391, 0x0314, 0x0342, 0x0345, 0x03B7, 0x0313, 0x0345, 0x03B7, 0x0314, 0x0345, 0x03B7,
+	0x0313, 0x0300, 0x0345, 0x03B7, 0x0314, 0x0300, 0x0345, 0x03B7, 0x0313, 0x0301, 0x0345, 0x03B7,
+	0x0314, 0x0301, 0x0345, 0x03B7, 0x0313, 0x0342, 0x0345, 0x03B7, 0x0314, 0x0342, 0x0345, 0x0397,
+	0x0313, 0x0345, 0x0397, 0x0314, 0x0345, 0x0397, 0x0313, 0x0300, 0x0345, 0x0397, 0x0314, 0x0300,
+	0x0345, 0x0397, 0x0313, 0x0301, 0x0345, 0x0397, 0x0314, 0x0301, 0x0345, 0x0397, 0x0313, 0x0342,
+	0x0345, 0x0397, 0x0314, 0x0342, 0x0345, 0x03C9, 0x0313, 0x0345, 0x03C9, 0x0314, 0x0345, 0x03C9,
+	0x0313, 0x0300, 0x0345, 0x03C9, 0x0314, 0x0300, 0x0345, 0x03C9, 0x0313, 0x0301, 0x0345, 0x03C9,
+	0x0314, 0x0301, 0x0345, 0x03C9, 0x0313, 0x0342, 0x0345, 0x03C9, 0x0314, 0x0342, 0x0345, 0x03A9,
+	0x0313, 0x0345, 0x03A9, 0x0314, 0x0345, 0x03A9, 0x0313, 0x0300, 0x0345, 0x03A9, 0x0314, 0x0300,
+	0x0345, 0x03A9, 0x0313, 0x0301, 0x0345, 0x03A9, 0x0314, 0x0301, 0x0345, 0x03A9, 0x0313, 0x0342,
+	0x0345, 0x03A9, 0x0314, 0x0342, 0x0345, 0x03B1, 0x0306, 0x03B1, 0x0304, 0x03B1, 0x0300, 0x0345,
+	0x03B1, 0x0345, 0x03B1, 0x0301, 0x0345, 0x03B1, 0x0342, 0x03B1, 0x0342, 0x0345, 0x0391, 0x0306,
+	0x0391, 0x0304, 0x0391, 0x0300, 0x0391, 0x0301, 0x0391, 0x0345, 0x03B9, 0x00A8, 0x0342, 0x03B7,
+	0x0300, 0x0345, 0x03B7, 0x0345, 0x03B7, 0x0301, 0x0345, 0x03B7, 0x0342, 0x03B7, 0x0342, 0x0345,
+	0x0395, 0x0300, 0x0395, 0x0301, 0x0397, 0x0300, 0x0397, 0x0301, 0x0397, 0x0345, 0x1FBF, 0x0300,
+	0x1FBF, 0x0301, 0x1FBF, 0x0342, 0x03B9, 0x0306, 0x03B9, 0x0304, 0x03B9, 0x0308, 0x0300, 0x03B9,
+	0x0308, 0x0301, 0x03B9, 0x0342, 0x03B9, 0x0308, 0x0342, 0x0399, 0x0306, 0x0399, 0x0304, 0x0399,
+	0x0300, 0x0399, 0x0301, 0x1FFE, 0x0300, 0x1FFE, 0x0301, 0x1FFE, 0x0342, 0x03C5, 0x0306, 0x03C5,
+	0x0304, 0x03C5, 0x0308, 0x0300, 0x03C5, 0x0308, 0x0301, 0x03C1, 0x0313, 0x03C1, 0x0314, 0x03C5,
+	0x0342, 0x03C5, 0x0308, 0x0342, 0x03A5, 0x0306, 0x03A5, 0x0304, 0x03A5, 0x0300, 0x03A5, 0x0301,
+	0x03A1, 0x0314, 0x00A8, 0x0300, 0x00A8, 0x0301, 0x0060, 0x03C9, 0x0300, 0x0345, 0x03C9, 0x0345,
+	0x03C9, 0x0301, 0x0345, 0x03C9, 0x0342, 0x03C9, 0x0342, 0x0345, 0x039F, 0x0300, 0x039F, 0x0301,
+	0x03A9, 0x0300, 0x03A9, 0x0301, 0x03A9, 0x0345, 0x00B4, 0x2002, 0x2003, 0x03A9, 0x004B, 0x0041,
+	0x030A, 0x2190, 0x0338, 0x2192, 0x0338, 0x2194, 0x0338, 0x21D0, 0x0338, 0x21D4, 0x0338, 0x21D2,
+	0x0338, 0x2203, 0x0338, 0x2208, 0x0338, 0x220B, 0x0338, 0x2223, 0x0338, 0x2225, 0x0338, 0x223C,
+	0x0338, 0x2243, 0x0338, 0x2245, 0x0338, 0x2248, 0x0338, 0x003D, 0x0338, 0x2261, 0x0338, 0x224D,
+	0x0338, 0x003C, 0x0338, 0x003E, 0x0338, 0x2264, 0x0338, 0x2265, 0x0338, 0x2272, 0x0338, 0x2273,
+	0x0338, 0x2276, 0x0338, 0x2277, 0x0338, 0x227A, 0x0338, 0x227B, 0x0338, 0x2282, 0x0338, 0x2283,
+	0x0338, 0x2286, 0x0338, 0x2287, 0x0338, 0x22A2, 0x0338, 0x22A8, 0x0338, 0x22A9, 0x0338, 0x22AB,
+	0x0338, 0x227C, 0x0338, 0x227D, 0x0338, 0x2291, 0x0338, 0x2292, 0x0338, 0x22B2, 0x0338, 0x22B3,
+	0x0338, 0x22B4, 0x0338, 0x22B5, 0x0338, 0x3008, 0x3009, 0x2ADD, 0x0338, 0x304B, 0x3099, 0x304D,
+	0x3099, 0x304F, 0x3099, 0x3051, 0x3099, 0x3053, 0x3099, 0x3055, 0x3099, 0x3057, 0x3099, 0x3059,
+	0x3099, 0x305B, 0x3099, 0x305D, 0x3099, 0x305F, 0x3099, 0x3061, 0x3099, 0x3064, 0x3099, 0x3066,
+	0x3099, 0x3068, 0x3099, 0x306F, 0x3099, 0x306F, 0x309A, 0x3072, 0x3099, 0x3072, 0x309A, 0x3075,
+	0x3099, 0x3075, 0x309A, 0x3078, 0x3099, 0x3078, 0x309A, 0x307B, 0x3099, 0x307B, 0x309A, 0x3046,
+	0x3099, 0x309D, 0x3099, 0x30AB, 0x3099, 0x30AD, 0x3099, 0x30AF, 0x3099, 0x30B1, 0x3099, 0x30B3,
+	0x3099, 0x30B5, 0x3099, 0x30B7, 0x3099, 0x30B9, 0x3099, 0x30BB, 0x3099, 0x30BD, 0x3099, 0x30BF,
+	0x3099, 0x30C1, 0x3099, 0x30C4, 0x3099, 0x30C6, 0x3099, 0x30C8, 0x3099, 0x30CF, 0x3099, 0x30CF,
+	0x309A, 0x30D2, 0x3099, 0x30D2, 0x309A, 0x30D5, 0x3099, 0x30D5, 0x309A, 0x30D8, 0x3099, 0x30D8,
+	0x309A, 0x30DB, 0x3099, 0x30DB, 0x309A, 0x30A6, 0x3099, 0x30EF, 0x3099, 0x30F0, 0x3099, 0x30F1,
+	0x3099, 0x30F2, 0x3099, 0x30FD, 0x3099, 0x8C48, 0x66F4, 0x8ECA, 0x8CC8, 0x6ED1, 0x4E32, 0x53E5,
+	0x9F9C, 0x9F9C, 0x5951, 0x91D1, 0x5587, 0x5948, 0x61F6, 0x7669, 0x7F85, 0x863F, 0x87BA, 0x88F8,
+	0x908F, 0x6A02, 0x6D1B, 0x70D9, 0x73DE, 0x843D, 0x916A, 0x99F1, 0x4E82, 0x5375, 0x6B04, 0x721B,
+	0x862D, 0x9E1E, 0x5D50, 0x6FEB, 0x85CD, 0x8964, 0x62C9, 0x81D8, 0x881F, 0x5ECA, 0x6717, 0x6D6A,
+	0x72FC, 0x90CE, 0x4F86, 0x51B7, 0x52DE, 0x64C4, 0x6AD3, 0x7210, 0x76E7, 0x8001, 0x8606, 0x865C,
+	0x8DEF, 0x9732, 0x9B6F, 0x9DFA, 0x788C, 0x797F, 0x7DA0, 0x83C9, 0x9304, 0x9E7F, 0x8AD6, 0x58DF,
+	0x5F04, 0x7C60, 0x807E, 0x7262, 0x78CA, 0x8CC2, 0x96F7, 0x58D8, 0x5C62, 0x6A13, 0x6DDA, 0x6F0F,
+	0x7D2F, 0x7E37, 0x964B, 0x52D2, 0x808B, 0x51DC, 0x51CC, 0x7A1C, 0x7DBE, 0x83F1, 0x9675, 0x8B80,
+	0x62CF, 0x6A02, 0x8AFE, 0x4E39, 0x5BE7, 0x6012, 0x7387, 0x7570, 0x5317, 0x78FB, 0x4FBF, 0x5FA9,
+	0x4E0D, 0x6CCC, 0x6578, 0x7D22, 0x53C3, 0x585E, 0x7701, 0x8449, 0x8AAA, 0x6BBA, 0x8FB0, 0x6C88,
+	0x62FE, 0x82E5, 0x63A0, 0x7565, 0x4EAE, 0x5169, 0x51C9, 0x6881, 0x7CE7, 0x826F, 0x8AD2, 0x91CF,
+	0x52F5, 0x5442, 0x5973, 0x5EEC, 0x65C5, 0x6FFE, 0x792A, 0x95AD, 0x9A6A, 0x9E97, 0x9ECE, 0x529B,
+	0x66C6, 0x6B77, 0x8F62, 0x5E74, 0x6190, 0x6200, 0x649A, 0x6F23, 0x7149, 0x7489, 0x79CA, 0x7DF4,
+	0x806F, 0x8F26, 0x84EE, 0x9023, 0x934A, 0x5217, 0x52A3, 0x54BD, 0x70C8, 0x88C2, 0x8AAA, 0x5EC9,
+	0x5FF5, 0x637B, 0x6BAE, 0x7C3E, 0x7375, 0x4EE4, 0x56F9, 0x5BE7, 0x5DBA, 0x601C, 0x73B2, 0x7469,
+	0x7F9A, 0x8046, 0x9234, 0x96F6, 0x9748, 0x9818, 0x4F8B, 0x79AE, 0x91B4, 0x96B8, 0x60E1, 0x4E86,
+	0x50DA, 0x5BEE, 0x5C3F, 0x6599, 0x6A02, 0x71CE, 0x7642, 0x84FC, 0x907C, 0x9F8D, 0x6688, 0x962E,
+	0x5289, 0x677B, 0x67F3, 0x6D41, 0x6E9C, 0x7409, 0x7559, 0x786B, 0x7D10, 0x985E, 0x516D, 0x622E,
+	0x9678, 0x502B, 0x5D19, 0x6DEA, 0x8F2A, 0x5F8B, 0x6144, 0x6817, 0x7387, 0x9686, 0x5229, 0x540F,
+	0x5C65, 0x6613, 0x674E, 0x68A8, 0x6CE5, 0x7406, 0x75E2, 0x7F79, 0x88CF, 0x88E1, 0x91CC, 0x96E2,
+	0x533F, 0x6EBA, 0x541D, 0x71D0, 0x7498, 0x85FA, 0x96A3, 0x9C57, 0x9E9F, 0x6797, 0x6DCB, 0x81E8,
+	0x7ACB, 0x7B20, 0x7C92, 0x72C0, 0x7099, 0x8B58, 0x4EC0, 0x8336, 0x523A, 0x5207, 0x5EA6, 0x62D3,
+	0x7CD6, 0x5B85, 0x6D1E, 0x66B4, 0x8F3B, 0x884C, 0x964D, 0x898B, 0x5ED3, 0x5140, 0x55C0, 0x585A,
+	0x6674, 0x51DE, 0x732A, 0x76CA, 0x793C, 0x795E, 0x7965, 0x798F, 0x9756, 0x7CBE, 0x7FBD, 0x8612,
+	0x8AF8, 0x9038, 0x90FD, 0x98EF, 0x98FC, 0x9928, 0x9DB4, 0x90DE, 0x96B7, 0x4FAE, 0x50E7, 0x514D,
+	0x52C9, 0x52E4, 0x5351, 0x559D, 0x5606, 0x5668, 0x5840, 0x58A8, 0x5C64, 0x5C6E, 0x6094, 0x6168,
+	0x618E, 0x61F2, 0x654F, 0x65E2, 0x6691, 0x6885, 0x6D77, 0x6E1A, 0x6F22, 0x716E, 0x722B, 0x7422,
+	0x7891, 0x793E, 0x7949, 0x7948, 0x7950, 0x7956, 0x795D, 0x798D, 0x798E, 0x7A40, 0x7A81, 0x7BC0,
+	0x7DF4, 0x7E09, 0x7E41, 0x7F72, 0x8005, 0x81ED, 0x8279, 0x8279, 0x8457, 0x8910, 0x8996, 0x8B01,
+	0x8B39, 0x8CD3, 0x8D08, 0x8FB6, 0x9038, 0x96E3, 0x97FF, 0x983B, 0x6075, 0x242EE, 0x8218, 0x4E26,
+	0x51B5, 0x5168, 0x4F80, 0x5145, 0x5180, 0x52C7, 0x52FA, 0x559D, 0x5555, 0x5599, 0x55E2, 0x585A,
+	0x58B3, 0x5944, 0x5954, 0x5A62, 0x5B28, 0x5ED2, 0x5ED9, 0x5F69, 0x5FAD, 0x60D8, 0x614E, 0x6108,
+	0x618E, 0x6160, 0x61F2, 0x6234, 0x63C4, 0x641C, 0x6452, 0x6556, 0x6674, 0x6717, 0x671B, 0x6756,
+	0x6B79, 0x6BBA, 0x6D41, 0x6EDB, 0x6ECB, 0x6F22, 0x701E, 0x716E, 0x77A7, 0x7235, 0x72AF, 0x732A,
+	0x7471, 0x7506, 0x753B, 0x761D, 0x761F, 0x76CA, 0x76DB, 0x76F4, 0x774A, 0x7740, 0x78CC, 0x7AB1,
+	0x7BC0, 0x7C7B, 0x7D5B, 0x7DF4, 0x7F3E, 0x8005, 0x8352, 0x83EF, 0x8779, 0x8941, 0x8986, 0x8996,
+	0x8ABF, 0x8AF8, 0x8ACB, 0x8B01, 0x8AFE, 0x8AED, 0x8B39, 0x8B8A, 0x8D08, 0x8F38, 0x9072, 0x9199,
+	0x9276, 0x967C, 0x96E3, 0x9756, 0x97DB, 0x97FF, 0x980B, 0x983B, 0x9B12, 0x9F9C, 0x2284A, 0x22844,
+	0x233D5, 0x3B9D, 0x4018, 0x4039, 0x25249, 0x25CD0, 0x27ED3, 0x9F43, 0x9F8E, 0x05D9, 0x05B4, 0x05F2,
+	0x05B7, 0x05E9, 0x05C1, 0x05E9, 0x05C2, 0x05E9, 0x05BC, 0x05C1, 0x05E9, 0x05BC, 0x05C2, 0x05D0,
+	0x05B7, 0x05D0, 0x05B8, 0x05D0, 0x05BC, 0x05D1, 0x05BC, 0x05D2, 0x05BC, 0x05D3, 0x05BC, 0x05D4,
+	0x05BC, 0x05D5, 0x05BC, 0x05D6, 0x05BC, 0x05D8, 0x05BC, 0x05D9, 0x05BC, 0x05DA, 0x05BC, 0x05DB,
+	0x05BC, 0x05DC, 0x05BC, 0x05DE, 0x05BC, 0x05E0, 0x05BC, 0x05E1, 0x05BC, 0x05E3, 0x05BC, 0x05E4,
+	0x05BC, 0x05E6, 0x05BC, 0x05E7, 0x05BC, 0x05E8, 0x05BC, 0x05E9, 0x05BC, 0x05EA, 0x05BC, 0x05D5,
+	0x05B9, 0x05D1, 0x05BF, 0x05DB, 0x05BF, 0x05E4, 0x05BF, 0x105D2, 0x0307, 0x105DA, 0x0307, 0x11099,
+	0x110BA, 0x1109B, 0x110BA, 0x110A5, 0x110BA, 0x11131, 0x11127, 0x11132, 0x11127, 0x11347, 0x1133E, 0x11347,
+	0x11357, 0x11382, 0x113C9, 0x11384, 0x113BB, 0x1138B, 0x113C2, 0x11390, 0x113C9, 0x113C2, 0x113C2, 0x113C2,
+	0x113B8, 0x113C2, 0x113C9, 0x114B9, 0x114BA, 0x114B9, 0x114B0, 0x114B9, 0x114BD, 0x115B8, 0x115AF, 0x115B9,
+	0x115AF, 0x11935, 0x11930, 0x1611E, 0x1611E, 0x1611E, 0x16129, 0x1611E, 0x1611F, 0x16129, 0x1611F, 0x1611E,
+	0x16120, 0x1611E, 0x1611E, 0x1611F, 0x1611E, 0x16129, 0x1611F, 0x1611E, 0x1611E, 0x16120, 0x16D67, 0x16D67,
+	0x16D63, 0x16D67, 0x16D63, 0x16D67, 0x16D67, 0x1D157, 0x1D165, 0x1D158, 0x1D165, 0x1D158, 0x1D165, 0x1D16E,
+	0x1D158, 0x1D165, 0x1D16F, 0x1D158, 0x1D165, 0x1D170, 0x1D158, 0x1D165, 0x1D171, 0x1D158, 0x1D165, 0x1D172,
+	0x1D1B9, 0x1D165, 0x1D1BA, 0x1D165, 0x1D1B9, 0x1D165, 0x1D16E, 0x1D1BA, 0x1D165, 0x1D16E, 0x1D1B9, 0x1D165,
+	0x1D16F, 0x1D1BA, 0x1D165, 0x1D16F, 0x4E3D, 0x4E38, 0x4E41, 0x20122, 0x4F60, 0x4FAE, 0x4FBB, 0x5002,
+	0x507A, 0x5099, 0x50E7, 0x50CF, 0x349E, 0x2063A, 0x514D, 0x5154, 0x5164, 0x5177, 0x2051C, 0x34B9,
+	0x5167, 0x518D, 0x2054B, 0x5197, 0x51A4, 0x4ECC, 0x51AC, 0x51B5, 0x291DF, 0x51F5, 0x5203, 0x34DF,
+	0x523B, 0x5246, 0x5272, 0x5277, 0x3515, 0x52C7, 0x52C9, 0x52E4, 0x52FA, 0x5305, 0x5306, 0x5317,
+	0x5349, 0x5351, 0x535A, 0x5373, 0x537D, 0x537F, 0x537F, 0x537F, 0x20A2C, 0x7070, 0x53CA, 0x53DF,
+	0x20B63, 0x53EB, 0x53F1, 0x5406, 0x549E, 0x5438, 0x5448, 0x5468, 0x54A2, 0x54F6, 0x5510, 0x5553,
+	0x5563, 0x5584, 0x5584, 0x5599, 0x55AB, 0x55B3, 0x55C2, 0x5716, 0x5606, 0x5717, 0x5651, 0x5674,
+	0x5207, 0x58EE, 0x57CE, 0x57F4, 0x580D, 0x578B, 0x5832, 0x5831, 0x58AC, 0x214E4, 0x58F2, 0x58F7,
+	0x5906, 0x591A, 0x5922, 0x5962, 0x216A8, 0x216EA, 0x59EC, 0x5A1B, 0x5A27, 0x59D8, 0x5A66, 0x36EE,
+	0x36FC, 0x5B08, 0x5B3E, 0x5B3E, 0x219C8, 0x5BC3, 0x5BD8, 0x5BE7, 0x5BF3, 0x21B18, 0x5BFF, 0x5C06,
+	0x5F53, 0x5C22, 0x3781, 0x5C60, 0x5C6E, 0x5CC0, 0x5C8D, 0x21DE4, 0x5D43, 0x21DE6, 0x5D6E, 0x5D6B,
+	0x5D7C, 0x5DE1, 0x5DE2, 0x382F, 0x5DFD, 0x5E28, 0x5E3D, 0x5E69, 0x3862, 0x22183, 0x387C, 0x5EB0,
+	0x5EB3, 0x5EB6, 0x5ECA, 0x2A392, 0x5EFE, 0x22331, 0x22331, 0x8201, 0x5F22, 0x5F22, 0x38C7, 0x232B8,
+	0x261DA, 0x5F62, 0x5F6B, 0x38E3, 0x5F9A, 0x5FCD, 0x5FD7, 0x5FF9, 0x6081, 0x393A, 0x391C, 0x6094,
+	0x226D4, 0x60C7, 0x6148, 0x614C, 0x614E, 0x614C, 0x617A, 0x618E, 0x61B2, 0x61A4, 0x61AF, 0x61DE,
+	0x61F2, 0x61F6, 0x6210, 0x621B, 0x625D, 0x62B1, 0x62D4, 0x6350, 0x22B0C, 0x633D, 0x62FC, 0x6368,
+	0x6383, 0x63E4, 0x22BF1, 0x6422, 0x63C5, 0x63A9, 0x3A2E, 0x6469, 0x647E, 0x649D, 0x6477, 0x3A6C,
+	0x654F, 0x656C, 0x2300A, 0x65E3, 0x66F8, 0x6649, 0x3B19, 0x6691, 0x3B08, 0x3AE4, 0x5192, 0x5195,
+	0x6700, 0x669C, 0x80AD, 0x43D9, 0x6717, 0x671B, 0x6721, 0x675E, 0x6753, 0x233C3, 0x3B49, 0x67FA,
+	0x6785, 0x6852, 0x6885, 0x2346D, 0x688E, 0x681F, 0x6914, 0x3B9D, 0x6942, 0x69A3, 0x69EA, 0x6AA8,
+	0x236A3, 0x6ADB, 0x3C18, 0x6B21, 0x238A7, 0x6B54, 0x3C4E, 0x6B72, 0x6B9F, 0x6BBA, 0x6BBB, 0x23A8D,
+	0x21D0B, 0x23AFA, 0x6C4E, 0x23CBC, 0x6CBF, 0x6CCD, 0x6C67, 0x6D16, 0x6D3E, 0x6D77, 0x6D41, 0x6D69,
+	0x6D78, 0x6D85, 0x23D1E, 0x6D34, 0x6E2F, 0x6E6E, 0x3D33, 0x6ECB, 0x6EC7, 0x23ED1, 0x6DF9, 0x6F6E,
+	0x23F5E, 0x23F8E, 0x6FC6, 0x7039, 0x701E, 0x701B, 0x3D96, 0x704A, 0x707D, 0x7077, 0x70AD, 0x20525,
+	0x7145, 0x24263, 0x719C, 0x243AB, 0x7228, 0x7235, 0x7250, 0x24608, 0x7280, 0x7295, 0x24735, 0x24814,
+	0x737A, 0x738B, 0x3EAC, 0x73A5, 0x3EB8, 0x3EB8, 0x7447, 0x745C, 0x7471, 0x7485, 0x74CA, 0x3F1B,
+	0x7524, 0x24C36, 0x753E, 0x24C92, 0x7570, 0x2219F, 0x7610, 0x24FA1, 0x24FB8, 0x25044, 0x3FFC, 0x4008,
+	0x76F4, 0x250F3, 0x250F2, 0x25119, 0x25133, 0x771E, 0x771F, 0x771F, 0x774A, 0x4039, 0x778B, 0x4046,
+	0x4096, 0x2541D, 0x784E, 0x788C, 0x78CC, 0x40E3, 0x25626, 0x7956, 0x2569A, 0x256C5, 0x798F, 0x79EB,
+	0x412F, 0x7A40, 0x7A4A, 0x7A4F, 0x2597C, 0x25AA7, 0x25AA7, 0x7AEE, 0x4202, 0x25BAB, 0x7BC6, 0x7BC9,
+	0x4227, 0x25C80, 0x7CD2, 0x42A0, 0x7CE8, 0x7CE3, 0x7D00, 0x25F86, 0x7D63, 0x4301, 0x7DC7, 0x7E02,
+	0x7E45, 0x4334, 0x26228, 0x26247, 0x4359, 0x262D9, 0x7F7A, 0x2633E, 0x7F95, 0x7FFA, 0x8005, 0x264DA,
+	0x26523, 0x8060, 0x265A8, 0x8070, 0x2335F, 0x43D5, 0x80B2, 0x8103, 0x440B, 0x813E, 0x5AB5, 0x267A7,
+	0x267B5, 0x23393, 0x2339C, 0x8201, 0x8204, 0x8F9E, 0x446B, 0x8291, 0x828B, 0x829D, 0x52B3, 0x82B1,
+	0x82B3, 0x82BD, 0x82E6, 0x26B3C, 0x82E5, 0x831D, 0x8363, 0x83AD, 0x8323, 0x83BD, 0x83E7, 0x8457,
+	0x8353, 0x83CA, 0x83CC, 0x83DC, 0x26C36, 0x26D6B, 0x26CD5, 0x452B, 0x84F1, 0x84F3, 0x8516, 0x273CA,
+	0x8564, 0x26F2C, 0x455D, 0x4561, 0x26FB1, 0x270D2, 0x456B, 0x8650, 0x865C, 0x8667, 0x8669, 0x86A9,
+	0x8688, 0x870E, 0x86E2, 0x8779, 0x8728, 0x876B, 0x8786, 0x45D7, 0x87E1, 0x8801, 0x45F9, 0x8860,
+	0x8863, 0x27667, 0x88D7, 0x88DE, 0x4635, 0x88FA, 0x34BB, 0x278AE, 0x27966, 0x46BE, 0x46C7, 0x8AA0,
+	0x8AED, 0x8B8A, 0x8C55, 0x27CA8, 0x8CAB, 0x8CC1, 0x8D1B, 0x8D77, 0x27F2F, 0x20804, 0x8DCB, 0x8DBC,
+	0x8DF0, 0x208DE, 0x8ED4, 0x8F38, 0x285D2, 0x285ED, 0x9094, 0x90F1, 0x9111, 0x2872E, 0x911B, 0x9238,
+	0x92D7, 0x92D8, 0x927C, 0x93F9, 0x9415, 0x28BFA, 0x958B, 0x4995, 0x95B7, 0x28D77, 0x49E6, 0x96C3,
+	0x5DB2, 0x9723, 0x29145, 0x2921A, 0x4A6E, 0x4A76, 0x97E0, 0x2940A, 0x4AB2, 0x29496, 0x980B, 0x980B,
+	0x9829, 0x295B6, 0x98E2, 0x4B33, 0x9929, 0x99A7, 0x99C2, 0x99FE, 0x4BCE, 0x29B30, 0x9B12, 0x9C40,
+	0x9CFD, 0x4CCE, 0x4CED, 0x9D67, 0x2A0CE, 0x4CF8, 0x2A105, 0x2A20E, 0x2A291, 0x9EBB, 0x4D56, 0x9EF9,
+	0x9EFE, 0x9F05, 0x9F0F, 0x9F16, 0x9F3B, 0x2A600,
+}
+
+var compatibilityDecompositions = []decomposition{
+	{0x00A0, 0, 1},
+	{0x00A8, 1, 3},
+	{0x00AA, 3, 4},
+	{0x00AF, 4, 6},
+	{0x00B2, 6, 7},
+	{0x00B3, 7, 8},
+	{0x00B4, 8, 10},
+	{0x00B5, 10, 11},
+	{0x00B8, 11, 13},
+	{0x00B9, 13, 14},
+	{0x00BA, 14, 15},
+	{0x00BC, 15, 18},
+	{0x00BD, 18, 21},
+	{0x00BE, 21, 24},
+	{0x00C0, 24, 26},
+	{0x00C1, 26, 28},
+	{0x00C2, 28, 30},
+	{0x00C3, 30, 32},
+	{0x00C4, 32, 34},
+	{0x00C5, 34, 36},
+	{0x00C7, 36, 38},
+	{0x00C8, 38, 40},
+	{0x00C9, 40, 42},
+	{0x00CA, 42, 44},
+	{0x00CB, 44, 46},
+	{0x00CC, 46, 48},
+	{0x00CD, 48, 50},
+	{0x00CE, 50, 52},
+	{0x00CF, 52, 54},
+	{0x00D1, 54, 56},
+	{0x00D2, 56, 58},
+	{0x00D3, 58, 60},
+	{0x00D4, 60, 62},
+	{0x00D5, 62, 64},
+	{0x00D6, 64, 66},
+	{0x00D9, 66, 68},
+	{0x00DA, 68, 70},
+	{0x00DB, 70, 72},
+	{0x00DC, 72, 74},
+	{0x00DD, 74, 76},
+	{0x00E0, 76, 78},
+	{0x00E1, 78, 80},
+	{0x00E2, 80, 82},
+	{0x00E3, 82, 84},
+	{0x00E4, 84, 86},
+	{0x00E5, 86, 88},
+	{0x00E7, 88, 90},
+	{0x00E8, 90, 92},
+	{0x00E9, 92, 94},
+	{0x00EA, 94, 96},
+	{0x00EB, 96, 98},
+	{0x00EC, 98, 100},
+	{0x00ED, 100, 102},
+	{0x00EE, 102, 104},
+	{0x00EF, 104, 106},
+	{0x00F1, 106, 108},
+	{0x00F2, 108, 110},
+	{0x00F3, 110, 112},
+	{0x00F4, 112, 114},
+	{0x00F5, 114, 116},
+	{0x00F6, 116, 118},
+	{0x00F9, 118, 120},
+	{0x00FA, 120, 122},
+	{0x00FB, 122, 124},
+	{0x00FC, 124, 126},
+	{0x00FD, 126, 128},
+	{0x00FF, 128, 130},
+	{0x0100, 130, 132},
+	{0x0101, 132, 134},
+	{0x0102, 134, 136},
+	{0x0103, 136, 138},
+	{0x0104, 138, 140},
+	{0x0105, 140, 142},
+	{0x0106, 142, 144},
+	{0x0107, 144, 146},
+	{0x0108, 146, 148},
+	{0x0109, 148, 150},
+	{0x010A, 150, 152},
+	{0x010B, 152, 154},
+	{0x010C, 154, 156},
+	{0x010D, 156, 158},
+	{0x010E, 158, 160},
+	{0x010F, 160, 162},
+	{0x0112, 162, 164},
+	{0x0113, 164, 166},
+	{0x0114, 166, 168},
+	{0x0115, 168, 170},
+	{0x0116, 170, 172},
+	{0x0117, 172, 174},
+	{0x0118, 174, 176},
+	{0x0119, 176, 178},
+	{0x011A, 178, 180},
+	{0x011B, 180, 182},
+	{0x011C, 182, 184},
+	{0x011D, 184, 186},
+	{0x011E, 186, 188},
+	{0x011F, 188, 190},
+	{0x0120, 190, 192},
+	{0x0121, 192, 194},
+	{0x0122, 194, 196},
+	{0x0123, 196, 198},
+	{0x0124, 198, 200},
+	{0x0125, 200, 202},
+	{0x0128, 202, 204},
+	{0x0129, 204, 206},
+	{0x012A, 206, 208},
+	{0x012B, 208, 210},
+	{0x012C, 210, 212},
+	{0x012D, 212, 214},
+	{0x012E, 214, 216},
+	{0x012F, 216, 218},
+	{0x0130, 218, 220},
+	{0x0132, 220, 222},
+	{0x0133, 222, 224},
+	{0x0134, 224, 226},
+	{0x0135, 226, 228},
+	{0x0136, 228, 230},
+	{0x0137, 230, 232},
+	{0x0139, 232, 234},
+	{0x013A, 234, 236},
+	{0x013B, 236, 238},
+	{0x013C, 238, 240},
+	{0x013D, 240, 242},
+	{0x013E, 242, 244},
+	{0x013F, 244, 246},
+	{0x0140, 246, 248},
+	{0x0143, 248, 250},
+	{0x0144, 250, 252},
+	{0x0145, 252, 254},
+	{0x0146, 254, 256},
+	{0x0147, 256, 258},
+	{0x0148, 258, 260},
+	{0x0149, 260, 262},
+	{0x014C, 262, 264},
+	{0x014D, 264, 266},
+	{0x014E, 266, 268},
+	{0x014F, 268, 270},
+	{0x0150, 270, 272},
+	{0x0151, 272, 274},
+	{0x0154, 274, 276},
+	{0x0155, 276, 278},
+	{0x0156, 278, 280},
+	{0x0157, 280, 282},
+	{0x0158, 282, 284},
+	{0x0159, 284, 286},
+	{0x015A, 286, 288},
+	{0x015B, 288, 290},
+	{0x015C, 290, 292},
+	{0x015D, 292, 294},
+	{0x015E, 294, 296},
+	{0x015F, 296, 298},
+	{0x0160, 298, 300},
+	{0x0161, 300, 302},
+	{0x0162, 302, 304},
+	{0x0163, 304, 306},
+	{0x0164, 306, 308},
+	{0x0165, 308, 310},
+	{0x0168, 310, 312},
+	{0x0169, 312, 314},
+	{0x016A, 314, 316},
+	{0x016B, 316, 318},
+	{0x016C, 318, 320},
+	{0x016D, 320, 322},
+	{0x016E, 322, 324},
+	{0x016F, 324, 326},
+	{0x0170, 326, 328},
+	{0x0171, 328, 330},
+	{0x0172, 330, 332},
+	{0x0173, 332, 334},
+	{0x0174, 334, 336},
+	{0x0175, 336, 338},
+	{0x0176, 338, 340},
+	{0x0177, 340, 342},
+	{0x0178, 342, 344},
+	{0x0179, 344, 346},
+	{0x017A, 346, 348},
+	{0x017B, 348, 350},
+	{0x017C, 350, 352},
+	{0x017D, 352, 354},
+	{0x017E, 354, 356},
+	{0x017F, 356, 357},
+	{0x01A0, 357, 359},
+	{0x01A1, 359, 361},
+	{0x01AF, 361, 363},
+	{0x01B0, 363, 365},
+	{0x01C4, 365, 368},
+	{0x01C5, 368, 371},
+	{0x01C6, 371, 374},
+	{0x01C7, 374, 376},
+	{0x01C8, 376, 378},
+	{0x01C9, 378, 380},
+	{0x01CA, 380, 382},
+	{0x01CB, 382, 384},
+	{0x01CC, 384, 386},
+	{0x01CD, 386, 388},
+	{0x01CE, 388, 390},
+	{0x01CF, 390, 392},
+	{0x01D0, 392, 394},
+	{0x01D1, 394, 396},
+	{0x01D2, 396, 398},
+	{0x01D3, 398, 400},
+	{0x01D4, 400, 402},
+	{0x01D5, 402, 405},
+	{0x01D6, 405, 408},
+	{0x01D7, 408, 411},
+	{0x01D8, 411, 414},
+	{0x01D9, 414, 417},
+	{0x01DA, 417, 420},
+	{0x01DB, 420, 423},
+	{0x01DC, 423, 426},
+	{0x01DE, 426, 429},
+	{0x01DF, 429, 432},
+	{0x01E0, 432, 435},
+	{0x01E1, 435, 438},
+	{0x01E2, 438, 440},
+	{0x01E3, 440, 442},
+	{0x01E6, 442, 444},
+	{0x01E7, 444, 446},
+	{0x01E8, 446, 448},
+	{0x01E9, 448, 450},
+	{0x01EA, 450, 452},
+	{0x01EB, 452, 454},
+	{0x01EC, 454, 457},
+	{0x01ED, 457, 460},
+	{0x01EE, 460, 462},
+	{0x01EF, 462, 464},
+	{0x01F0, 464, 466},
+	{0x01F1, 466, 468},
+	{0x01F2, 468, 470},
+	{0x01F3, 470, 472},
+	{0x01F4, 472, 474},
+	{0x01F5, 474, 476},
+	{0x01F8, 476, 478},
+	{0x01F9, 478, 480},
+	{0x01FA, 480, 483},
+	{0x01FB, 483, 486},
+	{0x01FC, 486, 488},
+	{0x01FD, 488, 490},
+	{0x01FE, 490, 492},
+	{0x01FF, 492, 494},
+	{0x0200, 494, 496},
+	{0x0201, 496, 498},
+	{0x0202, 498, 500},
+	{0x0203, 500, 502},
+	{0x0204, 502, 504},
+	{0x0205, 504, 506},
+	{0x0206, 506, 508},
+	{0x0207, 508, 510},
+	{0x0208, 510, 512},
+	{0x0209, 512, 514},
+	{0x020A, 514, 516},
+	{0x020B, 516, 518},
+	{0x020C, 518, 520},
+	{0x020D, 520, 522},
+	{0x020E, 522, 524},
+	{0x020F, 524, 526},
+	{0x0210, 526, 528},
+	{0x0211, 528, 530},
+	{0x0212, 530, 532},
+	{0x0213, 532, 534},
+	{0x0214, 534, 536},
+	{0x0215, 536, 538},
+	{0x0216, 538, 540},
+	{0x0217, 540, 542},
+	{0x0218, 542, 544},
+	{0x0219, 544, 546},
+	{0x021A, 546, 548},
+	{0x021B, 548, 550},
+	{0x021E, 550, 552},
+	{0x021F, 552, 554},
+	{0x0226, 554, 556},
+	{0x0227, 556, 558},
+	{0x0228, 558, 560},
+	{0x0229, 560, 562},
+	{0x022A, 562, 565},
+	{0x022B, 565, 568},
+	{0x022C, 568, 571},
+	{0x022D, 571, 574},
+	{0x022E, 574, 576},
+	{0x022F, 576, 578},
+	{0x0230, 578, 581},
+	{0x0231, 581, 584},
+	{0x0232, 584, 586},
+	{0x0233, 586, 588},
+	{0x02B0, 588, 589},
+	{0x02B1, 589, 590},
+	{0x02B2, 590, 591},
+	{0x02B3, 591, 592},
+	{0x02B4, 592, 593},
+	{0x02B5, 593, 594},
+	{0x02B6, 594, 595},
+	{0x02B7, 595, 596},
+	{0x02B8, 596, 597},
+	{0x02D8, 597, 599},
+	{0x02D9, 599, 601},
+	{0x02DA, 601, 603},
+	{0x02DB, 603, 605},
+	{0x02DC, 605, 607},
+	{0x02DD, 607, 609},
+	{0x02E0, 609, 610},
+	{0x02E1, 610, 611},
+	{0x02E2, 611, 612},
+	{0x02E3, 612, 613},
+	{0x02E4, 613, 614},
+	{0x0340, 614, 615},
+	{0x0341, 615, 616},
+	{0x0343, 616, 617},
+	{0x0344, 617, 619},
+	{0x0374, 619, 620},
+	{0x037A, 620, 622},
+	{0x037E, 622, 623},
+	{0x0384, 623, 625},
+	{0x0385, 625, 628},
+	{0x0386, 628, 630},
+	{0x0387, 630, 631},
+	{0x0388, 631, 633},
+	{0x0389, 633, 635},
+	{0x038A, 635, 637},
+	{0x038C, 637, 639},
+	{0x038E, 639, 641},
+	{0x038F, 641, 643},
+	{0x0390, 643, 646},
+	{0x03AA, 646, 648},
+	{0x03AB, 648, 650},
+	{0x03AC, 650, 652},
+	{0x03AD, 652, 654},
+	{0x03AE, 654, 656},
+	{0x03AF, 656, 658},
+	{0x03B0, 658, 661},
+	{0x03CA, 661, 663},
+	{0x03CB, 663, 665},
+	{0x03CC, 665, 667},
+	{0x03CD, 667, 669},
+	{0x03CE, 669, 671},
+	{0x03D0, 671, 672},
+	{0x03D1, 672, 673},
+	{0x03D2, 673, 674},
+	{0x03D3, 674, 676},
+	{0x03D4, 676, 678},
+	{0x03D5, 678, 679},
+	{0x03D6, 679, 680},
+	{0x03F0, 680, 681},
+	{0x03F1, 681, 682},
+	{0x03F2, 682, 683},
+	{0x03F4, 683, 684},
+	{0x03F5, 684, 685},
+	{0x03F9, 685, 686},
+	{0x0400, 686, 688},
+	{0x0401, 688, 690},
+	{0x0403, 690, 692},
+	{0x0407, 692, 694},
+	{0x040C, 694, 696},
+	{0x040D, 696, 698},
+	{0x040E, 698, 700},
+	{0x0419, 700, 702},
+	{0x0439, 702, 704},
+	{0x0450, 704, 706},
+	{0x0451, 706, 708},
+	{0x0453, 708, 710},
+	{0x0457, 710, 712},
+	{0x045C, 712, 714},
+	{0x045D, 714, 716},
+	{0x045E, 716, 718},
+	{0x0476, 718, 720},
+	{0x0477, 720, 722},
+	{0x04C1, 722, 724},
+	{0x04C2, 724, 726},
+	{0x04D0, 726, 728},
+	{0x04D1, 728, 730},
+	{0x04D2, 730, 732},
+	{0x04D3, 732, 734},
+	{0x04D6, 734, 736},
+	{0x04D7, 736, 738},
+	{0x04DA, 738, 740},
+	{0x04DB, 740, 742},
+	{0x04DC, 742, 744},
+	{0x04DD, 744, 746},
+	{0x04DE, 746, 748},
+	{0x04DF, 748, 750},
+	{0x04E2, 750, 752},
+	{0x04E3, 752, 754},
+	{0x04E4, 754, 756},
+	{0x04E5, 756, 758},
+	{0x04E6, 758, 760},
+	{0x04E7, 760, 762},
+	{0x04EA, 762, 764},
+	{0x04EB, 764, 766},
+	{0x04EC, 766, 768},
+	{0x04ED, 768, 770},
+	{0x04EE, 770, 772},
+	{0x04EF, 772, 774},
+	{0x04F0, 774, 776},
+	{0x04F1, 776, 778},
+	{0x04F2, 778, 780},
+	{0x04F3, 780, 782},
+	{0x04F4, 782, 784},
+	{0x04F5, 784, 786},
+	{0x04F8, 786, 788},
+	{0x04F9, 788, 790},
+	{0x0587, 790, 792},
+	{0x0622, 792, 794},
+	{0x0623, 794, 796},
+	{0x0624, 796, 798},
+	{0x0625, 798, 800},
+	{0x0626, 800, 802},
+	{0x0675, 802, 804},
+	{0x0676, 804, 806},
+	{0x0677, 806, 808},
+	{0x0678, 808, 810},
+	{0x06C0, 810, 812},
+	{0x06C2, 812, 814},
+	{0x06D3, 814, 816},
+	{0x0929, 816, 818},
+	{0x0931, 818, 820},
+	{0x0934, 820, 822},
+	{0x0958, 822, 824},
+	{0x0959, 824, 826},
+	{0x095A, 826, 828},
+	{0x095B, 828, 830},
+	{0x095C, 830, 832},
+	{0x095D, 832, 834},
+	{0x095E, 834, 836},
+	{0x095F, 836, 838},
+	{0x09CB, 838, 840},
+	{0x09CC, 840, 842},
+	{0x09DC, 842, 844},
+	{0x09DD, 844, 846},
+	{0x09DF, 846, 848},
+	{0x0A33, 848, 850},
+	{0x0A36, 850, 852},
+	{0x0A59, 852, 854},
+	{0x0A5A, 854, 856},
+	{0x0A5B, 856, 858},
+	{0x0A5E, 858, 860},
+	{0x0B48, 860, 862},
+	{0x0B4B, 862, 864},
+	{0x0B4C, 864, 866},
+	{0x0B5C, 866, 868},
+	{0x0B5D, 868, 870},
+	{0x0B94, 870, 872},
+	{0x0BCA, 872, 874},
+	{0x0BCB, 874, 876},
+	{0x0BCC, 876, 878},
+	{0x0C48, 878, 880},
+	{0x0CC0, 880, 882},
+	{0x0CC7, 882, 884},
+	{0x0CC8, 884, 886},
+	{0x0CCA, 886, 888},
+	{0x0CCB, 888, 891},
+	{0x0D4A, 891, 893},
+	{0x0D4B, 893, 895},
+	{0x0D4C, 895, 897},
+	{0x0DDA, 897, 899},
+	{0x0DDC, 899, 901},
+	{0x0DDD, 901, 904},
+	{0x0DDE, 904, 906},
+	{0x0E33, 906, 908},
+	{0x0EB3, 908, 910},
+	{0x0EDC, 910, 912},
+	{0x0EDD, 912, 914},
+	{0x0F0C, 914, 915},
+	{0x0F43, 915, 917},
+	{0x0F4D, 917, 919},
+	{0x0F52, 919, 921},
+	{0x0F57, 921, 923},
+	{0x0F5C, 923, 925},
+	{0x0F69, 925, 927},
+	{0x0F73, 927, 929},
+	{0x0F75, 929, 931},
+	{0x0F76, 931, 933},
+	{0x0F77, 933, 936},
+	{0x0F78, 936, 938},
+	{0x0F79, 938, 941},
+	{0x0F81, 941, 943},
+	{0x0F93, 943, 945},
+	{0x0F9D, 945, 947},
+	{0x0FA2, 947, 949},
+	{0x0FA7, 949, 951},
+	{0x0FAC, 951, 953},
+	{0x0FB9, 953, 955},
+	{0x1026, 955, 957},
+	{0x10FC, 957, 958},
+	{0x1B06, 958, 960},
+	{0x1B08, 960, 962},
+	{0x1B0A, 962, 964},
+	{0x1B0C, 964, 966},
+	{0x1B0E, 966, 968},
+	{0x1B12, 968, 970},
+	{0x1B3B, 970, 972},
+	{0x1B3D, 972, 974},
+	{0x1B40, 974, 976},
+	{0x1B41, 976, 978},
+	{0x1B43, 978, 980},
+	{0x1D2C, 980, 981},
+	{0x1D2D, 981, 982},
+	{0x1D2E, 982, 983},
+	{0x1D30, 983, 984},
+	{0x1D31, 984, 985},
+	{0x1D32, 985, 986},
+	{0x1D33, 986, 987},
+	{0x1D34, 987, 988},
+	{0x1D35, 988, 989},
+	{0x1D36, 989, 990},
+	{0x1D37, 990, 991},
+	{0x1D38, 991, 992},
+	{0x1D39, 992, 993},
+	{0x1D3A, 993, 994},
+	{0x1D3C, 994, 995},
+	{0x1D3D, 995, 996},
+	{0x1D3E, 996, 997},
+	{0x1D3F, 997, 998},
+	{0x1D40, 998, 999},
+	{0x1D41, 999, 1000},
+	{0x1D42, 1000, 1001},
+	{0x1D43, 1001, 1002},
+	{0x1D44, 1002, 1003},
+	{0x1D45, 1003, 1004},
+	{0x1D46, 1004, 1005},
+	{0x1D47, 1005, 1006},
+	{0x1D48, 1006, 1007},
+	{0x1D49, 1007, 1008},
+	{0x1D4A, 1008, 1009},
+	{0x1D4B, 1009, 1010},
+	{0x1D4C, 1010, 1011},
+	{0x1D4D, 1011, 1012},
+	{0x1D4F, 1012, 1013},
+	{0x1D50, 1013, 1014},
+	{0x1D51, 1014, 1015},
+	{0x1D52, 1015, 1016},
+	{0x1D53, 1016, 1017},
+	{0x1D54, 1017, 1018},
+	{0x1D55, 1018, 1019},
+	{0x1D56, 1019, 1020},
+	{0x1D57, 1020, 1021},
+	{0x1D58, 1021, 1022},
+	{0x1D59, 1022, 1023},
+	{0x1D5A, 1023, 1024},
+	{0x1D5B, 1024, 1025},
+	{0x1D5C, 1025, 1026},
+	{0x1D5D, 1026, 1027},
+	{0x1D5E, 1027, 1028},
+	{0x1D5F, 1028, 1029},
+	{0x1D60, 1029, 1030},
+	{0x1D61, 1030, 1031},
+	{0x1D62, 1031, 1032},
+	{0x1D63, 1032, 1033},
+	{0x1D64, 1033, 1034},
+	{0x1D65, 1034, 1035},
+	{0x1D66, 1035, 1036},
+	{0x1D67, 1036, 1037},
+	{0x1D68, 1037, 1038},
+	{0x1D69, 1038, 1039},
+	{0x1D6A, 1039, 1040},
+	{0x1D78, 1040, 1041},
+	{0x1D9B, 1041, 1042},
+	{0x1D9C, 1042, 1043},
+	{0x1D9D, 1043, 1044},
+	{0x1D9E, 1044, 1045},
+	{0x1D9F, 1045, 1046},
+	{0x1DA0, 1046, 1047},
+	{0x1DA1, 1047, 1048},
+	{0x1DA2, 1048, 1049},
+	{0x1DA3, 1049, 1050},
+	{0x1DA4, 1050, 1051},
+	{0x1DA5, 1051, 1052},
+	{0x1DA6, 1052, 1053},
+	{0x1DA7, 1053, 1054},
+	{0x1DA8, 1054, 1055},
+	{0x1DA9, 1055, 1056},
+	{0x1DAA, 1056, 1057},
+	{0x1DAB, 1057, 1058},
+	{0x1DAC, 1058, 1059},
+	{0x1DAD, 1059, 1060},
+	{0x1DAE, 1060, 1061},
+	{0x1DAF, 1061, 1062},
+	{0x1DB0, 1062, 1063},
+	{0x1DB1, 1063, 1064},
+	{0x1DB2, 1064, 1065},
+	{0x1DB3, 1065, 1066},
+	{0x1DB4, 1066, 1067},
+	{0x1DB5, 1067, 1068},
+	{0x1DB6, 1068, 1069},
+	{0x1DB7, 1069, 1070},
+	{0x1DB8, 1070, 1071},
+	{0x1DB9, 1071, 1072},
+	{0x1DBA, 1072, 1073},
+	{0x1DBB, 1073, 1074},
+	{0x1DBC, 1074, 1075},
+	{0x1DBD, 1075, 1076},
+	{0x1DBE, 1076, 1077},
+	{0x1DBF, 1077, 1078},
+	{0x1E00, 1078, 1080},
+	{0x1E01, 1080, 1082},
+	{0x1E02, 1082, 1084},
+	{0x1E03, 1084, 1086},
+	{0x1E04, 1086, 1088},
+	{0x1E05, 1088, 1090},
+	{0x1E06, 1090, 1092},
+	{0x1E07, 1092, 1094},
+	{0x1E08, 1094, 1097},
+	{0x1E09, 1097, 1100},
+	{0x1E0A, 1100, 1102},
+	{0x1E0B, 1102, 1104},
+	{0x1E0C, 1104, 1106},
+	{0x1E0D, 1106, 1108},
+	{0x1E0E, 1108, 1110},
+	{0x1E0F, 1110, 1112},
+	{0x1E10, 1112, 1114},
+	{0x1E11, 1114, 1116},
+	{0x1E12, 1116, 1118},
+	{0x1E13, 1118, 1120},
+	{0x1E14, 1120, 1123},
+	{0x1E15, 1123, 1126},
+	{0x1E16, 1126, 1129},
+	{0x1E17, 1129, 1132},
+	{0x1E18, 1132, 1134},
+	{0x1E19, 1134, 1136},
+	{0x1E1A, 1136, 1138},
+	{0x1E1B, 1138, 1140},
+	{0x1E1C, 1140, 1143},
+	{0x1E1D, 1143, 1146},
+	{0x1E1E, 1146, 1148},
+	{0x1E1F, 1148, 1150},
+	{0x1E20, 1150, 1152},
+	{0x1E21, 1152, 1154},
+	{0x1E22, 1154, 1156},
+	{0x1E23, 1156, 1158},
+	{0x1E24, 1158, 1160},
+	{0x1E25, 1160, 1162},
+	{0x1E26, 1162, 1164},
+	{0x1E27, 1164, 1166},
+	{0x1E28, 1166, 1168},
+	{0x1E29, 1168, 1170},
+	{0x1E2A, 1170, 1172},
+	{0x1E2B, 1172, 1174},
+	{0x1E2C, 1174, 1176},
+	{0x1E2D, 1176, 1178},
+	{0x1E2E, 1178, 1181},
+	{0x1E2F, 1181, 1184},
+	{0x1E30, 1184, 1186},
+	{0x1E31, 1186, 1188},
+	{0x1E32, 1188, 1190},
+	{0x1E33, 1190, 1192},
+	{0x1E34, 1192, 1194},
+	{0x1E35, 1194, 1196},
+	{0x1E36, 1196, 1198},
+	{0x1E37, 1198, 1200},
+	{0x1E38, 1200, 1203},
+	{0x1E39, 1203, 1206},
+	{0x1E3A, 1206, 1208},
+	{0x1E3B, 1208, 1210},
+	{0x1E3C, 1210, 1212},
+	{0x1E3D, 1212, 1214},
+	{0x1E3E, 1214, 1216},
+	{0x1E3F, 1216, 1218},
+	{0x1E40, 1218, 1220},
+	{0x1E41, 1220, 1222},
+	{0x1E42, 1222, 1224},
+	{0x1E43, 1224, 1226},
+	{0x1E44, 1226, 1228},
+	{0x1E45, 1228, 1230},
+	{0x1E46, 1230, 1232},
+	{0x1E47, 1232, 1234},
+	{0x1E48, 1234, 1236},
+	{0x1E49, 1236, 1238},
+	{0x1E4A, 1238, 1240},
+	{0x1E4B, 1240, 1242},
+	{0x1E4C, 1242, 1245},
+	{0x1E4D, 1245, 1248},
+	{0x1E4E, 1248, 1251},
+	{0x1E4F, 1251, 1254},
+	{0x1E50, 1254, 1257},
+	{0x1E51, 1257, 1260},
+	{0x1E52, 1260, 1263},
+	{0x1E53, 1263, 1266},
+	{0x1E54, 1266, 1268},
+	{0x1E55, 1268, 1270},
+	{0x1E56, 1270, 1272},
+	{0x1E57, 1272, 1274},
+	{0x1E58, 1274, 1276},
+	{0x1E59, 1276, 1278},
+	{0x1E5A, 1278, 1280},
+	{0x1E5B, 1280, 1282},
+	{0x1E5C, 1282, 1285},
+	{0x1E5D, 1285, 1288},
+	{0x1E5E, 1288, 1290},
+	{0x1E5F, 1290, 1292},
+	{0x1E60, 1292, 1294},
+	{0x1E61, 1294, 1296},
+	{0x1E62, 1296, 1298},
+	{0x1E63, 1298, 1300},
+	{0x1E64, 1300, 1303},
+	{0x1E65, 1303, 1306},
+	{0x1E66, 1306, 1309},
+	{0x1E67, 1309, 1312},
+	{0x1E68, 1312, 1315},
+	{0x1E69, 1315, 1318},
+	{0x1E6A, 1318, 1320},
+	{0x1E6B, 1320, 1322},
+	{0x1E6C, 1322, 1324},
+	{0x1E6D, 1324, 1326},
+	{0x1E6E, 1326, 1328},
+	{0x1E6F, 1328, 1330},
+	{0x1E70, 1330, 1332},
+	{0x1E71, 1332, 1334},
+	{0x1E72, 1334, 1336},
+	{0x1E73, 1336, 1338},
+	{0x1E74, 1338, 1340},
+	{0x1E75, 1340, 1342},
+	{0x1E76, 1342, 1344},
+	{0x1E77, 1344, 1346},
+	{0x1E78, 1346, 1349},
+	{0x1E79, 1349, 1352},
+	{0x1E7A, 1352, 1355},
+	{0x1E7B, 1355, 1358},
+	{0x1E7C, 1358, 1360},
+	{0x1E7D, 1360, 1362},
+	{0x1E7E, 1362, 1364},
+	{0x1E7F, 1364, 1366},
+	{0x1E80, 1366, 1368},
+	{0x1E81, 1368, 1370},
+	{0x1E82, 1370, 1372},
+	{0x1E83, 1372, 1374},
+	{0x1E84, 1374, 1376},
+	{0x1E85, 1376, 1378},
+	{0x1E86, 1378, 1380},
+	{0x1E87, 1380, 1382},
+	{0x1E88, 1382, 1384},
+	{0x1E89, 1384, 1386},
+	{0x1E8A, 1386, 1388},
+	{0x1E8B, 1388, 1390},
+	{0x1E8C, 1390, 1392},
+	{0x1E8D, 1392, 1394},
+	{0x1E8E, 1394, 1396},
+	{0x1E8F, 1396, 1398},
+	{0x1E90, 1398, 1400},
+	{0x1E91, 1400, 1402},
+	{0x1E92, 1402, 1404},
+	{0x1E93, 1404, 1406},
+	{0x1E94, 1406, 1408},
+	{0x1E95, 1408, 1410},
+	{0x1E96, 1410, 1412},
+	{0x1E97, 1412, 1414},
+	{0x1E98, 1414, 1416},
+	{0x1E99, 1416, 1418},
+	{0x1E9A, 1418, 1420},
+	{0x1E9B, 1420, 1422},
+	{0x1EA0, 1422, 1424},
+	{0x1EA1, 1424, 1426},
+	{0x1EA2, 1426, 1428},
+	{0x1EA3, 1428, 1430},
+	{0x1EA4, 1430, 1433},
+	{0x1EA5, 1433, 1436},
+	{0x1EA6, 1436, 1439},
+	{0x1EA7, 1439, 1442},
+	{0x1EA8, 1442, 1445},
+	{0x1EA9, 1445, 1448},
+	{0x1EAA, 1448, 1451},
+	{0x1EAB, 1451, 1454},
+	{0x1EAC, 1454, 1457},
+	{0x1EAD, 1457, 1460},
+	{0x1EAE, 1460, 1463},
+	{0x1EAF, 1463, 1466},
+	{0x1EB0, 1466, 1469},
+	{0x1EB1, 1469, 1472},
+	{0x1EB2, 1472, 1475},
+	{0x1EB3, 1475, 1478},
+	{0x1EB4, 1478, 1481},
+	{0x1EB5, 1481, 1484},
+	{0x1EB6, 1484, 1487},
+	{0x1EB7, 1487, 1490},
+	{0x1EB8, 1490, 1492},
+	{0x1EB9, 1492, 1494},
+	{0x1EBA, 1494, 1496},
+	{0x1EBB, 1496, 1498},
+	{0x1EBC, 1498, 1500},
+	{0x1EBD, 1500, 1502},
+	{0x1EBE, 1502, 1505},
+	{0x1EBF, 1505, 1508},
+	{0x1EC0, 1508, 1511},
+	{0x1EC1, 1511, 1514},
+	{0x1EC2, 1514, 1517},
+	{0x1EC3, 1517, 1520},
+	{0x1EC4, 1520, 1523},
+	{0x1EC5, 1523, 1526},
+	{0x1EC6, 1526, 1529},
+	{0x1EC7, 1529, 1532},
+	{0x1EC8, 1532, 1534},
+	{0x1EC9, 1534, 1536},
+	{0x1ECA, 1536, 1538},
+	{0x1ECB, 1538, 1540},
+	{0x1ECC, 1540, 1542},
+	{0x1ECD, 1542, 1544},
+	{0x1ECE, 1544, 1546},
+	{0x1ECF, 1546, 1548},
+	{0x1ED0, 1548, 1551},
+	{0x1ED1, 1551, 1554},
+	{0x1ED2, 1554, 1557},
+	{0x1ED3, 1557, 1560},
+	{0x1ED4, 1560, 1563},
+	{0x1ED5, 1563, 1566},
+	{0x1ED6, 1566, 1569},
+	{0x1ED7, 1569, 1572},
+	{0x1ED8, 1572, 1575},
+	{0x1ED9, 1575, 1578},
+	{0x1EDA, 1578, 1581},
+	{0x1EDB, 1581, 1584},
+	{0x1EDC, 1584, 1587},
+	{0x1EDD, 1587, 1590},
+	{0x1EDE, 1590, 1593},
+	{0x1EDF, 1593, 1596},
+	{0x1EE0, 1596, 1599},
+	{0x1EE1, 1599, 1602},
+	{0x1EE2, 1602, 1605},
+	{0x1EE3, 1605, 1608},
+	{0x1EE4, 1608, 1610},
+	{0x1EE5, 1610, 1612},
+	{0x1EE6, 1612, 1614},
+	{0x1EE7, 1614, 1616},
+	{0x1EE8, 1616, 1619},
+	{0x1EE9, 1619, 1622},
+	{0x1EEA, 1622, 1625},
+	{0x1EEB, 1625, 1628},
+	{0x1EEC, 1628, 1631},
+	{0x1EED, 1631, 1634},
+	{0x1EEE, 1634, 1637},
+	{0x1EEF, 1637, 1640},
+	{0x1EF0, 1640, 1643},
+	{0x1EF1, 1643, 1646},
+	{0x1EF2, 1646, 1648},
+	{0x1EF3, 1648, 1650},
+	{0x1EF4, 1650, 1652},
+	{0x1EF5, 1652, 1654},
+	{0x1EF6, 1654, 1656},
+	{0x1EF7, 1656, 1658},
+	{0x1EF8, 1658, 1660},
+	{0x1EF9, 1660, 1662},
+	{0x1F00, 1662, 1664},
+	{0x1F01, 1664, 1666},
+	{0x1F02, 1666, 1669},
+	{0x1F03, 1669, 1672},
+	{0x1F04, 1672, 1675},
+	{0x1F05, 1675, 1678},
+	{0x1F06, 1678, 1681},
+	{0x1F07, 1681, 1684},
+	{0x1F08, 1684, 1686},
+	{0x1F09, 1686, 1688},
+	{0x1F0A, 1688, 1691},
+	{0x1F0B, 1691, 1694},
+	{0x1F0C, 1694, 1697},
+	{0x1F0D, 1697, 1700},
+	{0x1F0E, 1700, 1703},
+	{0x1F0F, 1703, 1706},
+	{0x1F10, 1706, 1708},
+	{0x1F11, 1708, 1710},
+	{0x1F12, 1710, 1713},
+	{0x1F13, 1713, 1716},
+	{0x1F14, 1716, 1719},
+	{0x1F15, 1719, 1722},
+	{0x1F18, 1722, 1724},
+	{0x1F19, 1724, 1726},
+	{0x1F1A, 1726, 1729},
+	{0x1F1B, 1729, 1732},
+	{0x1F1C, 1732, 1735},
+	{0x1F1D, 1735, 1738},
+	{0x1F20, 1738, 1740},
+	{0x1F21, 1740, 1742},
+	{0x1F22, 1742, 1745},
+	{0x1F23, 1745, 1748},
+	{0x1F24, 1748, 1751},
+	{0x1F25, 1751, 1754},
+	{0x1F26, 1754, 1757},
+	{0x1F27, 1757, 1760},
+	{0x1F28, 1760, 1762},
+	{0x1F29, 1762, 1764},
+	{0x1F2A, 1764, 1767},
+	{0x1F2B, 1767, 1770},
+	{0x1F2C, 1770, 1773},
+	{0x1F2D, 1773, 1776},
+	{0x1F2E, 1776, 1779},
+	{0x1F2F, 1779, 1782},
+	{0x1F30, 1782, 1784},
+	{0x1F31, 1784, 1786},
+	{0x1F32, 1786, 1789},
+	{0x1F33, 1789, 1792},
+	{0x1F34, 1792, 1795},
+	{0x1F35, 1795, 1798},
+	{0x1F36, 1798, 1801},
+	{0x1F37, 1801, 1804},
+	{0x1F38, 1804, 1806},
+	{0x1F39, 1806, 1808},
+	{0x1F3A, 1808, 1811},
+	{0x1F3B, 1811, 1814},
+	{0x1F3C, 1814, 1817},
+	{0x1F3D, 1817, 1820},
+	{0x1F3E, 1820, 1823},
+	{0x1F3F, 1823, 1826},
+	{0x1F40, 1826, 1828},
+	{0x1F41, 1828, 1830},
+	{0x1F42, 1830, 1833},
+	{0x1F43, 1833, 1836},
+	{0x1F44, 1836, 1839},
+	{0x1F45, 1839, 1842},
+	{0x1F48, 1842, 1844},
+	{0x1F49, 1844, 1846},
+	{0x1F4A, 1846, 1849},
+	{0x1F4B, 1849, 1852},
+	{0x1F4C, 1852, 1855},
+	{0x1F4D, 1855, 1858},
+	{0x1F50, 1858, 1860},
+	{0x1F51, 1860, 1862},
+	{0x1F52, 1862, 1865},
+	{0x1F53, 1865, 1868},
+	{0x1F54, 1868, 1871},
+	{0x1F55, 1871, 1874},
+	{0x1F56, 1874, 1877},
+	{0x1F57, 1877, 1880},
+	{0x1F59, 1880, 1882},
+	{0x1F5B, 1882, 1885},
+	{0x1F5D, 1885, 1888},
+	{0x1F5F, 1888, 1891},
+	{0x1F60, 1891, 1893},
+	{0x1F61, 1893, 1895},
+	{0x1F62, 1895, 1898},
+	{0x1F63, 1898, 1901},
+	{0x1F64, 1901, 1904},
+	{0x1F65, 1904, 1907},
+	{0x1F66, 1907, 1910},
+	{0x1F67, 1910, 1913},
+	{0x1F68, 1913, 1915},
+	{0x1F69, 1915, 1917},
+	{0x1F6A, 1917, 1920},
+	{0x1F6B, 1920, 1923},
+	{0x1F6C, 1923, 1926},
+	{0x1F6D, 1926, 1929},
+	{0x1F6E, 1929, 1932},
+	{0x1F6F, 1932, 1935},
+	{0x1F70, 1935, 1937},
+	{0x1F71, 1937, 1939},
+	{0x1F72, 1939, 1941},
+	{0x1F73, 1941, 1943},
+	{0x1F74, 1943, 1945},
+	{0x1F75, 1945, 1947},
+	{0x1F76, 1947, 1949},
+	{0x1F77, 1949, 1951},
+	{0x1F78, 1951, 1953},
+	{0x1F79, 1953, 1955},
+	{0x1F7A, 1955, 1957},
+	{0x1F7B, 1957, 1959},
+	{0x1F7C, 1959, 1961},
+	{0x1F7D, 1961, 1963},
+	{0x1F80, 1963, 1966},
+	{0x1F81, 1966, 1969},
+	{0x1F82, 1969, 1973},
+	{0x1F83, 1973, 1977},
+	{0x1F84, 1977, 1981},
+	{0x1F85, 1981, 1985},
+	{0x1F86, 1985, 1989},
+	{0x1F87, 1989, 1993},
+	{0x1F88, 1993, 1996},
+	{0x1F89, 1996, 1999},
+	{0x1F8A, 1999, 2003},
+	{0x1F8B, 2003, 2007},
+	{0x1F8C, 2007, 2011},
+	{0x1F8D, 2011, 2015},
+	{0x1F8E, 2015, 2019},
+	{0x1F8F, 2019, 2023},
+	{0x1F90, 2023, 2026},
+	{0x1F91, 2026, 2029},
+	{0x1F92, 2029, 2033},
+	{0x1F93, 2033, 2037},
+	{0x1F94, 2037, 2041},
+	{0x1F95, 2041, 2045},
+	{0x1F96, 2045, 2049},
+	{0x1F97, 2049, 2053},
+	{0x1F98, 2053, 2056},
+	{0x1F99, 2056, 2059},
+	{0x1F9A, 2059, 2063},
+	{0x1F9B, 2063, 2067},
+	{0x1F9C, 2067, 2071},
+	{0x1F9D, 2071, 2075},
+	{0x1F9E, 2075, 2079},
+	{0x1F9F, 2079, 2083},
+	{0x1FA0, 2083, 2086},
+	{0x1FA1, 2086, 2089},
+	{0x1FA2, 2089, 2093},
+	{0x1FA3, 2093, 2097},
+	{0x1FA4, 2097, 2101},
+	{0x1FA5, 2101, 2105},
+	{0x1FA6, 2105, 2109},
+	{0x1FA7, 2109, 2113},
+	{0x1FA8, 2113, 2116},
+	{0x1FA9, 2116, 2119},
+	{0x1FAA, 2119, 2123},
+	{0x1FAB, 2123, 2127},
+	{0x1FAC, 2127, 2131},
+	{0x1FAD, 2131, 2135},
+	{0x1FAE, 2135, 2139},
+	{0x1FAF, 2139, 2143},
+	{0x1FB0, 2143, 2145},
+	{0x1FB1, 2145, 2147},
+	{0x1FB2, 2147, 2150},
+	{0x1FB3, 2150, 2152},
+	{0x1FB4, 2152, 2155},
+	{0x1FB6, 2155, 2157},
+	{0x1FB7, 2157, 2160},
+	{0x1FB8, 2160, 2162},
+	{0x1FB9, 2162, 2164},
+	{0x1FBA, 2164, 2166},
+	{0x1FBB, 2166, 2168},
+	{0x1FBC, 2168, 2170},
+	{0x1FBD, 2170, 2172},
+	{0x1FBE, 2172, 2173},
+	{0x1FBF, 2173, 2175},
+	{0x1FC0, 2175, 2177},
+	{0x1FC1, 2177, 2180},
+	{0x1FC2, 2180, 2183},
+	{0x1FC3, 2183, 2185},
+	{0x1FC4, 2185, 2188},
+	{0x1FC6, 2188, 2190},
+	{0x1FC7, 2190, 2193},
+	{0x1FC8, 2193, 2195},
+	{0x1FC9, 2195, 2197},
+	{0x1FCA, 2197, 2199},
+	{0x1FCB, 2199, 2201},
+	{0x1FCC, 2201, 2203},
+	{0x1FCD, 2203, 2206},
+	{0x1FCE, 2206, 2209},
+	{0x1FCF, 2209, 2212},
+	{0x1FD0, 2212, 2214},
+	{0x1FD1, 2214, 2216},
+	{0x1FD2, 2216, 2219},
+	{0x1FD3, 2219, 2222},
+	{0x1FD6, 2222, 2224},
+	{0x1FD7, 2224, 2227},
+	{0x1FD8, 2227, 2229},
+	{0x1FD9, 2229, 2231},
+	{0x1FDA, 2231, 2233},
+	{0x1FDB, 2233, 2235},
+	{0x1FDD, 2235, 2238},
+	{0x1FDE, 2238, 2241},
+	{0x1FDF, 2241, 2244},
+	{0x1FE0, 2244, 2246},
+	{0x1FE1, 2246, 2248},
+	{0x1FE2, 2248, 2251},
+	{0x1FE3, 2251, 2254},
+	{0x1FE4, 2254, 2256},
+	{0x1FE5, 2256, 2258},
+	{0x1FE6, 2258, 2260},
+	{0x1FE7, 2260, 2263},
+	{0x1FE8, 2263, 2265},
+	{0x1FE9, 2265, 2267},
+	{0x1FEA, 2267, 2269},
+	{0x1FEB, 2269, 2271},
+	{0x1FEC, 2271, 2273},
+	{0x1FED, 2273, 2276},
+	{0x1FEE, 2276, 2279},
+	{0x1FEF, 2279, 2280},
+	{0x1FF2, 2280, 2283},
+	{0x1FF3, 2283, 2285},
+	{0x1FF4, 2285, 2288},
+	{0x1FF6, 2288, 2290},
+	{0x1FF7, 2290, 2293},
+	{0x1FF8, 2293, 2295},
+	{0x1FF9, 2295, 2297},
+	{0x1FFA, 2297, 2299},
+	{0x1FFB, 2299, 2301},
+	{0x1FFC, 2301, 2303},
+	{0x1FFD, 2303, 2305},
+	{0x1FFE, 2305, 2307},
+	{0x2000, 2307, 2308},
+	{0x2001, 2308, 2309},
+	{0x2002, 2309, 2310},
+	{0x2003, 2310, 2311},
+	{0x2004, 2311, 2312},
+	{0x2005, 2312, 2313},
+	{0x2006, 2313, 2314},
+	{0x2007, 2314, 2315},
+	{0x2008, 2315, 2316},
+	{0x2009, 2316, 2317},
+	{0x200A, 2317, 2318},
+	{0x2011, 2318, 2319},
+	{0x2017, 2319, 2321},
+	{0x2024, 2321, 2322},
+	{0x2025, 2322, 2324},
+	{0x2026, 2324, 2327},
+	{0x202F, 2327, 2328},
+	{0x2033, 2328, 2330},
+	{0x2034, 2330, 2333},
+	{0x2036, 2333, 2335},
+	{0x2037, 2335, 2338},
+	{0x203C, 2338, 2340},
+	{0x203E, 2340, 2342},
+	{0x2047, 2342, 2344},
+	{0x2048, 2344, 2346},
+	{0x2049, 2346, 2348},
+	{0x2057, 2348, 2352},
+	{0x205F, 2352, 2353},
+	{0x2070, 2353, 2354},
+	{0x2071, 2354, 2355},
+	{0x2074, 2355, 2356},
+	{0x2075, 2356, 2357},
+	{0x2076, 2357, 2358},
+	{0x2077, 2358, 2359},
+	{0x2078, 2359, 2360},
+	{0x2079, 2360, 2361},
+	{0x207A, 2361, 2362},
+	{0x207B, 2362, 2363},
+	{0x207C, 2363, 2364},
+	{0x207D, 2364, 2365},
+	{0x207E, 2365, 2366},
+	{0x207F, 2366, 2367},
+	{0x2080, 2367, 2368},
+	{0x2081, 2368, 2369},
+	{0x2082, 2369, 2370},
+	{0x2083, 2370, 2371},
+	{0x2084, 2371, 2372},
+	{0x2085, 2372, 2373},
+	{0x2086, 2373, 2374},
+	{0x2087, 2374, 2375},
+	{0x2088, 2375, 2376},
+	{0x2089, 2376, 2377},
+	{0x208A, 2377, 2378},
+	{0x208B, 2378, 2379},
+	{0x208C, 2379, 2380},
+	{0x208D, 2380, 2381},
+	{0x208E, 2381, 2382},
+	{0x2090, 2382, 2383},
+	{0x2091, 2383, 2384},
+	{0x2092, 2384, 2385},
+	{0x2093, 2385, 2386},
+	{0x2094, 2386, 2387},
+	{0x2095, 2387, 2388},
+	{0x2096, 2388, 2389},
+	{0x2097, 2389, 2390},
+	{0x2098, 2390, 2391},
+	{0x2099, 2391, 2392},
+	{0x209A, 2392, 2393},
+	{0x209B, 2393, 2394},
+	{0x209C, 2394, 2395},
+	{0x20A8, 2395, 2397},
+	{0x2100, 2397, 2400},
+	{0x2101, 2400, 2403},
+	{0x2102, 2403, 2404},
+	{0x2103, 2404, 2406},
+	{0x2105, 2406, 2409},
+	{0x2106, 2409, 2412},
+	{0x2107, 2412, 2413},
+	{0x2109, 2413, 2415},
+	{0x210A, 2415, 2416},
+	{0x210B, 2416, 2417},
+	{0x210C, 2417, 2418},
+	{0x210D, 2418, 2419},
+	{0x210E, 2419, 2420},
+	{0x210F, 2420, 2421},
+	{0x2110, 2421, 2422},
+	{0x2111, 2422, 2423},
+	{0x2112, 2423, 2424},
+	{0x2113, 2424, 2425},
+	{0x2115, 2425, 2426},
+	{0x2116, 2426, 2428},
+	{0x2119, 2428, 2429},
+	{0x211A, 2429, 2430},
+	{0x211B, 2430, 2431},
+	{0x211C, 2431, 2432},
+	{0x211D, 2432, 2433},
+	{0x2120, 2433, 2435},
+	{0x2121, 2435, 2438},
+	{0x2122, 2438, 2440},
+	{0x2124, 2440, 2441},
+	{0x2126, 2441, 2442},
+	{0x2128, 2442, 2443},
+	{0x212A, 2443, 2444},
+	{0x212B, 2444, 2446},
+	{0x212C, 2446, 2447},
+	{0x212D, 2447, 2448},
+	{0x212F, 2448, 2449},
+	{0x2130, 2449, 2450},
+	{0x2131, 2450, 2451},
+	{0x2133, 2451, 2452},
+	{0x2134, 2452, 2453},
+	{0x2135, 2453, 2454},
+	{0x2136, 2454, 2455},
+	{0x2137, 2455, 2456},
+	{0x2138, 2456, 2457},
+	{0x2139, 2457, 2458},
+	{0x213B, 2458, 2461},
+	{0x213C, 2461, 2462},
+	{0x213D, 2462, 2463},
+	{0x213E, 2463, 2464},
+	{0x213F, 2464, 2465},
+	{0x2140, 2465, 2466},
+	{0x2145, 2466, 2467},
+	{0x2146, 2467, 2468},
+	{0x2147, 2468, 2469},
+	{0x2148, 2469, 2470},
+	{0x2149, 2470, 2471},
+	{0x2150, 2471, 2474},
+	{0x2151, 2474, 2477},
+	{0x2152, 2477, 2481},
+	{0x2153, 2481, 2484},
+	{0x2154, 2484, 2487},
+	{0x2155, 2487, 2490},
+	{0x2156, 2490, 2493},
+	{0x2157, 2493, 2496},
+	{0x2158, 2496, 2499},
+	{0x2159, 2499, 2502},
+	{0x215A, 2502, 2505},
+	{0x215B, 2505, 2508},
+	{0x215C, 2508, 2511},
+	{0x215D, 2511, 2514},
+	{0x215E, 2514, 2517},
+	{0x215F, 2517, 2519},
+	{0x2160, 2519, 2520},
+	{0x2161, 2520, 2522},
+	{0x2162, 2522, 2525},
+	{0x2163, 2525, 2527},
+	{0x2164, 2527, 2528},
+	{0x2165, 2528, 2530},
+	{0x2166, 2530, 2533},
+	{0x2167, 2533, 2537},
+	{0x2168, 2537, 2539},
+	{0x2169, 2539, 2540},
+	{0x216A, 2540, 2542},
+	{0x216B, 2542, 2545},
+	{0x216C, 2545, 2546},
+	{0x216D, 2546, 2547},
+	{0x216E, 2547, 2548},
+	{0x216F, 2548, 2549},
+	{0x2170, 2549, 2550},
+	{0x2171, 2550, 2552},
+	{0x2172, 2552, 2555},
+	{0x2173, 2555, 2557},
+	{0x2174, 2557, 2558},
+	{0x2175, 2558, 2560},
+	{0x2176, 2560, 2563},
+	{0x2177, 2563, 2567},
+	{0x2178, 2567, 2569},
+	{0x2179, 2569, 2570},
+	{0x217A, 2570, 2572},
+	{0x217B, 2572, 2575},
+	{0x217C, 2575, 2576},
+	{0x217D, 2576, 2577},
+	{0x217E, 2577, 2578},
+	{0x217F, 2578, 2579},
+	{0x2189, 2579, 2582},
+	{0x219A, 2582, 2584},
+	{0x219B, 2584, 2586},
+	{0x21AE, 2586, 2588},
+	{0x21CD, 2588, 2590},
+	{0x21CE, 2590, 2592},
+	{0x21CF, 2592, 2594},
+	{0x2204, 2594, 2596},
+	{0x2209, 2596, 2598},
+	{0x220C, 2598, 2600},
+	{0x2224, 2600, 2602},
+	{0x2226, 2602, 2604},
+	{0x222C, 2604, 2606},
+	{0x222D, 2606, 2609},
+	{0x222F, 2609, 2611},
+	{0x2230, 2611, 2614},
+	{0x2241, 2614, 2616},
+	{0x2244, 2616, 2618},
+	{0x2247, 2618, 2620},
+	{0x2249, 2620, 2622},
+	{0x2260, 2622, 2624},
+	{0x2262, 2624, 2626},
+	{0x226D, 2626, 2628},
+	{0x226E, 2628, 2630},
+	{0x226F, 2630, 2632},
+	{0x2270, 2632, 2634},
+	{0x2271, 2634, 2636},
+	{0x2274, 2636, 2638},
+	{0x2275, 2638, 2640},
+	{0x2278, 2640, 2642},
+	{0x2279, 2642, 2644},
+	{0x2280, 2644, 2646},
+	{0x2281, 2646, 2648},
+	{0x2284, 2648, 2650},
+	{0x2285, 2650, 2652},
+	{0x2288, 2652, 2654},
+	{0x2289, 2654, 2656},
+	{0x22AC, 2656, 2658},
+	{0x22AD, 2658, 2660},
+	{0x22AE, 2660, 2662},
+	{0x22AF, 2662, 2664},
+	{0x22E0, 2664, 2666},
+	{0x22E1, 2666, 2668},
+	{0x22E2, 2668, 2670},
+	{0x22E3, 2670, 2672},
+	{0x22EA, 2672, 2674},
+	{0x22EB, 2674, 2676},
+	{0x22EC, 2676, 2678},
+	{0x22ED, 2678, 2680},
+	{0x2329, 2680, 2681},
+	{0x232A, 2681, 2682},
+	{0x2460, 2682, 2683},
+	{0x2461, 2683, 2684},
+	{0x2462, 2684, 2685},
+	{0x2463, 2685, 2686},
+	{0x2464, 2686, 2687},
+	{0x2465, 2687, 2688},
+	{0x2466, 2688, 2689},
+	{0x2467, 2689, 2690},
+	{0x2468, 2690, 2691},
+	{0x2469, 2691, 2693},
+	{0x246A, 2693, 2695},
+	{0x246B, 2695, 2697},
+	{0x246C, 2697, 2699},
+	{0x246D, 2699, 2701},
+	{0x246E, 2701, 2703},
+	{0x246F, 2703, 2705},
+	{0x2470, 2705, 2707},
+	{0x2471, 2707, 2709},
+	{0x2472, 2709, 2711},
+	{0x2473, 2711, 2713},
+	{0x2474, 2713, 2716},
+	{0x2475, 2716, 2719},
+	{0x2476, 2719, 2722},
+	{0x2477, 2722, 2725},
+	{0x2478, 2725, 2728},
+	{0x2479, 2728, 2731},
+	{0x247A, 2731, 2734},
+	{0x247B, 2734, 2737},
+	{0x247C, 2737, 2740},
+	{0x247D, 2740, 2744},
+	{0x247E, 2744, 2748},
+	{0x247F, 2748, 2752},
+	{0x2480, 2752, 2756},
+	{0x2481, 2756, 2760},
+	{0x2482, 2760, 2764},
+	{0x2483, 2764, 2768},
+	{0x2484, 2768, 2772},
+	{0x2485, 2772, 2776},
+	{0x2486, 2776, 2780},
+	{0x2487, 2780, 2784},
+	{0x2488, 2784, 2786},
+	{0x2489, 2786, 2788},
+	{0x248A, 2788, 2790},
+	{0x248B, 2790, 2792},
+	{0x248C, 2792, 2794},
+	{0x248D, 2794, 2796},
+	{0x248E, 2796, 2798},
+	{0x248F, 2798, 2800},
+	{0x2490, 2800, 2802},
+	{0x2491, 2802, 2805},
+	{0x2492, 2805, 2808},
+	{0x2493, 2808, 2811},
+	{0x2494, 2811, 2814},
+	{0x2495, 2814, 2817},
+	{0x2496, 2817, 2820},
+	{0x2497, 2820, 2823},
+	{0x2498, 2823, 2826},
+	{0x2499, 2826, 2829},
+	{0x249A, 2829, 2832},
+	{0x249B, 2832, 2835},
+	{0x249C, 2835, 2838},
+	{0x249D, 2838, 2841},
+	{0x249E, 2841, 2844},
+	{0x249F, 2844, 2847},
+	{0x24A0, 2847, 2850},
+	{0x24A1, 2850, 2853},
+	{0x24A2, 2853, 2856},
+	{0x24A3, 2856, 2859},
+	{0x24A4, 2859, 2862},
+	{0x24A5, 2862, 2865},
+	{0x24A6, 2865, 2868},
+	{0x24A7, 2868, 2871},
+	{0x24A8, 2871, 2874},
+	{0x24A9, 2874, 2877},
+	{0x24AA, 2877, 2880},
+	{0x24AB, 2880, 2883},
+	{0x24AC, 2883, 2886},
+	{0x24AD, 2886, 2889},
+	{0x24AE, 2889, 2892},
+	{0x24AF, 2892, 2895},
+	{0x24B0, 2895, 2898},
+	{0x24B1, 2898, 2901},
+	{0x24B2, 2901, 2904},
+	{0x24B3, 2904, 2907},
+	{0x24B4, 2907, 2910},
+	{0x24B5, 2910, 2913},
+	{0x24B6, 2913, 2914},
+	{0x24B7, 2914, 2915},
+	{0x24B8, 2915, 2916},
+	{0x24B9, 2916, 2917},
+	{0x24BA, 2917, 2918},
+	{0x24BB, 2918, 2919},
+	{0x24BC, 2919, 2920},
+	{0x24BD, 2920, 2921},
+	{0x24BE, 2921, 2922},
+	{0x24BF, 2922, 2923},
+	{0x24C0, 2923, 2924},
+	{0x24C1, 2924, 2925},
+	{0x24C2, 2925, 2926},
+	{0x24C3, 2926, 2927},
+	{0x24C4, 2927, 2928},
+	{0x24C5, 2928, 2929},
+	{0x24C6, 2929, 2930},
+	{0x24C7, 2930, 2931},
+	{0x24C8, 2931, 2932},
+	{0x24C9, 2932, 2933},
+	{0x24CA, 2933, 2934},
+	{0x24CB, 2934, 2935},
+	{0x24CC, 2935, 2936},
+	{0x24CD, 2936, 2937},
+	{0x24CE, 2937, 2938},
+	{0x24CF, 2938, 2939},
+	{0x24D0, 2939, 2940},
+	{0x24D1, 2940, 2941},
+	{0x24D2, 2941, 2942},
+	{0x24D3, 2942, 2943},
+	{0x24D4, 2943, 2944},
+	{0x24D5, 2944, 2945},
+	{0x24D6, 2945, 2946},
+	{0x24D7, 2946, 2947},
+	{0x24D8, 2947, 2948},
+	{0x24D9, 2948, 2949},
+	{0x24DA, 2949, 2950},
+	{0x24DB, 2950, 2951},
+	{0x24DC, 2951, 2952},
+	{0x24DD, 2952, 2953},
+	{0x24DE, 2953, 2954},
+	{0x24DF, 2954, 2955},
+	{0x24E0, 2955, 2956},
+	{0x24E1, 2956, 2957},
+	{0x24E2, 2957, 2958},
+	{0x24E3, 2958, 2959},
+	{0x24E4, 2959, 2960},
+	{0x24E5, 2960, 2961},
+	{0x24E6, 2961, 2962},
+	{0x24E7, 2962, 2963},
+	{0x24E8, 2963, 2964},
+	{0x24E9, 2964, 2965},
+	{0x24EA, 2965, 2966},
+	{0x2A0C, 2966, 2970},
+	{0x2A74, 2970, 2973},
+	{0x2A75, 2973, 2975},
+	{0x2A76, 2975, 2978},
+	{0x2ADC, 2978, 2980},
+	{0x2C7C, 2980, 2981},
+	{0x2C7D, 2981, 2982},
+	{0x2D6F, 2982, 2983},
+	{0x2E9F, 2983, 2984},
+	{0x2EF3, 2984, 2985},
+	{0x2F00, 2985, 2986},
+	{0x2F01, 2986, 2987},
+	{0x2F02, 2987, 2988},
+	{0x2F03, 2988, 2989},
+	{0x2F04, 2989, 2990},
+	{0x2F05, 2990, 2991},
+	{0x2F06, 2991, 2992},
+	{0x2F07, 2992, 2993},
+	{0x2F08, 2993, 2994},
+	{0x2F09, 2994, 2995},
+	{0x2F0A, 2995, 2996},
+	{0x2F0B, 2996, 2997},
+	{0x2F0C, 2997, 2998},
+	{0x2F0D, 2998, 2999},
+	{0x2F0E, 2999, 3000},
+	{0x2F0F, 3000, 3001},
+	{0x2F10, 3001, 3002},
+	{0x2F11, 3002, 3003},
+	{0x2F12, 3003, 3004},
+	{0x2F13, 3004, 3005},
+	{0x2F14, 3005, 3006},
+	{0x2F15, 3006, 3007},
+	{0x2F16, 3007, 3008},
+	{0x2F17, 3008, 3009},
+	{0x2F18, 3009, 3010},
+	{0x2F19, 3010, 3011},
+	{0x2F1A, 3011, 3012},
+	{0x2F1B, 3012, 3013},
+	{0x2F1C, 3013, 3014},
+	{0x2F1D, 3014, 3015},
+	{0x2F1E, 3015, 3016},
+	{0x2F1F, 3016, 3017},
+	{0x2F20, 3017, 3018},
+	{0x2F21, 3018, 3019},
+	{0x2F22, 3019, 3020},
+	{0x2F23, 3020, 3021},
+	{0x2F24, 3021, 3022},
+	{0x2F25, 3022, 3023},
+	{0x2F26, 3023, 3024},
+	{0x2F27, 3024, 3025},
+	{0x2F28, 3025, 3026},
+	{0x2F29, 3026, 3027},
+	{0x2F2A, 3027, 3028},
+	{0x2F2B, 3028, 3029},
+	{0x2F2C, 3029, 3030},
+	{0x2F2D, 3030, 3031},
+	{0x2F2E, 3031, 3032},
+	{0x2F2F, 3032, 3033},
+	{0x2F30, 3033, 3034},
+	{0x2F31, 3034, 3035},
+	{0x2F32, 3035, 3036},
+	{0x2F33, 3036, 3037},
+	{0x2F34, 3037, 3038},
+	{0x2F35, 3038, 3039},
+	{0x2F36, 3039, 3040},
+	{0x2F37, 3040, 3041},
+	{0x2F38, 3041, 3042},
+	{0x2F39, 3042, 3043},
+	{0x2F3A, 3043, 3044},
+	{0x2F3B, 3044, 3045},
+	{0x2F3C, 3045, 3046},
+	{0x2F3D, 3046, 3047},
+	{0x2F3E, 3047, 3048},
+	{0x2F3F, 3048, 3049},
+	{0x2F40, 3049, 3050},
+	{0x2F41, 3050, 3051},
+	{0x2F42, 3051, 3052},
+	{0x2F43, 3052, 3053},
+	{0x2F44, 3053, 3054},
+	{0x2F45, 3054, 3055},
+	{0x2F46, 3055, 3056},
+	{0x2F47, 3056, 3057},
+	{0x2F48, 3057, 3058},
+	{0x2F49, 3058, 3059},
+	{0x2F4A, 3059, 3060},
+	{0x2F4B, 3060, 3061},
+	{0x2F4C, 3061, 3062},
+	{0x2F4D, 3062, 3063},
+	{0x2F4E, 3063, 3064},
+	{0x2F4F, 3064, 3065},
+	{0x2F50, 3065, 3066},
+	{0x2F51, 3066, 3067},
+	{0x2F52, 3067, 3068},
+	{0x2F53, 3068, 3069},
+	{0x2F54, 3069, 3070},
+	{0x2F55, 3070, 3071},
+	{0x2F56, 3071, 3072},
+	{0x2F57, 3072, 3073},
+	{0x2F58, 3073, 3074},
+	{0x2F59, 3074, 3075},
+	{0x2F5A, 3075, 3076},
+	{0x2F5B, 3076, 3077},
+	{0x2F5C, 3077, 3078},
+	{0x2F5D, 3078, 3079},
+	{0x2F5E, 3079, 3080},
+	{0x2F5F, 3080, 3081},
+	{0x2F60, 3081, 3082},
+	{0x2F61, 3082, 3083},
+	{0x2F62, 3083, 3084},
+	{0x2F63, 3084, 3085},
+	{0x2F64, 3085, 3086},
+	{0x2F65, 3086, 3087},
+	{0x2F66, 3087, 3088},
+	{0x2F67, 3088, 3089},
+	{0x2F68, 3089, 3090},
+	{0x2F69, 3090, 3091},
+	{0x2F6A, 3091, 3092},
+	{0x2F6B, 3092, 3093},
+	{0x2F6C, 3093, 3094},
+	{0x2F6D, 3094, 3095},
+	{0x2F6E, 3095, 3096},
+	{0x2F6F, 3096, 3097},
+	{0x2F70, 3097, 3098},
+	{0x2F71, 3098, 3099},
+	{0x2F72, 3099, 3100},
+	{0x2F73, 3100, 3101},
+	{0x2F74, 3101, 3102},
+	{0x2F75, 3102, 3103},
+	{0x2F76, 3103, 3104},
+	{0x2F77, 3104, 3105},
+	{0x2F78, 3105, 3106},
+	{0x2F79, 3106, 3107},
+	{0x2F7A, 3107, 3108},
+	{0x2F7B, 3108, 3109},
+	{0x2F7C, 3109, 3110},
+	{0x2F7D, 3110, 3111},
+	{0x2F7E, 3111, 3112},
+	{0x2F7F, 3112, 3113},
+	{0x2F80, 3113, 3114},
+	{0x2F81, 3114, 3115},
+	{0x2F82, 3115, 3116},
+	{0x2F83, 3116, 3117},
+	{0x2F84, 3117, 3118},
+	{0x2F85, 3118, 3119},
+	{0x2F86, 3119, 3120},
+	{0x2F87, 3120, 3121},
+	{0x2F88, 3121, 3122},
+	{0x2F89, 3122, 3123},
+	{0x2F8A, 3123, 3124},
+	{0x2F8B, 3124, 3125},
+	{0x2F8C, 3125, 3126},
+	{0x2F8D, 3126, 3127},
+	{0x2F8E, 3127, 3128},
+	{0x2F8F, 3128, 3129},
+	{0x2F90, 3129, 3130},
+	{0x2F91, 3130, 3131},
+	{0x2F92, 3131, 3132},
+	{0x2F93, 3132, 3133},
+	{0x2F94, 3133, 3134},
+	{0x2F95, 3134, 3135},
+	{0x2F96, 3135, 3136},
+	{0x2F97, 3136, 3137},
+	{0x2F98, 3137, 3138},
+	{0x2F99, 3138, 3139},
+	{0x2F9A, 3139, 3140},
+	{0x2F9B, 3140, 3141},
+	{0x2F9C, 3141, 3142},
+	{0x2F9D, 3142, 3143},
+	{0x2F9E, 3143, 3144},
+	{0x2F9F, 3144, 3145},
+	{0x2FA0, 3145, 3146},
+	{0x2FA1, 3146, 3147},
+	{0x2FA2, 3147, 3148},
+	{0x2FA3, 3148, 3149},
+	{0x2FA4, 3149, 3150},
+	{0x2FA5, 3150, 3151},
+	{0x2FA6, 3151, 3152},
+	{0x2FA7, 3152, 3153},
+	{0x2FA8, 3153, 3154},
+	{0x2FA9, 3154, 3155},
+	{0x2FAA, 3155, 3156},
+	{0x2FAB, 3156, 3157},
+	{0x2FAC, 3157, 3158},
+	{0x2FAD, 3158, 3159},
+	{0x2FAE, 3159, 3160},
+	{0x2FAF, 3160, 3161},
+	{0x2FB0, 3161, 3162},
+	{0x2FB1, 3162, 3163},
+	{0x2FB2, 3163, 3164},
+	{0x2FB3, 3164, 3165},
+	{0x2FB4, 3165, 3166},
+	{0x2FB5, 3166, 3167},
+	{0x2FB6, 3167, 3168},
+	{0x2FB7, 3168, 3169},
+	{0x2FB8, 3169, 3170},
+	{0x2FB9, 3170, 3171},
+	{0x2FBA, 3171, 3172},
+	{0x2FBB, 3172, 3173},
+	{0x2FBC, 3173, 3174},
+	{0x2FBD, 3174, 3175},
+	{0x2FBE, 3175, 3176},
+	{0x2FBF, 3176, 3177},
+	{0x2FC0, 3177, 3178},
+	{0x2FC1, 3178, 3179},
+	{0x2FC2, 3179, 3180},
+	{0x2FC3, 3180, 3181},
+	{0x2FC4, 3181, 3182},
+	{0x2FC5, 3182, 3183},
+	{0x2FC6, 3183, 3184},
+	{0x2FC7, 3184, 3185},
+	{0x2FC8, 3185, 3186},
+	{0x2FC9, 3186, 3187},
+	{0x2FCA, 3187, 3188},
+	{0x2FCB, 3188, 3189},
+	{0x2FCC, 3189, 3190},
+	{0x2FCD, 3190, 3191},
+	{0x2FCE, 3191, 3192},
+	{0x2FCF, 3192, 3193},
+	{0x2FD0, 3193, 3194},
+	{0x2FD1, 3194, 3195},
+	{0x2FD2, 3195, 3196},
+	{0x2FD3, 3196, 3197},
+	{0x2FD4, 3197, 3198},
+	{0x2FD5, 3198, 3199},
+	{0x3000, 3199, 3200},
+	{0x3036, 3200, 3201},
+	{0x3038, 3201, 3202},
+	{0x3039, 3202, 3203},
+	{0x303A, 3203, 3204},
+	{0x304C, 3204, 3206},
+	{0x304E, 3206, 3208},
+	{0x3050, 3208, 3210},
+	{0x3052, 3210, 3212},
+	{0x3054, 3212, 3214},
+	{0x3056, 3214, 3216},
+	{0x3058, 3216, 3218},
+	{0x305A, 3218, 3220},
+	{0x305C, 3220, 3222},
+	{0x305E, 3222, 3224},
+	{0x3060, 3224, 3226},
+	{0x3062, 3226, 3228},
+	{0x3065, 3228, 3230},
+	{0x3067, 3230, 3232},
+	{0x3069, 3232, 3234},
+	{0x3070, 3234, 3236},
+	{0x3071, 3236, 3238},
+	{0x3073, 3238, 3240},
+	{0x3074, 3240, 3242},
+	{0x3076, 3242, 3244},
+	{0x3077, 3244, 3246},
+	{0x3079, 3246, 3248},
+	{0x307A, 3248, 3250},
+	{0x307C, 3250, 3252},
+	{0x307D, 3252, 3254},
+	{0x3094, 3254, 3256},
+	{0x309B, 3256, 3258},
+	{0x309C, 3258, 3260},
+	{0x309E, 3260, 3262},
+	{0x309F, 3262, 3264},
+	{0x30AC, 3264, 3266},
+	{0x30AE, 3266, 3268},
+	{0x30B0, 3268, 3270},
+	{0x30B2, 3270, 3272},
+	{0x30B4, 3272, 3274},
+	{0x30B6, 3274, 3276},
+	{0x30B8, 3276, 3278},
+	{0x30BA, 3278, 3280},
+	{0x30BC, 3280, 3282},
+	{0x30BE, 3282, 3284},
+	{0x30C0, 3284, 3286},
+	{0x30C2, 3286, 3288},
+	{0x30C5, 3288, 3290},
+	{0x30C7, 3290, 3292},
+	{0x30C9, 3292, 3294},
+	{0x30D0, 3294, 3296},
+	{0x30D1, 3296, 3298},
+	{0x30D3, 3298, 3300},
+	{0x30D4, 3300, 3302},
+	{0x30D6, 3302, 3304},
+	{0x30D7, 3304, 3306},
+	{0x30D9, 3306, 3308},
+	{0x30DA, 3308, 3310},
+	{0x30DC, 3310, 3312},
+	{0x30DD, 3312, 3314},
+	{0x30F4, 3314, 3316},
+	{0x30F7, 3316, 3318},
+	{0x30F8, 3318, 3320},
+	{0x30F9, 3320, 3322},
+	{0x30FA, 3322, 3324},
+	{0x30FE, 3324, 3326},
+	{0x30FF, 3326, 3328},
+	{0x3131, 3328, 3329},
+	{0x3132, 3329, 3330},
+	{0x3133, 3330, 3331},
+	{0x3134, 3331, 3332},
+	{0x3135, 3332, 3333},
+	{0x3136, 3333, 3334},
+	{0x3137, 3334, 3335},
+	{0x3138, 3335, 3336},
+	{0x3139, 3336, 3337},
+	{0x313A, 3337, 3338},
+	{0x313B, 3338, 3339},
+	{0x313C, 3339, 3340},
+	{0x313D, 3340, 3341},
+	{0x313E, 3341, 3342},
+	{0x313F, 3342, 3343},
+	{0x3140, 3343, 3344},
+	{0x3141, 3344, 3345},
+	{0x3142, 3345, 3346},
+	{0x3143, 3346, 3347},
+	{0x3144, 3347, 3348},
+	{0x3145, 3348, 3349},
+	{0x3146, 3349, 3350},
+	{0x3147, 3350, 3351},
+	{0x3148, 3351, 3352},
+	{0x3149, 3352, 3353},
+	{0x314A, 3353, 3354},
+	{0x314B, 3354, 3355},
+	{0x314C, 3355, 3356},
+	{0x314D, 3356, 3357},
+	{0x314E, 3357, 3358},
+	{0x314F, 3358, 3359},
+	{0x3150, 3359, 3360},
+	{0x3151, 3360, 3361},
+	{0x3152, 3361, 3362},
+	{0x3153, 3362, 3363},
+	{0x3154, 3363, 3364},
+	{0x3155, 3364, 3365},
+	{0x3156, 3365, 3366},
+	{0x3157, 3366, 3367},
+	{0x3158, 3367, 3368},
+	{0x3159, 3368, 3369},
+	{0x315A, 3369, 3370},
+	{0x315B, 3370, 3371},
+	{0x315C, 3371, 3372},
+	{0x315D, 3372, 3373},
+	{0x315E, 3373, 3374},
+	{0x315F, 3374, 3375},
+	{0x3160, 3375, 3376},
+	{0x3161, 3376, 3377},
+	{0x3162, 3377, 3378},
+	{0x3163, 3378, 3379},
+	{0x3164, 3379, 3380},
+	{0x3165, 3380, 3381},
+	{0x3166, 3381, 3382},
+	{0x3167, 3382, 3383},
+	{0x3168, 3383, 3384},
+	{0x3169, 3384, 3385},
+	{0x316A, 3385, 3386},
+	{0x316B, 3386, 3387},
+	{0x316C, 3387, 3388},
+	{0x316D, 3388, 3389},
+	{0x316E, 3389, 3390},
+	{0x316F, 3390, 3391},
+	{0x3170, 3391, 3392},
+	{0x3171, 3392, 3393},
+	{0x3172, 3393, 3394},
+	{0x3173, 3394, 3395},
+	{0x3174, 3395, 3396},
+	{0x3175, 3396, 3397},
+	{0x3176, 3397, 3398},
+	{0x3177, 3398, 3399},
+	{0x3178, 3399, 3400},
+	{0x3179, 3400, 3401},
+	{0x317A, 3401, 3402},
+	{0x317B, 3402, 3403},
+	{0x317C, 3403, 3404},
+	{0x317D, 3404, 3405},
+	{0x317E, 3405, 3406},
+	{0x317F, 3406, 3407},
+	{0x3180, 3407, 3408},
+	{0x3181, 3408, 3409},
+	{0x3182, 3409, 3410},
+	{0x3183, 3410, 3411},
+	{0x3184, 3411, 3412},
+	{0x3185, 3412, 3413},
+	{0x3186, 3413, 3414},
+	{0x3187, 3414, 3415},
+	{0x3188, 3415, 3416},
+	{0x3189, 3416, 3417},
+	{0x318A, 3417, 3418},
+	{0x318B, 3418, 3419},
+	{0x318C, 3419, 3420},
+	{0x318D, 3420, 3421},
+	{0x318E, 3421, 3422},
+	{0x3192, 3422, 3423},
+	{0x3193, 3423, 3424},
+	{0x3194, 3424, 3425},
+	{0x3195, 3425, 3426},
+	{0x3196, 3426, 3427},
+	{0x3197, 3427, 3428},
+	{0x3198, 3428, 3429},
+	{0x3199, 3429, 3430},
+	{0x319A, 3430, 3431},
+	{0x319B, 3431, 3432},
+	{0x319C, 3432, 3433},
+	{0x319D, 3433, 3434},
+	{0x319E, 3434, 3435},
+	{0x319F, 3435, 3436},
+	{0x3200, 3436, 3439},
+	{0x3201, 3439, 3442},
+	{0x3202, 3442, 3445},
+	{0x3203, 3445, 3448},
+	{0x3204, 3448, 3451},
+	{0x3205, 3451, 3454},
+	{0x3206, 3454, 3457},
+	{0x3207, 3457, 3460},
+	{0x3208, 3460, 3463},
+	{0x3209, 3463, 3466},
+	{0x320A, 3466, 3469},
+	{0x320B, 3469, 3472},
+	{0x320C, 3472, 3475},
+	{0x320D, 3475, 3478},
+	{0x320E, 3478, 3482},
+	{0x320F, 3482, 3486},
+	{0x3210, 3486, 3490},
+	{0x3211, 3490, 3494},
+	{0x3212, 3494, 3498},
+	{0x3213, 3498, 3502},
+	{0x3214, 3502, 3506},
+	{0x3215, 3506, 3510},
+	{0x3216, 3510, 3514},
+	{0x3217, 3514, 3518},
+	{0x3218, 3518, 3522},
+	{0x3219, 3522, 3526},
+	{0x321A, 3526, 3530},
+	{0x321B, 3530, 3534},
+	{0x321C, 3534, 3538},
+	{0x321D, 3538, 3545},
+	{0x321E, 3545, 3551},
+	{0x3220, 3551, 3554},
+	{0x3221, 3554, 3557},
+	{0x3222, 3557, 3560},
+	{0x3223, 3560, 3563},
+	{0x3224, 3563, 3566},
+	{0x3225, 3566, 3569},
+	{0x3226, 3569, 3572},
+	{0x3227, 3572, 3575},
+	{0x3228, 3575, 3578},
+	{0x3229, 3578, 3581},
+	{0x322A, 3581, 3584},
+	{0x322B, 3584, 3587},
+	{0x322C, 3587, 3590},
+	{0x322D, 3590, 3593},
+	{0x322E, 3593, 3596},
+	{0x322F, 3596, 3599},
+	{0x3230, 3599, 3602},
+	{0x3231, 3602, 3605},
+	{0x3232, 3605, 3608},
+	{0x3233, 3608, 3611},
+	{0x3234, 3611, 3614},
+	{0x3235, 3614, 3617},
+	{0x3236, 3617, 3620},
+	{0x3237, 3620, 3623},
+	{0x3238, 3623, 3626},
+	{0x3239, 3626, 3629},
+	{0x323A, 3629, 3632},
+	{0x323B, 3632, 3635},
+	{0x323C, 3635, 3638},
+	{0x323D, 3638, 3641},
+	{0x323E, 3641, 3644},
+	{0x323F, 3644, 3647},
+	{0x3240, 3647, 3650},
+	{0x3241, 3650, 3653},
+	{0x3242, 3653, 3656},
+	{0x3243, 3656, 3659},
+	{0x3244, 3659, 3660},
+	{0x3245, 3660, 3661},
+	{0x3246, 3661, 3662},
+	{0x3247, 3662, 3663},
+	{0x3250, 3663, 3666},
+	{0x3251, 3666, 3668},
+	{0x3252, 3668, 3670},
+	{0x3253, 3670, 3672},
+	{0x3254, 3672, 3674},
+	{0x3255, 3674, 3676},
+	{0x3256, 3676, 3678},
+	{0x3257, 3678, 3680},
+	{0x3258, 3680, 3682},
+	{0x3259, 3682, 3684},
+	{0x325A, 3684, 3686},
+	{0x325B, 3686, 3688},
+	{0x325C, 3688, 3690},
+	{0x325D, 3690, 3692},
+	{0x325E, 3692, 3694},
+	{0x325F, 3694, 3696},
+	{0x3260, 3696, 3697},
+	{0x3261, 3697, 3698},
+	{0x3262, 3698, 3699},
+	{0x3263, 3699, 3700},
+	{0x3264, 3700, 3701},
+	{0x3265, 3701, 3702},
+	{0x3266, 3702, 3703},
+	{0x3267, 3703, 3704},
+	{0x3268, 3704, 3705},
+	{0x3269, 3705, 3706},
+	{0x326A, 3706, 3707},
+	{0x326B, 3707, 3708},
+	{0x326C, 3708, 3709},
+	{0x326D, 3709, 3710},
+	{0x326E, 3710, 3712},
+	{0x326F, 3712, 3714},
+	{0x3270, 3714, 3716},
+	{0x3271, 3716, 3718},
+	{0x3272, 3718, 3720},
+	{0x3273, 3720, 3722},
+	{0x3274, 3722, 3724},
+	{0x3275, 3724, 3726},
+	{0x3276, 3726, 3728},
+	{0x3277, 3728, 3730},
+	{0x3278, 3730, 3732},
+	{0x3279, 3732, 3734},
+	{0x327A, 3734, 3736},
+	{0x327B, 3736, 3738},
+	{0x327C, 3738, 3743},
+	{0x327D, 3743, 3747},
+	{0x327E, 3747, 3749},
+	{0x3280, 3749, 3750},
+	{0x3281, 3750, 3751},
+	{0x3282, 3751, 3752},
+	{0x3283, 3752, 3753},
+	{0x3284, 3753, 3754},
+	{0x3285, 3754, 3755},
+	{0x3286, 3755, 3756},
+	{0x3287, 3756, 3757},
+	{0x3288, 3757, 3758},
+	{0x3289, 3758, 3759},
+	{0x328A, 3759, 3760},
+	{0x328B, 3760, 3761},
+	{0x328C, 3761, 3762},
+	{0x328D, 3762, 3763},
+	{0x328E, 3763, 3764},
+	{0x328F, 3764, 3765},
+	{0x3290, 3765, 3766},
+	{0x3291, 3766, 3767},
+	{0x3292, 3767, 3768},
+	{0x3293, 3768, 3769},
+	{0x3294, 3769, 3770},
+	{0x3295, 3770, 3771},
+	{0x3296, 3771, 3772},
+	{0x3297, 3772, 3773},
+	{0x3298, 3773, 3774},
+	{0x3299, 3774, 3775},
+	{0x329A, 3775, 3776},
+	{0x329B, 3776, 3777},
+	{0x329C, 3777, 3778},
+	{0x329D, 3778, 3779},
+	{0x329E, 3779, 3780},
+	{0x329F, 3780, 3781},
+	{0x32A0, 3781, 3782},
+	{0x32A1, 3782, 3783},
+	{0x32A2, 3783, 3784},
+	{0x32A3, 3784, 3785},
+	{0x32A4, 3785, 3786},
+	{0x32A5, 3786, 3787},
+	{0x32A6, 3787, 3788},
+	{0x32A7, 3788, 3789},
+	{0x32A8, 3789, 3790},
+	{0x32A9, 3790, 3791},
+	{0x32AA, 3791, 3792},
+	{0x32AB, 3792, 3793},
+	{0x32AC, 3793, 3794},
+	{0x32AD, 3794, 3795},
+	{0x32AE, 3795, 3796},
+	{0x32AF, 3796, 3797},
+	{0x32B0, 3797, 3798},
+	{0x32B1, 3798, 3800},
+	{0x32B2, 3800, 3802},
+	{0x32B3, 3802, 3804},
+	{0x32B4, 3804, 3806},
+	{0x32B5, 3806, 3808},
+	{0x32B6, 3808, 3810},
+	{0x32B7, 3810, 3812},
+	{0x32B8, 3812, 3814},
+	{0x32B9, 3814, 3816},
+	{0x32BA, 3816, 3818},
+	{0x32BB, 3818, 3820},
+	{0x32BC, 3820, 3822},
+	{0x32BD, 3822, 3824},
+	{0x32BE, 3824, 3826},
+	{0x32BF, 3826, 3828},
+	{0x32C0, 3828, 3830},
+	{0x32C1, 3830, 3832},
+	{0x32C2, 3832, 3834},
+	{0x32C3, 3834, 3836},
+	{0x32C4, 3836, 3838},
+	{0x32C5, 3838, 3840},
+	{0x32C6, 3840, 3842},
+	{0x32C7, 3842, 3844},
+	{0x32C8, 3844, 3846},
+	{0x32C9, 3846, 3849},
+	{0x32CA, 3849, 3852},
+	{0x32CB, 3852, 3855},
+	{0x32CC, 3855, 3857},
+	{0x32CD, 3857, 3860},
+	{0x32CE, 3860, 3862},
+	{0x32CF, 3862, 3865},
+	{0x32D0, 3865, 3866},
+	{0x32D1, 3866, 3867},
+	{0x32D2, 3867, 3868},
+	{0x32D3, 3868, 3869},
+	{0x32D4, 3869, 3870},
+	{0x32D5, 3870, 3871},
+	{0x32D6, 3871, 3872},
+	{0x32D7, 3872, 3873},
+	{0x32D8, 3873, 3874},
+	{0x32D9, 3874, 3875},
+	{0x32DA, 3875, 3876},
+	{0x32DB, 3876, 3877},
+	{0x32DC, 3877, 3878},
+	{0x32DD, 3878, 3879},
+	{0x32DE, 3879, 3880},
+	{0x32DF, 3880, 3881},
+	{0x32E0, 3881, 3882},
+	{0x32E1, 3882, 3883},
+	{0x32E2, 3883, 3884},
+	{0x32E3, 3884, 3885},
+	{0x32E4, 3885, 3886},
+	{0x32E5, 3886, 3887},
+	{0x32E6, 3887, 3888},
+	{0x32E7, 3888, 3889},
+	{0x32E8, 3889, 3890},
+	{0x32E9, 3890, 3891},
+	{0x32EA, 3891, 3892},
+	{0x32EB, 3892, 3893},
+	{0x32EC, 3893, 3894},
+	{0x32ED, 3894, 3895},
+	{0x32EE, 3895, 3896},
+	{0x32EF, 3896, 3897},
+	{0x32F0, 3897, 3898},
+	{0x32F1, 3898, 3899},
+	{0x32F2, 3899, 3900},
+	{0x32F3, 3900, 3901},
+	{0x32F4, 3901, 3902},
+	{0x32F5, 3902, 3903},
+	{0x32F6, 3903, 3904},
+	{0x32F7, 3904, 3905},
+	{0x32F8, 3905, 3906},
+	{0x32F9, 3906, 3907},
+	{0x32FA, 3907, 3908},
+	{0x32FB, 3908, 3909},
+	{0x32FC, 3909, 3910},
+	{0x32FD, 3910, 3911},
+	{0x32FE, 3911, 3912},
+	{0x32FF, 3912, 3914},
+	{0x3300, 3914, 3919},
+	{0x3301, 3919, 3923},
+	{0x3302, 3923, 3928},
+	{0x3303, 3928, 3931},
+	{0x3304, 3931, 3936},
+	{0x3305, 3936, 3939},
+	{0x3306, 3939, 3942},
+	{0x3307, 3942, 3948},
+	{0x3308, 3948, 3952},
+	{0x3309, 3952, 3955},
+	{0x330A, 3955, 3958},
+	{0x330B, 3958, 3961},
+	{0x330C, 3961, 3965},
+	{0x330D, 3965, 3969},
+	{0x330E, 3969, 3973},
+	{0x330F, 3973, 3977},
+	{0x3310, 3977, 3981},
+	{0x3311, 3981, 3985},
+	{0x3312, 3985, 3989},
+	{0x3313, 3989, 3995},
+	{0x3314, 3995, 3997},
+	{0x3315, 3997, 4003},
+	{0x3316, 4003, 4009},
+	{0x3317, 4009, 4014},
+	{0x3318, 4014, 4018},
+	{0x3319, 4018, 4024},
+	{0x331A, 4024, 4030},
+	{0x331B, 4030, 4034},
+	{0x331C, 4034, 4037},
+	{0x331D, 4037, 4040},
+	{0x331E, 4040, 4044},
+	{0x331F, 4044, 4048},
+	{0x3320, 4048, 4053},
+	{0x3321, 4053, 4058},
+	{0x3322, 4058, 4061},
+	{0x3323, 4061, 4064},
+	{0x3324, 4064, 4068},
+	{0x3325, 4068, 4071},
+	{0x3326, 4071, 4074},
+	{0x3327, 4074, 4076},
+	{0x3328, 4076, 4078},
+	{0x3329, 4078, 4081},
+	{0x332A, 4081, 4084},
+	{0x332B, 4084, 4090},
+	{0x332C, 4090, 4094},
+	{0x332D, 4094, 4099},
+	{0x332E, 4099, 4105},
+	{0x332F, 4105, 4109},
+	{0x3330, 4109, 4112},
+	{0x3331, 4112, 4115},
+	{0x3332, 4115, 4121},
+	{0x3333, 4121, 4125},
+	{0x3334, 4125, 4131},
+	{0x3335, 4131, 4134},
+	{0x3336, 4134, 4139},
+	{0x3337, 4139, 4142},
+	{0x3338, 4142, 4146},
+	{0x3339, 4146, 4149},
+	{0x333A, 4149, 4153},
+	{0x333B, 4153, 4158},
+	{0x333C, 4158, 4162},
+	{0x333D, 4162, 4167},
+	{0x333E, 4167, 4171},
+	{0x333F, 4171, 4173},
+	{0x3340, 4173, 4178},
+	{0x3341, 4178, 4181},
+	{0x3342, 4181, 4184},
+	{0x3343, 4184, 4188},
+	{0x3344, 4188, 4191},
+	{0x3345, 4191, 4194},
+	{0x3346, 4194, 4197},
+	{0x3347, 4197, 4202},
+	{0x3348, 4202, 4206},
+	{0x3349, 4206, 4208},
+	{0x334A, 4208, 4214},
+	{0x334B, 4214, 4217},
+	{0x334C, 4217, 4222},
+	{0x334D, 4222, 4226},
+	{0x334E, 4226, 4230},
+	{0x334F, 4230, 4233},
+	{0x3350, 4233, 4236},
+	{0x3351, 4236, 4240},
+	{0x3352, 4240, 4242},
+	{0x3353, 4242, 4246},
+	{0x3354, 4246, 4251},
+	{0x3355, 4251, 4253},
+	{0x3356, 4253, 4259},
+	{0x3357, 4259, 4262},
+	{0x3358, 4262, 4264},
+	{0x3359, 4264, 4266},
+	{0x335A, 4266, 4268},
+	{0x335B, 4268, 4270},
+	{0x335C, 4270, 4272},
+	{0x335D, 4272, 4274},
+	{0x335E, 4274, 4276},
+	{0x335F, 4276, 4278},
+	{0x3360, 4278, 4280},
+	{0x3361, 4280, 4282},
+	{0x3362, 4282, 4285},
+	{0x3363, 4285, 4288},
+	{0x3364, 4288, 4291},
+	{0x3365, 4291, 4294},
+	{0x3366, 4294, 4297},
+	{0x3367, 4297, 4300},
+	{0x3368, 4300, 4303},
+	{0x3369, 4303, 4306},
+	{0x336A, 4306, 4309},
+	{0x336B, 4309, 4312},
+	{0x336C, 4312, 4315},
+	{0x336D, 4315, 4318},
+	{0x336E, 4318, 4321},
+	{0x336F, 4321, 4324},
+	{0x3370, 4324, 4327},
+	{0x3371, 4327, 4330},
+	{0x3372, 4330, 4332},
+	{0x3373, 4332, 4334},
+	{0x3374, 4334, 4337},
+	{0x3375, 4337, 4339},
+	{0x3376, 4339, 4341},
+	{0x3377, 4341, 4343},
+	{0x3378, 4343, 4346},
+	{0x3379, 4346, 4349},
+	{0x337A, 4349, 4351},
+	{0x337B, 4351, 4353},
+	{0x337C, 4353, 4355},
+	{0x337D, 4355, 4357},
+	{0x337E, 4357, 4359},
+	{0x337F, 4359, 4363},
+	{0x3380, 4363, 4365},
+	{0x3381, 4365, 4367},
+	{0x3382, 4367, 4369},
+	{0x3383, 4369, 4371},
+	{0x3384, 4371, 4373},
+	{0x3385, 4373, 4375},
+	{0x3386, 4375, 4377},
+	{0x3387, 4377, 4379},
+	{0x3388, 4379, 4382},
+	{0x3389, 4382, 4386},
+	{0x338A, 4386, 4388},
+	{0x338B, 4388, 4390},
+	{0x338C, 4390, 4392},
+	{0x338D, 4392, 4394},
+	{0x338E, 4394, 4396},
+	{0x338F, 4396, 4398},
+	{0x3390, 4398, 4400},
+	{0x3391, 4400, 4403},
+	{0x3392, 4403, 4406},
+	{0x3393, 4406, 4409},
+	{0x3394, 4409, 4412},
+	{0x3395, 4412, 4414},
+	{0x3396, 4414, 4416},
+	{0x3397, 4416, 4418},
+	{0x3398, 4418, 4420},
+	{0x3399, 4420, 4422},
+	{0x339A, 4422, 4424},
+	{0x339B, 4424, 4426},
+	{0x339C, 4426, 4428},
+	{0x339D, 4428, 4430},
+	{0x339E, 4430, 4432},
+	{0x339F, 4432, 4435},
+	{0x33A0, 4435, 4438},
+	{0x33A1, 4438, 4440},
+	{0x33A2, 4440, 4443},
+	{0x33A3, 4443, 4446},
+	{0x33A4, 4446, 4449},
+	{0x33A5, 4449, 4451},
+	{0x33A6, 4451, 4454},
+	{0x33A7, 4454, 4457},
+	{0x33A8, 4457, 4461},
+	{0x33A9, 4461, 4463},
+	{0x33AA, 4463, 4466},
+	{0x33AB, 4466, 4469},
+	{0x33AC, 4469, 4472},
+	{0x33AD, 4472, 4475},
+	{0x33AE, 4475, 4480},
+	{0x33AF, 4480, 4486},
+	{0x33B0, 4486, 4488},
+	{0x33B1, 4488, 4490},
+	{0x33B2, 4490, 4492},
+	{0x33B3, 4492, 4494},
+	{0x33B4, 4494, 4496},
+	{0x33B5, 4496, 4498},
+	{0x33B6, 4498, 4500},
+	{0x33B7, 4500, 4502},
+	{0x33B8, 4502, 4504},
+	{0x33B9, 4504, 4506},
+	{0x33BA, 4506, 4508},
+	{0x33BB, 4508, 4510},
+	{0x33BC, 4510, 4512},
+	{0x33BD, 4512, 4514},
+	{0x33BE, 4514, 4516},
+	{0x33BF, 4516, 4518},
+	{0x33C0, 4518, 4520},
+	{0x33C1, 4520, 4522},
+	{0x33C2, 4522, 4526},
+	{0x33C3, 4526, 4528},
+	{0x33C4, 4528, 4530},
+	{0x33C5, 4530, 4532},
+	{0x33C6, 4532, 4536},
+	{0x33C7, 4536, 4539},
+	{0x33C8, 4539, 4541},
+	{0x33C9, 4541, 4543},
+	{0x33CA, 4543, 4545},
+	{0x33CB, 4545, 4547},
+	{0x33CC, 4547, 4549},
+	{0x33CD, 4549, 4551},
+	{0x33CE, 4551, 4553},
+	{0x33CF, 4553, 4555},
+	{0x33D0, 4555, 4557},
+	{0x33D1, 4557, 4559},
+	{0x33D2, 4559, 4562},
+	{0x33D3, 4562, 4564},
+	{0x33D4, 4564, 4566},
+	{0x33D5, 4566, 4569},
+	{0x33D6, 4569, 4572},
+	{0x33D7, 4572, 4574},
+	{0x33D8, 4574, 4578},
+	{0x33D9, 4578, 4581},
+	{0x33DA, 4581, 4583},
+	{0x33DB, 4583, 4585},
+	{0x33DC, 4585, 4587},
+	{0x33DD, 4587, 4589},
+	{0x33DE, 4589, 4592},
+	{0x33DF, 4592, 4595},
+	{0x33E0, 4595, 4597},
+	{0x33E1, 4597, 4599},
+	{0x33E2, 4599, 4601},
+	{0x33E3, 4601, 4603},
+	{0x33E4, 4603, 4605},
+	{0x33E5, 4605, 4607},
+	{0x33E6, 4607, 4609},
+	{0x33E7, 4609, 4611},
+	{0x33E8, 4611, 4613},
+	{0x33E9, 4613, 4616},
+	{0x33EA, 4616, 4619},
+	{0x33EB, 4619, 4622},
+	{0x33EC, 4622, 4625},
+	{0x33ED, 4625, 4628},
+	{0x33EE, 4628, 4631},
+	{0x33EF, 4631, 4634},
+	{0x33F0, 4634, 4637},
+	{0x33F1, 4637, 4640},
+	{0x33F2, 4640, 4643},
+	{0x33F3, 4643, 4646},
+	{0x33F4, 4646, 4649},
+	{0x33F5, 4649, 4652},
+	{0x33F6, 4652, 4655},
+	{0x33F7, 4655, 4658},
+	{0x33F8, 4658, 4661},
+	{0x33F9, 4661, 4664},
+	{0x33FA, 4664, 4667},
+	{0x33FB, 4667, 4670},
+	{0x33FC, 4670, 4673},
+	{0x33FD, 4673, 4676},
+	{0x33FE, 4676, 4679},
+	{0x33FF, 4679, 4682},
+	{0xA69C, 4682, 4683},
+	{0xA69D, 4683, 4684},
+	{0xA770, 4684, 4685},
+	{0xA7F1, 4685, 4686},
+	{0xA7F2, 4686, 4687},
+	{0xA7F3, 4687, 4688},
+	{0xA7F4, 4688, 4689},
+	{0xA7F8, 4689, 4690},
+	{0xA7F9, 4690, 4691},
+	{0xAB5C, 4691, 4692},
+	{0xAB5D, 4692, 4693},
+	{0xAB5E, 4693, 4694},
+	{0xAB5F, 4694, 4695},
+	{0xAB69, 4695, 4696},
+	{0xF900, 4696, 4697},
+	{0xF901, 4697, 4698},
+	{0xF902, 4698, 4699},
+	{0xF903, 4699, 4700},
+	{0xF904, 4700, 4701},
+	{0xF905, 4701, 4702},
+	{0xF906, 4702, 4703},
+	{0xF907, 4703, 4704},
+	{0xF908, 4704, 4705},
+	{0xF909, 4705, 4706},
+	{0xF90A, 4706, 4707},
+	{0xF90B, 4707, 4708},
+	{0xF90C, 4708, 4709},
+	{0xF90D, 4709, 4710},
+	{0xF90E, 4710, 4711},
+	{0xF90F, 4711, 4712},
+	{0xF910, 4712, 4713},
+	{0xF911, 4713, 4714},
+	{0xF912, 4714, 4715},
+	{0xF913, 4715, 4716},
+	{0xF914, 4716, 4717},
+	{0xF915, 4717, 4718},
+	{0xF916, 4718, 4719},
+	{0xF917, 4719, 4720},
+	{0xF918, 4720, 4721},
+	{0xF919, 4721, 4722},
+	{0xF91A, 4722, 4723},
+	{0xF91B, 4723, 4724},
+	{0xF91C, 4724, 4725},
+	{0xF91D, 4725, 4726},
+	{0xF91E, 4726, 4727},
+	{0xF91F, 4727, 4728},
+	{0xF920, 4728, 4729},
+	{0xF921, 4729, 4730},
+	{0xF922, 4730, 4731},
+	{0xF923, 4731, 4732},
+	{0xF924, 4732, 4733},
+	{0xF925, 4733, 4734},
+	{0xF926, 4734, 4735},
+	{0xF927, 4735, 4736},
+	{0xF928, 4736, 4737},
+	{0xF929, 4737, 4738},
+	{0xF92A, 4738, 4739},
+	{0xF92B, 4739, 4740},
+	{0xF92C, 4740, 4741},
+	{0xF92D, 4741, 4742},
+	{0xF92E, 4742, 4743},
+	{0xF92F, 4743, 4744},
+	{0xF930, 4744, 4745},
+	{0xF931, 4745, 4746},
+	{0xF932, 4746, 4747},
+	{0xF933, 4747, 4748},
+	{0xF934, 4748, 4749},
+	{0xF935, 4749, 4750},
+	{0xF936, 4750, 4751},
+	{0xF937, 4751, 4752},
+	{0xF938, 4752, 4753},
+	{0xF939, 4753, 4754},
+	{0xF93A, 4754, 4755},
+	{0xF93B, 4755, 4756},
+	{0xF93C, 4756, 4757},
+	{0xF93D, 4757, 4758},
+	{0xF93E, 4758, 4759},
+	{0xF93F, 4759, 4760},
+	{0xF940, 4760, 4761},
+	{0xF941, 4761, 4762},
+	{0xF942, 4762, 4763},
+	{0xF943, 4763, 4764},
+	{0xF944, 4764, 4765},
+	{0xF945, 4765, 4766},
+	{0xF946, 4766, 4767},
+	{0xF947, 4767, 4768},
+	{0xF948, 4768, 4769},
+	{0xF949, 4769, 4770},
+	{0xF94A, 4770, 4771},
+	{0xF94B, 4771, 4772},
+	{0xF94C, 4772, 4773},
+	{0xF94D, 4773, 4774},
+	{0xF94E, 4774, 4775},
+	{0xF94F, 4775, 4776},
+	{0xF950, 4776, 4777},
+	{0xF951, 4777, 4778},
+	{0xF952, 4778, 4779},
+	{0xF953, 4779, 4780},
+	{0xF954, 4780, 4781},
+	{0xF955, 4781, 4782},
+	{0xF956, 4782, 4783},
+	{0xF957, 4783, 4784},
+	{0xF958, 4784, 4785},
+	{0xF959, 4785, 4786},
+	{0xF95A, 4786, 4787},
+	{0xF95B, 4787, 4788},
+	{0xF95C, 4788, 4789},
+	{0xF95D, 4789, 4790},
+	{0xF95E, 4790, 4791},
+	{0xF95F, 4791, 4792},
+	{0xF960, 4792, 4793},
+	{0xF961, 4793, 4794},
+	{0xF962, 4794, 4795},
+	{0xF963, 4795, 4796},
+	{0xF964, 4796, 4797},
+	{0xF965, 4797, 4798},
+	{0xF966, 4798, 4799},
+	{0xF967, 4799, 4800},
+	{0xF968, 4800, 4801},
+	{0xF969, 4801, 4802},
+	{0xF96A, 4802, 4803},
+	{0xF96B, 4803, 4804},
+	{0xF96C, 4804, 4805},
+	{0xF96D, 4805, 4806},
+	{0xF96E, 4806, 4807},
+	{0xF96F, 4807, 4808},
+	{0xF970, 4808, 4809},
+	{0xF971, 4809, 4810},
+	{0xF972, 4810, 4811},
+	{0xF973, 4811, 4812},
+	{0xF974, 4812, 4813},
+	{0xF975, 4813, 4814},
+	{0xF976, 4814, 4815},
+	{0xF977, 4815, 4816},
+	{0xF978, 4816, 4817},
+	{0xF979, 4817, 4818},
+	{0xF97A, 4818, 4819},
+	{0xF97B, 4819, 4820},
+	{0xF97C, 4820, 4821},
+	{0xF97D, 4821, 4822},
+	{0xF97E, 4822, 4823},
+	{0xF97F, 4823, 4824},
+	{0xF980, 4824, 4825},
+	{0xF981, 4825, 4826},
+	{0xF982, 4826, 4827},
+	{0xF983, 4827, 4828},
+	{0xF984, 4828, 4829},
+	{0xF985, 4829, 4830},
+	{0xF986, 4830, 4831},
+	{0xF987, 4831, 4832},
+	{0xF988, 4832, 4833},
+	{0xF989, 4833, 4834},
+	{0xF98A, 4834, 4835},
+	{0xF98B, 4835, 4836},
+	{0xF98C, 4836, 4837},
+	{0xF98D, 4837, 4838},
+	{0xF98E, 4838, 4839},
+	{0xF98F, 4839, 4840},
+	{0xF990, 4840, 4841},
+	{0xF991, 4841, 4842},
+	{0xF992, 4842, 4843},
+	{0xF993, 4843, 4844},
+	{0xF994, 4844, 4845},
+	{0xF995, 4845, 4846},
+	{0xF996, 4846, 4847},
+	{0xF997, 4847, 4848},
+	{0xF998, 4848, 4849},
+	{0xF999, 4849, 4850},
+	{0xF99A, 4850, 4851},
+	{0xF99B, 4851, 4852},
+	{0xF99C, 4852, 4853},
+	{0xF99D, 4853, 4854},
+	{0xF99E, 4854, 4855},
+	{0xF99F, 4855, 4856},
+	{0xF9A0, 4856, 4857},
+	{0xF9A1, 4857, 4858},
+	{0xF9A2, 4858, 4859},
+	{0xF9A3, 4859, 4860},
+	{0xF9A4, 4860, 4861},
+	{0xF9A5, 4861, 4862},
+	{0xF9A6, 4862, 4863},
+	{0xF9A7, 4863, 4864},
+	{0xF9A8, 4864, 4865},
+	{0xF9A9, 4865, 4866},
+	{0xF9AA, 4866, 4867},
+	{0xF9AB, 4867, 4868},
+	{0xF9AC, 4868, 4869},
+	{0xF9AD, 4869, 4870},
+	{0xF9AE, 4870, 4871},
+	{0xF9AF, 4871, 4872},
+	{0xF9B0, 4872, 4873},
+	{0xF9B1, 4873, 4874},
+	{0xF9B2, 4874, 4875},
+	{0xF9B3, 4875, 4876},
+	{0xF9B4, 4876, 4877},
+	{0xF9B5, 4877, 4878},
+	{0xF9B6, 4878, 4879},
+	{0xF9B7, 4879, 4880},
+	{0xF9B8, 4880, 4881},
+	{0xF9B9, 4881, 4882},
+	{0xF9BA, 4882, 4883},
+	{0xF9BB, 4883, 4884},
+	{0xF9BC, 4884, 4885},
+	{0xF9BD, 4885, 4886},
+	{0xF9BE, 4886, 4887},
+	{0xF9BF, 4887, 4888},
+	{0xF9C0, 4888, 4889},
+	{0xF9C1, 4889, 4890},
+	{0xF9C2, 4890, 4891},
+	{0xF9C3, 4891, 4892},
+	{0xF9C4, 4892, 4893},
+	{0xF9C5, 4893, 4894},
+	{0xF9C6, 4894, 4895},
+	{0xF9C7, 4895, 4896},
+	{0xF9C8, 4896, 4897},
+	{0xF9C9, 4897, 4898},
+	{0xF9CA, 4898, 4899},
+	{0xF9CB, 4899, 4900},
+	{0xF9CC, 4900, 4901},
+	{0xF9CD, 4901, 4902},
+	{0xF9CE, 4902, 4903},
+	{0xF9CF, 4903, 4904},
+	{0xF9D0, 4904, 4905},
+	{0xF9D1, 4905, 4906},
+	{0xF9D2, 4906, 4907},
+	{0xF9D3, 4907, 4908},
+	{0xF9D4, 4908, 4909},
+	{0xF9D5, 4909, 4910},
+	{0xF9D6, 4910, 4911},
+	{0xF9D7, 4911, 4912},
+	{0xF9D8, 4912, 4913},
+	{0xF9D9, 4913, 4914},
+	{0xF9DA, 4914, 4915},
+	{0xF9DB, 4915, 4916},
+	{0xF9DC, 4916, 4917},
+	{0xF9DD, 4917, 4918},
+	{0xF9DE, 4918, 4919},
+	{0xF9DF, 4919, 4920},
+	{0xF9E0, 4920, 4921},
+	{0xF9E1, 4921, 4922},
+	{0xF9E2, 4922, 4923},
+	{0xF9E3, 4923, 4924},
+	{0xF9E4, 4924, 4925},
+	{0xF9E5, 4925, 4926},
+	{0xF9E6, 4926, 4927},
+	{0xF9E7, 4927, 4928},
+	{0xF9E8, 4928, 4929},
+	{0xF9E9, 4929, 4930},
+	{0xF9EA, 4930, 4931},
+	{0xF9EB, 4931, 4932},
+	{0xF9EC, 4932, 4933},
+	{0xF9ED, 4933, 4934},
+	{0xF9EE, 4934, 4935},
+	{0xF9EF, 4935, 4936},
+	{0xF9F0, 4936, 4937},
+	{0xF9F1, 4937, 4938},
+	{0xF9F2, 4938, 4939},
+	{0xF9F3, 4939, 4940},
+	{0xF9F4, 4940, 4941},
+	{0xF9F5, 4941, 4942},
+	{0xF9F6, 4942, 4943},
+	{0xF9F7, 4943, 4944},
+	{0xF9F8, 4944, 4945},
+	{0xF9F9, 4945, 4946},
+	{0xF9FA, 4946, 4947},
+	{0xF9FB, 4947, 4948},
+	{0xF9FC, 4948, 4949},
+	{0xF9FD, 4949, 4950},
+	{0xF9FE, 4950, 4951},
+	{0xF9FF, 4951, 4952},
+	{0xFA00, 4952, 4953},
+	{0xFA01, 4953, 4954},
+	{0xFA02, 4954, 4955},
+	{0xFA03, 4955, 4956},
+	{0xFA04, 4956, 4957},
+	{0xFA05, 4957, 4958},
+	{0xFA06, 4958, 4959},
+	{0xFA07, 4959, 4960},
+	{0xFA08, 4960, 4961},
+	{0xFA09, 4961, 4962},
+	{0xFA0A, 4962, 4963},
+	{0xFA0B, 4963, 4964},
+	{0xFA0C, 4964, 4965},
+	{0xFA0D, 4965, 4966},
+	{0xFA10, 4966, 4967},
+	{0xFA12, 4967, 4968},
+	{0xFA15, 4968, 4969},
+	{0xFA16, 4969, 4970},
+	{0xFA17, 4970, 4971},
+	{0xFA18, 4971, 4972},
+	{0xFA19, 4972, 4973},
+	{0xFA1A, 4973, 4974},
+	{0xFA1B, 4974, 4975},
+	{0xFA1C, 4975, 4976},
+	{0xFA1D, 4976, 4977},
+	{0xFA1E, 4977, 4978},
+	{0xFA20, 4978, 4979},
+	{0xFA22, 4979, 4980},
+	{0xFA25, 4980, 4981},
+	{0xFA26, 4981, 4982},
+	{0xFA2A, 4982, 4983},
+	{0xFA2B, 4983, 4984},
+	{0xFA2C, 4984, 4985},
+	{0xFA2D, 4985, 4986},
+	{0xFA2E, 4986, 4987},
+	{0xFA2F, 4987, 4988},
+	{0xFA30, 4988, 4989},
+	{0xFA31, 4989, 4990},
+	{0xFA32, 4990, 4991},
+	{0xFA33, 4991, 4992},
+	{0xFA34, 4992, 4993},
+	{0xFA35, 4993, 4994},
+	{0xFA36, 4994, 4995},
+	{0xFA37, 4995, 4996},
+	{0xFA38, 4996, 4997},
+	{0xFA39, 4997, 4998},
+	{0xFA3A, 4998, 4999},
+	{0xFA3B, 4999, 5000},
+	{0xFA3C, 5000, 5001},
+	{0xFA3D, 5001, 5002},
+	{0xFA3E, 5002, 5003},
+	{0xFA3F, 5003, 5004},
+	{0xFA40, 5004, 5005},
+	{0xFA41, 5005, 5006},
+	{0xFA42, 5006, 5007},
+	{0xFA43, 5007, 5008},
+	{0xFA44, 5008, 5009},
+	{0xFA45, 5009, 5010},
+	{0xFA46, 5010, 5011},
+	{0xFA47, 5011, 5012},
+	{0xFA48, 5012, 5013},
+	{0xFA49, 5013, 5014},
+	{0xFA4A, 5014, 5015},
+	{0xFA4B, 5015, 5016},
+	{0xFA4C, 5016, 5017},
+	{0xFA4D, 5017, 5018},
+	{0xFA4E, 5018, 5019},
+	{0xFA4F, 5019, 5020},
+	{0xFA50, 5020, 5021},
+	{0xFA51, 5021, 5022},
+	{0xFA52, 5022, 5023},
+	{0xFA53, 5023, 5024},
+	{0xFA54, 5024, 5025},
+	{0xFA55, 5025, 5026},
+	{0xFA56, 5026, 5027},
+	{0xFA57, 5027, 5028},
+	{0xFA58, 5028, 5029},
+	{0xFA59, 5029, 5030},
+	{0xFA5A, 5030, 5031},
+	{0xFA5B, 5031, 5032},
+	{0xFA5C, 5032, 5033},
+	{0xFA5D, 5033, 5034},
+	{0xFA5E, 5034, 5035},
+	{0xFA5F, 5035, 5036},
+	{0xFA60, 5036, 5037},
+	{0xFA61, 5037, 5038},
+	{0xFA62, 5038, 5039},
+	{0xFA63, 5039, 5040},
+	{0xFA64, 5040, 5041},
+	{0xFA65, 5041, 5042},
+	{0xFA66, 5042, 5043},
+	{0xFA67, 5043, 5044},
+	{0xFA68, 5044, 5045},
+	{0xFA69, 5045, 5046},
+	{0xFA6A, 5046, 5047},
+	{0xFA6B, 5047, 5048},
+	{0xFA6C, 5048, 5049},
+	{0xFA6D, 5049, 5050},
+	{0xFA70, 5050, 5051},
+	{0xFA71, 5051, 5052},
+	{0xFA72, 5052, 5053},
+	{0xFA73, 5053, 5054},
+	{0xFA74, 5054, 5055},
+	{0xFA75, 5055, 5056},
+	{0xFA76, 5056, 5057},
+	{0xFA77, 5057, 5058},
+	{0xFA78, 5058, 5059},
+	{0xFA79, 5059, 5060},
+	{0xFA7A, 5060, 5061},
+	{0xFA7B, 5061, 5062},
+	{0xFA7C, 5062, 5063},
+	{0xFA7D, 5063, 5064},
+	{0xFA7E, 5064, 5065},
+	{0xFA7F, 5065, 5066},
+	{0xFA80, 5066, 5067},
+	{0xFA81, 5067, 5068},
+	{0xFA82, 5068, 5069},
+	{0xFA83, 5069, 5070},
+	{0xFA84, 5070, 5071},
+	{0xFA85, 5071, 5072},
+	{0xFA86, 5072, 5073},
+	{0xFA87, 5073, 5074},
+	{0xFA88, 5074, 5075},
+	{0xFA89, 5075, 5076},
+	{0xFA8A, 5076, 5077},
+	{0xFA8B, 5077, 5078},
+	{0xFA8C, 5078, 5079},
+	{0xFA8D, 5079, 5080},
+	{0xFA8E, 5080, 5081},
+	{0xFA8F, 5081, 5082},
+	{0xFA90, 5082, 5083},
+	{0xFA91, 5083, 5084},
+	{0xFA92, 5084, 5085},
+	{0xFA93, 5085, 5086},
+	{0xFA94, 5086, 5087},
+	{0xFA95, 5087, 5088},
+	{0xFA96, 5088, 5089},
+	{0xFA97, 5089, 5090},
+	{0xFA98, 5090, 5091},
+	{0xFA99, 5091, 5092},
+	{0xFA9A, 5092, 5093},
+	{0xFA9B, 5093, 5094},
+	{0xFA9C, 5094, 5095},
+	{0xFA9D, 5095, 5096},
+	{0xFA9E, 5096, 5097},
+	{0xFA9F, 5097, 5098},
+	{0xFAA0, 5098, 5099},
+	{0xFAA1, 5099, 5100},
+	{0xFAA2, 5100, 5101},
+	{0xFAA3, 5101, 5102},
+	{0xFAA4, 5102, 5103},
+	{0xFAA5, 5103, 5104},
+	{0xFAA6, 5104, 5105},
+	{0xFAA7, 5105, 5106},
+	{0xFAA8, 5106, 5107},
+	{0xFAA9, 5107, 5108},
+	{0xFAAA, 5108, 5109},
+	{0xFAAB, 5109, 5110},
+	{0xFAAC, 5110, 5111},
+	{0xFAAD, 5111, 5112},
+	{0xFAAE, 5112, 5113},
+	{0xFAAF, 5113, 5114},
+	{0xFAB0, 5114, 5115},
+	{0xFAB1, 5115, 5116},
+	{0xFAB2, 5116, 5117},
+	{0xFAB3, 5117, 5118},
+	{0xFAB4, 5118, 5119},
+	{0xFAB5, 5119, 5120},
+	{0xFAB6, 5120, 5121},
+	{0xFAB7, 5121, 5122},
+	{0xFAB8, 5122, 5123},
+	{0xFAB9, 5123, 5124},
+	{0xFABA, 5124, 5125},
+	{0xFABB, 5125, 5126},
+	{0xFABC, 5126, 5127},
+	{0xFABD, 5127, 5128},
+	{0xFABE, 5128, 5129},
+	{0xFABF, 5129, 5130},
+	{0xFAC0, 5130, 5131},
+	{0xFAC1, 5131, 5132},
+	{0xFAC2, 5132, 5133},
+	{0xFAC3, 5133, 5134},
+	{0xFAC4, 5134, 5135},
+	{0xFAC5, 5135, 5136},
+	{0xFAC6, 5136, 5137},
+	{0xFAC7, 5137, 5138},
+	{0xFAC8, 5138, 5139},
+	{0xFAC9, 5139, 5140},
+	{0xFACA, 5140, 5141},
+	{0xFACB, 5141, 5142},
+	{0xFACC, 5142, 5143},
+	{0xFACD, 5143, 5144},
+	{0xFACE, 5144, 5145},
+	{0xFACF, 5145, 5146},
+	{0xFAD0, 5146, 5147},
+	{0xFAD1, 5147, 5148},
+	{0xFAD2, 5148, 5149},
+	{0xFAD3, 5149, 5150},
+	{0xFAD4, 5150, 5151},
+	{0xFAD5, 5151, 5152},
+	{0xFAD6, 5152, 5153},
+	{0xFAD7, 5153, 5154},
+	{0xFAD8, 5154, 5155},
+	{0xFAD9, 5155, 5156},
+	{0xFB00, 5156, 5158},
+	{0xFB01, 5158, 5160},
+	{0xFB02, 5160, 5162},
+	{0xFB03, 5162, 5165},
+	{0xFB04, 5165, 5168},
+	{0xFB05, 5168, 5170},
+	{0xFB06, 5170, 5172},
+	{0xFB13, 5172, 5174},
+	{0xFB14, 5174, 5176},
+	{0xFB15, 5176, 5178},
+	{0xFB16, 5178, 5180},
+	{0xFB17, 5180, 5182},
+	{0xFB1D, 5182, 5184},
+	{0xFB1F, 5184, 5186},
+	{0xFB20, 5186, 5187},
+	{0xFB21, 5187, 5188},
+	{0xFB22, 5188, 5189},
+	{0xFB23, 5189, 5190},
+	{0xFB24, 5190, 5191},
+	{0xFB25, 5191, 5192},
+	{0xFB26, 5192, 5193},
+	{0xFB27, 5193, 5194},
+	{0xFB28, 5194, 5195},
+	{0xFB29, 5195, 5196},
+	{0xFB2A, 5196, 5198},
+	{0xFB2B, 5198, 5200},
+	{0xFB2C, 5200, 5203},
+	{0xFB2D, 5203, 5206},
+	{0xFB2E, 5206, 5208},
+	{0xFB2F, 5208, 5210},
+	{0xFB30, 5210, 5212},
+	{0xFB31, 5212, 5214},
+	{0xFB32, 5214, 5216},
+	{0xFB33, 5216, 5218},
+	{0xFB34, 5218, 5220},
+	{0xFB35, 5220, 5222},
+	{0xFB36, 5222, 5224},
+	{0xFB38, 5224, 5226},
+	{0xFB39, 5226, 5228},
+	{0xFB3A, 5228, 5230},
+	{0xFB3B, 5230, 5232},
+	{0xFB3C, 5232, 5234},
+	{0xFB3E, 5234, 5236},
+	{0xFB40, 5236, 5238},
+	{0xFB41, 5238, 5240},
+	{0xFB43, 5240, 5242},
+	{0xFB44, 5242, 5244},
+	{0xFB46, 5244, 5246},
+	{0xFB47, 5246, 5248},
+	{0xFB48, 5248, 5250},
+	{0xFB49, 5250, 5252},
+	{0xFB4A, 5252, 5254},
+	{0xFB4B, 5254, 5256},
+	{0xFB4C, 5256, 5258},
+	{0xFB4D, 5258, 5260},
+	{0xFB4E, 5260, 5262},
+	{0xFB4F, 5262, 5264},
+	{0xFB50, 5264, 5265},
+	{0xFB51, 5265, 5266},
+	{0xFB52, 5266, 5267},
+	{0xFB53, 5267, 5268},
+	{0xFB54, 5268, 5269},
+	{0xFB55, 5269, 5270},
+	{0xFB56, 5270, 5271},
+	{0xFB57, 5271, 5272},
+	{0xFB58, 5272, 5273},
+	{0xFB59, 5273, 5274},
+	{0xFB5A, 5274, 5275},
+	{0xFB5B, 5275, 5276},
+	{0xFB5C, 5276, 5277},
+	{0xFB5D, 5277, 5278},
+	{0xFB5E, 5278, 5279},
+	{0xFB5F, 5279, 5280},
+	{0xFB60, 5280, 5281},
+	{0xFB61, 5281, 5282},
+	{0xFB62, 5282, 5283},
+	{0xFB63, 5283, 5284},
+	{0xFB64, 5284, 5285},
+	{0xFB65, 5285, 5286},
+	{0xFB66, 5286, 5287},
+	{0xFB67, 5287, 5288},
+	{0xFB68, 5288, 5289},
+	{0xFB69, 5289, 5290},
+	{0xFB6A, 5290, 5291},
+	{0xFB6B, 5291, 5292},
+	{0xFB6C, 5292, 5293},
+	{0xFB6D, 5293, 5294},
+	{0xFB6E, 5294, 5295},
+	{0xFB6F, 5295, 5296},
+	{0xFB70, 5296, 5297},
+	{0xFB71, 5297, 5298},
+	{0xFB72, 5298, 5299},
+	{0xFB73, 5299, 5300},
+	{0xFB74, 5300, 5301},
+	{0xFB75, 5301, 5302},
+	{0xFB76, 5302, 5303},
+	{0xFB77, 5303, 5304},
+	{0xFB78, 5304, 5305},
+	{0xFB79, 5305, 5306},
+	{0xFB7A, 5306, 5307},
+	{0xFB7B, 5307, 5308},
+	{0xFB7C, 5308, 5309},
+	{0xFB7D, 5309, 5310},
+	{0xFB7E, 5310, 5311},
+	{0xFB7F, 5311, 5312},
+	{0xFB80, 5312, 5313},
+	{0xFB81, 5313, 5314},
+	{0xFB82, 5314, 5315},
+	{0xFB83, 5315, 5316},
+	{0xFB84, 5316, 5317},
+	{0xFB85, 5317, 5318},
+	{0xFB86, 5318, 5319},
+	{0xFB87, 5319, 5320},
+	{0xFB88, 5320, 5321},
+	{0xFB89, 5321, 5322},
+	{0xFB8A, 5322, 5323},
+	{0xFB8B, 5323, 5324},
+	{0xFB8C, 5324, 5325},
+	{0xFB8D, 5325, 5326},
+	{0xFB8E, 5326, 5327},
+	{0xFB8F, 5327, 5328},
+	{0xFB90, 5328, 5329},
+	{0xFB91, 5329, 5330},
+	{0xFB92, 5330, 5331},
+	{0xFB93, 5331, 5332},
+	{0xFB94, 5332, 5333},
+	{0xFB95, 5333, 5334},
+	{0xFB96, 5334, 5335},
+	{0xFB97, 5335, 5336},
+	{0xFB98, 5336, 5337},
+	{0xFB99, 5337, 5338},
+	{0xFB9A, 5338, 5339},
+	{0xFB9B, 5339, 5340},
+	{0xFB9C, 5340, 5341},
+	{0xFB9D, 5341, 5342},
+	{0xFB9E, 5342, 5343},
+	{0xFB9F, 5343, 5344},
+	{0xFBA0, 5344, 5345},
+	{0xFBA1, 5345, 5346},
+	{0xFBA2, 5346, 5347},
+	{0xFBA3, 5347, 5348},
+	{0xFBA4, 5348, 5350},
+	{0xFBA5, 5350, 5352},
+	{0xFBA6, 5352, 5353},
+	{0xFBA7, 5353, 5354},
+	{0xFBA8, 5354, 5355},
+	{0xFBA9, 5355, 5356},
+	{0xFBAA, 5356, 5357},
+	{0xFBAB, 5357, 5358},
+	{0xFBAC, 5358, 5359},
+	{0xFBAD, 5359, 5360},
+	{0xFBAE, 5360, 5361},
+	{0xFBAF, 5361, 5362},
+	{0xFBB0, 5362, 5364},
+	{0xFBB1, 5364, 5366},
+	{0xFBD3, 5366, 5367},
+	{0xFBD4, 5367, 5368},
+	{0xFBD5, 5368, 5369},
+	{0xFBD6, 5369, 5370},
+	{0xFBD7, 5370, 5371},
+	{0xFBD8, 5371, 5372},
+	{0xFBD9, 5372, 5373},
+	{0xFBDA, 5373, 5374},
+	{0xFBDB, 5374, 5375},
+	{0xFBDC, 5375, 5376},
+	{0xFBDD, 5376, 5378},
+	{0xFBDE, 5378, 5379},
+	{0xFBDF, 5379, 5380},
+	{0xFBE0, 5380, 5381},
+	{0xFBE1, 5381, 5382},
+	{0xFBE2, 5382, 5383},
+	{0xFBE3, 5383, 5384},
+	{0xFBE4, 5384, 5385},
+	{0xFBE5, 5385, 5386},
+	{0xFBE6, 5386, 5387},
+	{0xFBE7, 5387, 5388},
+	{0xFBE8, 5388, 5389},
+	{0xFBE9, 5389, 5390},
+	{0xFBEA, 5390, 5393},
+	{0xFBEB, 5393, 5396},
+	{0xFBEC, 5396, 5399},
+	{0xFBED, 5399, 5402},
+	{0xFBEE, 5402, 5405},
+	{0xFBEF, 5405, 5408},
+	{0xFBF0, 5408, 5411},
+	{0xFBF1, 5411, 5414},
+	{0xFBF2, 5414, 5417},
+	{0xFBF3, 5417, 5420},
+	{0xFBF4, 5420, 5423},
+	{0xFBF5, 5423, 5426},
+	{0xFBF6, 5426, 5429},
+	{0xFBF7, 5429, 5432},
+	{0xFBF8, 5432, 5435},
+	{0xFBF9, 5435, 5438},
+	{0xFBFA, 5438, 5441},
+	{0xFBFB, 5441, 5444},
+	{0xFBFC, 5444, 5445},
+	{0xFBFD, 5445, 5446},
+	{0xFBFE, 5446, 5447},
+	{0xFBFF, 5447, 5448},
+	{0xFC00, 5448, 5451},
+	{0xFC01, 5451, 5454},
+	{0xFC02, 5454, 5457},
+	{0xFC03, 5457, 5460},
+	{0xFC04, 5460, 5463},
+	{0xFC05, 5463, 5465},
+	{0xFC06, 5465, 5467},
+	{0xFC07, 5467, 5469},
+	{0xFC08, 5469, 5471},
+	{0xFC09, 5471, 5473},
+	{0xFC0A, 5473, 5475},
+	{0xFC0B, 5475, 5477},
+	{0xFC0C, 5477, 5479},
+	{0xFC0D, 5479, 5481},
+	{0xFC0E, 5481, 5483},
+	{0xFC0F, 5483, 5485},
+	{0xFC10, 5485, 5487},
+	{0xFC11, 5487, 5489},
+	{0xFC12, 5489, 5491},
+	{0xFC13, 5491, 5493},
+	{0xFC14, 5493, 5495},
+	{0xFC15, 5495, 5497},
+	{0xFC16, 5497, 5499},
+	{0xFC17, 5499, 5501},
+	{0xFC18, 5501, 5503},
+	{0xFC19, 5503, 5505},
+	{0xFC1A, 5505, 5507},
+	{0xFC1B, 5507, 5509},
+	{0xFC1C, 5509, 5511},
+	{0xFC1D, 5511, 5513},
+	{0xFC1E, 5513, 5515},
+	{0xFC1F, 5515, 5517},
+	{0xFC20, 5517, 5519},
+	{0xFC21, 5519, 5521},
+	{0xFC22, 5521, 5523},
+	{0xFC23, 5523, 5525},
+	{0xFC24, 5525, 5527},
+	{0xFC25, 5527, 5529},
+	{0xFC26, 5529, 5531},
+	{0xFC27, 5531, 5533},
+	{0xFC28, 5533, 5535},
+	{0xFC29, 5535, 5537},
+	{0xFC2A, 5537, 5539},
+	{0xFC2B, 5539, 5541},
+	{0xFC2C, 5541, 5543},
+	{0xFC2D, 5543, 5545},
+	{0xFC2E, 5545, 5547},
+	{0xFC2F, 5547, 5549},
+	{0xFC30, 5549, 5551},
+	{0xFC31, 5551, 5553},
+	{0xFC32, 5553, 5555},
+	{0xFC33, 5555, 5557},
+	{0xFC34, 5557, 5559},
+	{0xFC35, 5559, 5561},
+	{0xFC36, 5561, 5563},
+	{0xFC37, 5563, 5565},
+	{0xFC38, 5565, 5567},
+	{0xFC39, 5567, 5569},
+	{0xFC3A, 5569, 5571},
+	{0xFC3B, 5571, 5573},
+	{0xFC3C, 5573, 5575},
+	{0xFC3D, 5575, 5577},
+	{0xFC3E, 5577, 5579},
+	{0xFC3F, 5579, 5581},
+	{0xFC40, 5581, 5583},
+	{0xFC41, 5583, 5585},
+	{0xFC42, 5585, 5587},
+	{0xFC43, 5587, 5589},
+	{0xFC44, 5589, 5591},
+	{0xFC45, 5591, 5593},
+	{0xFC46, 5593, 5595},
+	{0xFC47, 5595, 5597},
+	{0xFC48, 5597, 5599},
+	{0xFC49, 5599, 5601},
+	{0xFC4A, 5601, 5603},
+	{0xFC4B, 5603, 5605},
+	{0xFC4C, 5605, 5607},
+	{0xFC4D, 5607, 5609},
+	{0xFC4E, 5609, 5611},
+	{0xFC4F, 5611, 5613},
+	{0xFC50, 5613, 5615},
+	{0xFC51, 5615, 5617},
+	{0xFC52, 5617, 5619},
+	{0xFC53, 5619, 5621},
+	{0xFC54, 5621, 5623},
+	{0xFC55, 5623, 5625},
+	{0xFC56, 5625, 5627},
+	{0xFC57, 5627, 5629},
+	{0xFC58, 5629, 5631},
+	{0xFC59, 5631, 5633},
+	{0xFC5A, 5633, 5635},
+	{0xFC5B, 5635, 5637},
+	{0xFC5C, 5637, 5639},
+	{0xFC5D, 5639, 5641},
+	{0xFC5E, 5641, 5644},
+	{0xFC5F, 5644, 5647},
+	{0xFC60, 5647, 5650},
+	{0xFC61, 5650, 5653},
+	{0xFC62, 5653, 5656},
+	{0xFC63, 5656, 5659},
+	{0xFC64, 5659, 5662},
+	{0xFC65, 5662, 5665},
+	{0xFC66, 5665, 5668},
+	{0xFC67, 5668, 5671},
+	{0xFC68, 5671, 5674},
+	{0xFC69, 5674, 5677},
+	{0xFC6A, 5677, 5679},
+	{0xFC6B, 5679, 5681},
+	{0xFC6C, 5681, 5683},
+	{0xFC6D, 5683, 5685},
+	{0xFC6E, 5685, 5687},
+	{0xFC6F, 5687, 5689},
+	{0xFC70, 5689, 5691},
+	{0xFC71, 5691, 5693},
+	{0xFC72, 5693, 5695},
+	{0xFC73, 5695, 5697},
+	{0xFC74, 5697, 5699},
+	{0xFC75, 5699, 5701},
+	{0xFC76, 5701, 5703},
+	{0xFC77, 5703, 5705},
+	{0xFC78, 5705, 5707},
+	{0xFC79, 5707, 5709},
+	{0xFC7A, 5709, 5711},
+	{0xFC7B, 5711, 5713},
+	{0xFC7C, 5713, 5715},
+	{0xFC7D, 5715, 5717},
+	{0xFC7E, 5717, 5719},
+	{0xFC7F, 5719, 5721},
+	{0xFC80, 5721, 5723},
+	{0xFC81, 5723, 5725},
+	{0xFC82, 5725, 5727},
+	{0xFC83, 5727, 5729},
+	{0xFC84, 5729, 5731},
+	{0xFC85, 5731, 5733},
+	{0xFC86, 5733, 5735},
+	{0xFC87, 5735, 5737},
+	{0xFC88, 5737, 5739},
+	{0xFC89, 5739, 5741},
+	{0xFC8A, 5741, 5743},
+	{0xFC8B, 5743, 5745},
+	{0xFC8C, 5745, 5747},
+	{0xFC8D, 5747, 5749},
+	{0xFC8E, 5749, 5751},
+	{0xFC8F, 5751, 5753},
+	{0xFC90, 5753, 5755},
+	{0xFC91, 5755, 5757},
+	{0xFC92, 5757, 5759},
+	{0xFC93, 5759, 5761},
+	{0xFC94, 5761, 5763},
+	{0xFC95, 5763, 5765},
+	{0xFC96, 5765, 5767},
+	{0xFC97, 5767, 5770},
+	{0xFC98, 5770, 5773},
+	{0xFC99, 5773, 5776},
+	{0xFC9A, 5776, 5779},
+	{0xFC9B, 5779, 5782},
+	{0xFC9C, 5782, 5784},
+	{0xFC9D, 5784, 5786},
+	{0xFC9E, 5786, 5788},
+	{0xFC9F, 5788, 5790},
+	{0xFCA0, 5790, 5792},
+	{0xFCA1, 5792, 5794},
+	{0xFCA2, 5794, 5796},
+	{0xFCA3, 5796, 5798},
+	{0xFCA4, 5798, 5800},
+	{0xFCA5, 5800, 5802},
+	{0xFCA6, 5802, 5804},
+	{0xFCA7, 5804, 5806},
+	{0xFCA8, 5806, 5808},
+	{0xFCA9, 5808, 5810},
+	{0xFCAA, 5810, 5812},
+	{0xFCAB, 5812, 5814},
+	{0xFCAC, 5814, 5816},
+	{0xFCAD, 5816, 5818},
+	{0xFCAE, 5818, 5820},
+	{0xFCAF, 5820, 5822},
+	{0xFCB0, 5822, 5824},
+	{0xFCB1, 5824, 5826},
+	{0xFCB2, 5826, 5828},
+	{0xFCB3, 5828, 5830},
+	{0xFCB4, 5830, 5832},
+	{0xFCB5, 5832, 5834},
+	{0xFCB6, 5834, 5836},
+	{0xFCB7, 5836, 5838},
+	{0xFCB8, 5838, 5840},
+	{0xFCB9, 5840, 5842},
+	{0xFCBA, 5842, 5844},
+	{0xFCBB, 5844, 5846},
+	{0xFCBC, 5846, 5848},
+	{0xFCBD, 5848, 5850},
+	{0xFCBE, 5850, 5852},
+	{0xFCBF, 5852, 5854},
+	{0xFCC0, 5854, 5856},
+	{0xFCC1, 5856, 5858},
+	{0xFCC2, 5858, 5860},
+	{0xFCC3, 5860, 5862},
+	{0xFCC4, 5862, 5864},
+	{0xFCC5, 5864, 5866},
+	{0xFCC6, 5866, 5868},
+	{0xFCC7, 5868, 5870},
+	{0xFCC8, 5870, 5872},
+	{0xFCC9, 5872, 5874},
+	{0xFCCA, 5874, 5876},
+	{0xFCCB, 5876, 5878},
+	{0xFCCC, 5878, 5880},
+	{0xFCCD, 5880, 5882},
+	{0xFCCE, 5882, 5884},
+	{0xFCCF, 5884, 5886},
+	{0xFCD0, 5886, 5888},
+	{0xFCD1, 5888, 5890},
+	{0xFCD2, 5890, 5892},
+	{0xFCD3, 5892, 5894},
+	{0xFCD4, 5894, 5896},
+	{0xFCD5, 5896, 5898},
+	{0xFCD6, 5898, 5900},
+	{0xFCD7, 5900, 5902},
+	{0xFCD8, 5902, 5904},
+	{0xFCD9, 5904, 5906},
+	{0xFCDA, 5906, 5908},
+	{0xFCDB, 5908, 5910},
+	{0xFCDC, 5910, 5912},
+	{0xFCDD, 5912, 5914},
+	{0xFCDE, 5914, 5916},
+	{0xFCDF, 5916, 5919},
+	{0xFCE0, 5919, 5922},
+	{0xFCE1, 5922, 5924},
+	{0xFCE2, 5924, 5926},
+	{0xFCE3, 5926, 5928},
+	{0xFCE4, 5928, 5930},
+	{0xFCE5, 5930, 5932},
+	{0xFCE6, 5932, 5934},
+	{0xFCE7, 5934, 5936},
+	{0xFCE8, 5936, 5938},
+	{0xFCE9, 5938, 5940},
+	{0xFCEA, 5940, 5942},
+	{0xFCEB, 5942, 5944},
+	{0xFCEC, 5944, 5946},
+	{0xFCED, 5946, 5948},
+	{0xFCEE, 5948, 5950},
+	{0xFCEF, 5950, 5952},
+	{0xFCF0, 5952, 5954},
+	{0xFCF1, 5954, 5956},
+	{0xFCF2, 5956, 5959},
+	{0xFCF3, 5959, 5962},
+	{0xFCF4, 5962, 5965},
+	{0xFCF5, 5965, 5967},
+	{0xFCF6, 5967, 5969},
+	{0xFCF7, 5969, 5971},
+	{0xFCF8, 5971, 5973},
+	{0xFCF9, 5973, 5975},
+	{0xFCFA, 5975, 5977},
+	{0xFCFB, 5977, 5979},
+	{0xFCFC, 5979, 5981},
+	{0xFCFD, 5981, 5983},
+	{0xFCFE, 5983, 5985},
+	{0xFCFF, 5985, 5987},
+	{0xFD00, 5987, 5989},
+	{0xFD01, 5989, 5991},
+	{0xFD02, 5991, 5993},
+	{0xFD03, 5993, 5995},
+	{0xFD04, 5995, 5997},
+	{0xFD05, 5997, 5999},
+	{0xFD06, 5999, 6001},
+	{0xFD07, 6001, 6003},
+	{0xFD08, 6003, 6005},
+	{0xFD09, 6005, 6007},
+	{0xFD0A, 6007, 6009},
+	{0xFD0B, 6009, 6011},
+	{0xFD0C, 6011, 6013},
+	{0xFD0D, 6013, 6015},
+	{0xFD0E, 6015, 6017},
+	{0xFD0F, 6017, 6019},
+	{0xFD10, 6019, 6021},
+	{0xFD11, 6021, 6023},
+	{0xFD12, 6023, 6025},
+	{0xFD13, 6025, 6027},
+	{0xFD14, 6027, 6029},
+	{0xFD15, 6029, 6031},
+	{0xFD16, 6031, 6033},
+	{0xFD17, 6033, 6035},
+	{0xFD18, 6035, 6037},
+	{0xFD19, 6037, 6039},
+	{0xFD1A, 6039, 6041},
+	{0xFD1B, 6041, 6043},
+	{0xFD1C, 6043, 6045},
+	{0xFD1D, 6045, 6047},
+	{0xFD1E, 6047, 6049},
+	{0xFD1F, 6049, 6051},
+	{0xFD20, 6051, 6053},
+	{0xFD21, 6053, 6055},
+	{0xFD22, 6055, 6057},
+	{0xFD23, 6057, 6059},
+	{0xFD24, 6059, 6061},
+	{0xFD25, 6061, 6063},
+	{0xFD26, 6063, 6065},
+	{0xFD27, 6065, 6067},
+	{0xFD28, 6067, 6069},
+	{0xFD29, 6069, 6071},
+	{0xFD2A, 6071, 6073},
+	{0xFD2B, 6073, 6075},
+	{0xFD2C, 6075, 6077},
+	{0xFD2D, 6077, 6079},
+	{0xFD2E, 6079, 6081},
+	{0xFD2F, 6081, 6083},
+	{0xFD30, 6083, 6085},
+	{0xFD31, 6085, 6087},
+	{0xFD32, 6087, 6089},
+	{0xFD33, 6089, 6091},
+	{0xFD34, 6091, 6093},
+	{0xFD35, 6093, 6095},
+	{0xFD36, 6095, 6097},
+	{0xFD37, 6097, 6099},
+	{0xFD38, 6099, 6101},
+	{0xFD39, 6101, 6103},
+	{0xFD3A, 6103, 6105},
+	{0xFD3B, 6105, 6107},
+	{0xFD3C, 6107, 6109},
+	{0xFD3D, 6109, 6111},
+	{0xFD50, 6111, 6114},
+	{0xFD51, 6114, 6117},
+	{0xFD52, 6117, 6120},
+	{0xFD53, 6120, 6123},
+	{0xFD54, 6123, 6126},
+	{0xFD55, 6126, 6129},
+	{0xFD56, 6129, 6132},
+	{0xFD57, 6132, 6135},
+	{0xFD58, 6135, 6138},
+	{0xFD59, 6138, 6141},
+	{0xFD5A, 6141, 6144},
+	{0xFD5B, 6144, 6147},
+	{0xFD5C, 6147, 6150},
+	{0xFD5D, 6150, 6153},
+	{0xFD5E, 6153, 6156},
+	{0xFD5F, 6156, 6159},
+	{0xFD60, 6159, 6162},
+	{0xFD61, 6162, 6165},
+	{0xFD62, 6165, 6168},
+	{0xFD63, 6168, 6171},
+	{0xFD64, 6171, 6174},
+	{0xFD65, 6174, 6177},
+	{0xFD66, 6177, 6180},
+	{0xFD67, 6180, 6183},
+	{0xFD68, 6183, 6186},
+	{0xFD69, 6186, 6189},
+	{0xFD6A, 6189, 6192},
+	{0xFD6B, 6192, 6195},
+	{0xFD6C, 6195, 6198},
+	{0xFD6D, 6198, 6201},
+	{0xFD6E, 6201, 6204},
+	{0xFD6F, 6204, 6207},
+	{0xFD70, 6207, 6210},
+	{0xFD71, 6210, 6213},
+	{0xFD72, 6213, 6216},
+	{0xFD73, 6216, 6219},
+	{0xFD74, 6219, 6222},
+	{0xFD75, 6222, 6225},
+	{0xFD76, 6225, 6228},
+	{0xFD77, 6228, 6231},
+	{0xFD78, 6231, 6234},
+	{0xFD79, 6234, 6237},
+	{0xFD7A, 6237, 6240},
+	{0xFD7B, 6240, 6243},
+	{0xFD7C, 6243, 6246},
+	{0xFD7D, 6246, 6249},
+	{0xFD7E, 6249, 6252},
+	{0xFD7F, 6252, 6255},
+	{0xFD80, 6255, 6258},
+	{0xFD81, 6258, 6261},
+	{0xFD82, 6261, 6264},
+	{0xFD83, 6264, 6267},
+	{0xFD84, 6267, 6270},
+	{0xFD85, 6270, 6273},
+	{0xFD86, 6273, 6276},
+	{0xFD87, 6276, 6279},
+	{0xFD88, 6279, 6282},
+	{0xFD89, 6282, 6285},
+	{0xFD8A, 6285, 6288},
+	{0xFD8B, 6288, 6291},
+	{0xFD8C, 6291, 6294},
+	{0xFD8D, 6294, 6297},
+	{0xFD8E, 6297, 6300},
+	{0xFD8F, 6300, 6303},
+	{0xFD92, 6303, 6306},
+	{0xFD93, 6306, 6309},
+	{0xFD94, 6309, 6312},
+	{0xFD95, 6312, 6315},
+	{0xFD96, 6315, 6318},
+	{0xFD97, 6318, 6321},
+	{0xFD98, 6321, 6324},
+	{0xFD99, 6324, 6327},
+	{0xFD9A, 6327, 6330},
+	{0xFD9B, 6330, 6333},
+	{0xFD9C, 6333, 6336},
+	{0xFD9D, 6336, 6339},
+	{0xFD9E, 6339, 6342},
+	{0xFD9F, 6342, 6345},
+	{0xFDA0, 6345, 6348},
+	{0xFDA1, 6348, 6351},
+	{0xFDA2, 6351, 6354},
+	{0xFDA3, 6354, 6357},
+	{0xFDA4, 6357, 6360},
+	{0xFDA5, 6360, 6363},
+	{0xFDA6, 6363, 6366},
+	{0xFDA7, 6366, 6369},
+	{0xFDA8, 6369, 6372},
+	{0xFDA9, 6372, 6375},
+	{0xFDAA, 6375, 6378},
+	{0xFDAB, 6378, 6381},
+	{0xFDAC, 6381, 6384},
+	{0xFDAD, 6384, 6387},
+	{0xFDAE, 6387, 6390},
+	{0xFDAF, 6390, 6393},
+	{0xFDB0, 6393, 6396},
+	{0xFDB1, 6396, 6399},
+	{0xFDB2, 6399, 6402},
+	{0xFDB3, 6402, 6405},
+	{0xFDB4, 6405, 6408},
+	{0xFDB5, 6408, 6411},
+	{0xFDB6, 6411, 6414},
+	{0xFDB7, 6414, 6417},
+	{0xFDB8, 6417, 6420},
+	{0xFDB9, 6420, 6423},
+	{0xFDBA, 6423, 6426},
+	{0xFDBB, 6426, 6429},
+	{0xFDBC, 6429, 6432},
+	{0xFDBD, 6432, 6435},
+	{0xFDBE, 6435, 6438},
+	{0xFDBF, 6438, 6441},
+	{0xFDC0, 6441, 6444},
+	{0xFDC1, 6444, 6447},
+	{0xFDC2, 6447, 6450},
+	{0xFDC3, 6450, 6453},
+	{0xFDC4, 6453, 6456},
+	{0xFDC5, 6456, 6459},
+	{0xFDC6, 6459, 6462},
+	{0xFDC7, 6462, 6465},
+	{0xFDF0, 6465, 6468},
+	{0xFDF1, 6468, 6471},
+	{0xFDF2, 6471, 6475},
+	{0xFDF3, 6475, 6479},
+	{0xFDF4, 6479, 6483},
+	{0xFDF5, 6483, 6487},
+	{0xFDF6, 6487, 6491},
+	{0xFDF7, 6491, 6495},
+	{0xFDF8, 6495, 6499},
+	{0xFDF9, 6499, 6502},
+	{0xFDFA, 6502, 6520},
+	{0xFDFB, 6520, 6528},
+	{0xFDFC, 6528, 6532},
+	{0xFE10, 6532, 6533},
+	{0xFE11, 6533, 6534},
+	{0xFE12, 6534, 6535},
+	{0xFE13, 6535, 6536},
+	{0xFE14, 6536, 6537},
+	{0xFE15, 6537, 6538},
+	{0xFE16, 6538, 6539},
+	{0xFE17, 6539, 6540},
+	{0xFE18, 6540, 6541},
+	{0xFE19, 6541, 6544},
+	{0xFE30, 6544, 6546},
+	{0xFE31, 6546, 6547},
+	{0xFE32, 6547, 6548},
+	{0xFE33, 6548, 6549},
+	{0xFE34, 6549, 6550},
+	{0xFE35, 6550, 6551},
+	{0xFE36, 6551, 6552},
+	{0xFE37, 6552, 6553},
+	{0xFE38, 6553, 6554},
+	{0xFE39, 6554, 6555},
+	{0xFE3A, 6555, 6556},
+	{0xFE3B, 6556, 6557},
+	{0xFE3C, 6557, 6558},
+	{0xFE3D, 6558, 6559},
+	{0xFE3E, 6559, 6560},
+	{0xFE3F, 6560, 6561},
+	{0xFE40, 6561, 6562},
+	{0xFE41, 6562, 6563},
+	{0xFE42, 6563, 6564},
+	{0xFE43, 6564, 6565},
+	{0xFE44, 6565, 6566},
+	{0xFE47, 6566, 6567},
+	{0xFE48, 6567, 6568},
+	{0xFE49, 6568, 6570},
+	{0xFE4A, 6570, 6572},
+	{0xFE4B, 6572, 6574},
+	{0xFE4C, 6574, 6576},
+	{0xFE4D, 6576, 6577},
+	{0xFE4E, 6577, 6578},
+	{0xFE4F, 6578, 6579},
+	{0xFE50, 6579, 6580},
+	{0xFE51, 6580, 6581},
+	{0xFE52, 6581, 6582},
+	{0xFE54, 6582, 6583},
+	{0xFE55, 6583, 6584},
+	{0xFE56, 6584, 6585},
+	{0xFE57, 6585, 6586},
+	{0xFE58, 6586, 6587},
+	{0xFE59, 6587, 6588},
+	{0xFE5A, 6588, 6589},
+	{0xFE5B, 6589, 6590},
+	{0xFE5C, 6590, 6591},
+	{0xFE5D, 6591, 6592},
+	{0xFE5E, 6592, 6593},
+	{0xFE5F, 6593, 6594},
+	{0xFE60, 6594, 6595},
+	{0xFE61, 6595, 6596},
+	{0xFE62, 6596, 6597},
+	{0xFE63, 6597, 6598},
+	{0xFE64, 6598, 6599},
+	{0xFE65, 6599, 6600},
+	{0xFE66, 6600, 6601},
+	{0xFE68, 6601, 6602},
+	{0xFE69, 6602, 6603},
+	{0xFE6A, 6603, 6604},
+	{0xFE6B, 6604, 6605},
+	{0xFE70, 6605, 6607},
+	{0xFE71, 6607, 6609},
+	{0xFE72, 6609, 6611},
+	{0xFE74, 6611, 6613},
+	{0xFE76, 6613, 6615},
+	{0xFE77, 6615, 6617},
+	{0xFE78, 6617, 6619},
+	{0xFE79, 6619, 6621},
+	{0xFE7A, 6621, 6623},
+	{0xFE7B, 6623, 6625},
+	{0xFE7C, 6625, 6627},
+	{0xFE7D, 6627, 6629},
+	{0xFE7E, 6629, 6631},
+	{0xFE7F, 6631, 6633},
+	{0xFE80, 6633, 6634},
+	{0xFE81, 6634, 6636},
+	{0xFE82, 6636, 6638},
+	{0xFE83, 6638, 6640},
+	{0xFE84, 6640, 6642},
+	{0xFE85, 6642, 6644},
+	{0xFE86, 6644, 6646},
+	{0xFE87, 6646, 6648},
+	{0xFE88, 6648, 6650},
+	{0xFE89, 6650, 6652},
+	{0xFE8A, 6652, 6654},
+	{0xFE8B, 6654, 6656},
+	{0xFE8C, 6656, 6658},
+	{0xFE8D, 6658, 6659},
+	{0xFE8E, 6659, 6660},
+	{0xFE8F, 6660, 6661},
+	{0xFE90, 6661, 6662},
+	{0xFE91, 6662, 6663},
+	{0xFE92, 6663, 6664},
+	{0xFE93, 6664, 6665},
+	{0xFE94, 6665, 6666},
+	{0xFE95, 6666, 6667},
+	{0xFE96, 6667, 6668},
+	{0xFE97, 6668, 6669},
+	{0xFE98, 6669, 6670},
+	{0xFE99, 6670, 6671},
+	{0xFE9A, 6671, 6672},
+	{0xFE9B, 6672, 6673},
+	{0xFE9C, 6673, 6674},
+	{0xFE9D, 6674, 6675},
+	{0xFE9E, 6675, 6676},
+	{0xFE9F, 6676, 6677},
+	{0xFEA0, 6677, 6678},
+	{0xFEA1, 6678, 6679},
+	{0xFEA2, 6679, 6680},
+	{0xFEA3, 6680, 6681},
+	{0xFEA4, 6681, 6682},
+	{0xFEA5, 6682, 6683},
+	{0xFEA6, 6683, 6684},
+	{0xFEA7, 6684, 6685},
+	{0xFEA8, 6685, 6686},
+	{0xFEA9, 6686, 6687},
+	{0xFEAA, 6687, 6688},
+	{0xFEAB, 6688, 6689},
+	{0xFEAC, 6689, 6690},
+	{0xFEAD, 6690, 6691},
+	{0xFEAE, 6691, 6692},
+	{0xFEAF, 6692, 6693},
+	{0xFEB0, 6693, 6694},
+	{0xFEB1, 6694, 6695},
+	{0xFEB2, 6695, 6696},
+	{0xFEB3, 6696, 6697},
+	{0xFEB4, 6697, 6698},
+	{0xFEB5, 6698, 6699},
+	{0xFEB6, 6699, 6700},
+	{0xFEB7, 6700, 6701},
+	{0xFEB8, 6701, 6702},
+	{0xFEB9, 6702, 6703},
+	{0xFEBA, 6703, 6704},
+	{0xFEBB, 6704, 6705},
+	{0xFEBC, 6705, 6706},
+	{0xFEBD, 6706, 6707},
+	{0xFEBE, 6707, 6708},
+	{0xFEBF, 6708, 6709},
+	{0xFEC0, 6709, 6710},
+	{0xFEC1, 6710, 6711},
+	{0xFEC2, 6711, 6712},
+	{0xFEC3, 6712, 6713},
+	{0xFEC4, 6713, 6714},
+	{0xFEC5, 6714, 6715},
+	{0xFEC6, 6715, 6716},
+	{0xFEC7, 6716, 6717},
+	{0xFEC8, 6717, 6718},
+	{0xFEC9, 6718, 6719},
+	{0xFECA, 6719, 6720},
+	{0xFECB, 6720, 6721},
+	{0xFECC, 6721, 6722},
+	{0xFECD, 6722, 6723},
+	{0xFECE, 6723, 6724},
+	{0xFECF, 6724, 6725},
+	{0xFED0, 6725, 6726},
+	{0xFED1, 6726, 6727},
+	{0xFED2, 6727, 6728},
+	{0xFED3, 6728, 6729},
+	{0xFED4, 6729, 6730},
+	{0xFED5, 6730, 6731},
+	{0xFED6, 6731, 6732},
+	{0xFED7, 6732, 6733},
+	{0xFED8, 6733, 6734},
+	{0xFED9, 6734, 6735},
+	{0xFEDA, 6735, 6736},
+	{0xFEDB, 6736, 6737},
+	{0xFEDC, 6737, 6738},
+	{0xFEDD, 6738, 6739},
+	{0xFEDE, 6739, 6740},
+	{0xFEDF, 6740, 6741},
+	{0xFEE0, 6741, 6742},
+	{0xFEE1, 6742, 6743},
+	{0xFEE2, 6743, 6744},
+	{0xFEE3, 6744, 6745},
+	{0xFEE4, 6745, 6746},
+	{0xFEE5, 6746, 6747},
+	{0xFEE6, 6747, 6748},
+	{0xFEE7, 6748, 6749},
+	{0xFEE8, 6749, 6750},
+	{0xFEE9, 6750, 6751},
+	{0xFEEA, 6751, 6752},
+	{0xFEEB, 6752, 6753},
+	{0xFEEC, 6753, 6754},
+	{0xFEED, 6754, 6755},
+	{0xFEEE, 6755, 6756},
+	{0xFEEF, 6756, 6757},
+	{0xFEF0, 6757, 6758},
+	{0xFEF1, 6758, 6759},
+	{0xFEF2, 6759, 6760},
+	{0xFEF3, 6760, 6761},
+	{0xFEF4, 6761, 6762},
+	{0xFEF5, 6762, 6765},
+	{0xFEF6, 6765, 6768},
+	{0xFEF7, 6768, 6771},
+	{0xFEF8, 6771, 6774},
+	{0xFEF9, 6774, 6777},
+	{0xFEFA, 6777, 6780},
+	{0xFEFB, 6780, 6782},
+	{0xFEFC, 6782, 6784},
+	{0xFF01, 6784, 6785},
+	{0xFF02, 6785, 6786},
+	{0xFF03, 6786, 6787},
+	{0xFF04, 6787, 6788},
+	{0xFF05, 6788, 6789},
+	{0xFF06, 6789, 6790},
+	{0xFF07, 6790, 6791},
+	{0xFF08, 6791, 6792},
+	{0xFF09, 6792, 6793},
+	{0xFF0A, 6793, 6794},
+	{0xFF0B, 6794, 6795},
+	{0xFF0C, 6795, 6796},
+	{0xFF0D, 6796, 6797},
+	{0xFF0E, 6797, 6798},
+	{0xFF0F, 6798, 6799},
+	{0xFF10, 6799, 6800},
+	{0xFF11, 6800, 6801},
+	{0xFF12, 6801, 6802},
+	{0xFF13, 6802, 6803},
+	{0xFF14, 6803, 6804},
+	{0xFF15, 6804, 6805},
+	{0xFF16, 6805, 6806},
+	{0xFF17, 6806, 6807},
+	{0xFF18, 6807, 6808},
+	{0xFF19, 6808, 6809},
+	{0xFF1A, 6809, 6810},
+	{0xFF1B, 6810, 6811},
+	{0xFF1C, 6811, 6812},
+	{0xFF1D, 6812, 6813},
+	{0xFF1E, 6813, 6814},
+	{0xFF1F, 6814, 6815},
+	{0xFF20, 6815, 6816},
+	{0xFF21, 6816, 6817},
+	{0xFF22, 6817, 6818},
+	{0xFF23, 6818, 6819},
+	{0xFF24, 6819, 6820},
+	{0xFF25, 6820, 6821},
+	{0xFF26, 6821, 6822},
+	{0xFF27, 6822, 6823},
+	{0xFF28, 6823, 6824},
+	{0xFF29, 6824, 6825},
+	{0xFF2A, 6825, 6826},
+	{0xFF2B, 6826, 6827},
+	{0xFF2C, 6827, 6828},
+	{0xFF2D, 6828, 6829},
+	{0xFF2E, 6829, 6830},
+	{0xFF2F, 6830, 6831},
+	{0xFF30, 6831, 6832},
+	{0xFF31, 6832, 6833},
+	{0xFF32, 6833, 6834},
+	{0xFF33, 6834, 6835},
+	{0xFF34, 6835, 6836},
+	{0xFF35, 6836, 6837},
+	{0xFF36, 6837, 6838},
+	{0xFF37, 6838, 6839},
+	{0xFF38, 6839, 6840},
+	{0xFF39, 6840, 6841},
+	{0xFF3A, 6841, 6842},
+	{0xFF3B, 6842, 6843},
+	{0xFF3C, 6843, 6844},
+	{0xFF3D, 6844, 6845},
+	{0xFF3E, 6845, 6846},
+	{0xFF3F, 6846, 6847},
+	{0xFF40, 6847, 6848},
+	{0xFF41, 6848, 6849},
+	{0xFF42, 6849, 6850},
+	{0xFF43, 6850, 6851},
+	{0xFF44, 6851, 6852},
+	{0xFF45, 6852, 6853},
+	{0xFF46, 6853, 6854},
+	{0xFF47, 6854, 6855},
+	{0xFF48, 6855, 6856},
+	{0xFF49, 6856, 6857},
+	{0xFF4A, 6857, 6858},
+	{0xFF4B, 6858, 6859},
+	{0xFF4C, 6859, 6860},
+	{0xFF4D, 6860, 6861},
+	{0xFF4E, 6861, 6862},
+	{0xFF4F, 6862, 6863},
+	{0xFF50, 6863, 6864},
+	{0xFF51, 6864, 6865},
+	{0xFF52, 6865, 6866},
+	{0xFF53, 6866, 6867},
+	{0xFF54, 6867, 6868},
+	{0xFF55, 6868, 6869},
+	{0xFF56, 6869, 6870},
+	{0xFF57, 6870, 6871},
+	{0xFF58, 6871, 6872},
+	{0xFF59, 6872, 6873},
+	{0xFF5A, 6873, 6874},
+	{0xFF5B, 6874, 6875},
+	{0xFF5C, 6875, 6876},
+	{0xFF5D, 6876, 6877},
+	{0xFF5E, 6877, 6878},
+	{0xFF5F, 6878, 6879},
+	{0xFF60, 6879, 6880},
+	{0xFF61, 6880, 6881},
+	{0xFF62, 6881, 6882},
+	{0xFF63, 6882, 6883},
+	{0xFF64, 6883, 6884},
+	{0xFF65, 6884, 6885},
+	{0xFF66, 6885, 6886},
+	{0xFF67, 6886, 6887},
+	{0xFF68, 6887, 6888},
+	{0xFF69, 6888, 6889},
+	{0xFF6A, 6889, 6890},
+	{0xFF6B, 6890, 6891},
+	{0xFF6C, 6891, 6892},
+	{0xFF6D, 6892, 6893},
+	{0xFF6E, 6893, 6894},
+	{0xFF6F, 6894, 6895},
+	{0xFF70, 6895, 6896},
+	{0xFF71, 6896, 6897},
+	{0xFF72, 6897, 6898},
+	{0xFF73, 6898, 6899},
+	{0xFF74, 6899, 6900},
+	{0xFF75, 6900, 6901},
+	{0xFF76, 6901, 6902},
+	{0xFF77, 6902, 6903},
+	{0xFF78, 6903, 6904},
+	{0xFF79, 6904, 6905},
+	{0xFF7A, 6905, 6906},
+	{0xFF7B, 6906, 6907},
+	{0xFF7C, 6907, 6908},
+	{0xFF7D, 6908, 6909},
+	{0xFF7E, 6909, 6910},
+	{0xFF7F, 6910, 6911},
+	{0xFF80, 6911, 6912},
+	{0xFF81, 6912, 6913},
+	{0xFF82, 6913, 6914},
+	{0xFF83, 6914, 6915},
+	{0xFF84, 6915, 6916},
+	{0xFF85, 6916, 6917},
+	{0xFF86, 6917, 6918},
+	{0xFF87, 6918, 6919},
+	{0xFF88, 6919, 6920},
+	{0xFF89, 6920, 6921},
+	{0xFF8A, 6921, 6922},
+	{0xFF8B, 6922, 6923},
+	{0xFF8C, 6923, 6924},
+	{0xFF8D, 6924, 6925},
+	{0xFF8E, 6925, 6926},
+	{0xFF8F, 6926, 6927},
+	{0xFF90, 6927, 6928},
+	{0xFF91, 6928, 6929},
+	{0xFF92, 6929, 6930},
+	{0xFF93, 6930, 6931},
+	{0xFF94, 6931, 6932},
+	{0xFF95, 6932, 6933},
+	{0xFF96, 6933, 6934},
+	{0xFF97, 6934, 6935},
+	{0xFF98, 6935, 6936},
+	{0xFF99, 6936, 6937},
+	{0xFF9A, 6937, 6938},
+	{0xFF9B, 6938, 6939},
+	{0xFF9C, 6939, 6940},
+	{0xFF9D, 6940, 6941},
+	{0xFF9E, 6941, 6942},
+	{0xFF9F, 6942, 6943},
+	{0xFFA0, 6943, 6944},
+	{0xFFA1, 6944, 6945},
+	{0xFFA2, 6945, 6946},
+	{0xFFA3, 6946, 6947},
+	{0xFFA4, 6947, 6948},
+	{0xFFA5, 6948, 6949},
+	{0xFFA6, 6949, 6950},
+	{0xFFA7, 6950, 6951},
+	{0xFFA8, 6951, 6952},
+	{0xFFA9, 6952, 6953},
+	{0xFFAA, 6953, 6954},
+	{0xFFAB, 6954, 6955},
+	{0xFFAC, 6955, 6956},
+	{0xFFAD, 6956, 6957},
+	{0xFFAE, 6957, 6958},
+	{0xFFAF, 6958, 6959},
+	{0xFFB0, 6959, 6960},
+	{0xFFB1, 6960, 6961},
+	{0xFFB2, 6961, 6962},
+	{0xFFB3, 6962, 6963},
+	{0xFFB4, 6963, 6964},
+	{0xFFB5, 6964, 6965},
+	{0xFFB6, 6965, 6966},
+	{0xFFB7, 6966, 6967},
+	{0xFFB8, 6967, 6968},
+	{0xFFB9, 6968, 6969},
+	{0xFFBA, 6969, 6970},
+	{0xFFBB, 6970, 6971},
+	{0xFFBC, 6971, 6972},
+	{0xFFBD, 6972, 6973},
+	{0xFFBE, 6973, 6974},
+	{0xFFC2, 6974, 6975},
+	{0xFFC3, 6975, 6976},
+	{0xFFC4, 6976, 6977},
+	{0xFFC5, 6977, 6978},
+	{0xFFC6, 6978, 6979},
+	{0xFFC7, 6979, 6980},
+	{0xFFCA, 6980, 6981},
+	{0xFFCB, 6981, 6982},
+	{0xFFCC, 6982, 6983},
+	{0xFFCD, 6983, 6984},
+	{0xFFCE, 6984, 6985},
+	{0xFFCF, 6985, 6986},
+	{0xFFD2, 6986, 6987},
+	{0xFFD3, 6987, 6988},
+	{0xFFD4, 6988, 6989},
+	{0xFFD5, 6989, 6990},
+	{0xFFD6, 6990, 6991},
+	{0xFFD7, 6991, 6992},
+	{0xFFDA, 6992, 6993},
+	{0xFFDB, 6993, 6994},
+	{0xFFDC, 6994, 6995},
+	{0xFFE0, 6995, 6996},
+	{0xFFE1, 6996, 6997},
+	{0xFFE2, 6997, 6998},
+	{0xFFE3, 6998, 7000},
+	{0xFFE4, 7000, 7001},
+	{0xFFE5, 7001, 7002},
+	{0xFFE6, 7002, 7003},
+	{0xFFE8, 7003, 7004},
+	{0xFFE9, 7004, 7005},
+	{0xFFEA, 7005, 7006},
+	{0xFFEB, 7006, 7007},
+	{0xFFEC, 7007, 7008},
+	{0xFFED, 7008, 7009},
+	{0xFFEE, 7009, 7010},
+	{0x105C9, 7010, 7012},
+	{0x105E4, 7012, 7014},
+	{0x10781, 7014, 7015},
+	{0x10782, 7015, 7016},
+	{0x10783, 7016, 7017},
+	{0x10784, 7017, 7018},
+	{0x10785, 7018, 7019},
+	{0x10787, 7019, 7020},
+	{0x10788, 7020, 7021},
+	{0x10789, 7021, 7022},
+	{0x1078A, 7022, 7023},
+	{0x1078B, 7023, 7024},
+	{0x1078C, 7024, 7025},
+	{0x1078D, 7025, 7026},
+	{0x1078E, 7026, 7027},
+	{0x1078F, 7027, 7028},
+	{0x10790, 7028, 7029},
+	{0x10791, 7029, 7030},
+	{0x10792, 7030, 7031},
+	{0x10793, 7031, 7032},
+	{0x10794, 7032, 7033},
+	{0x10795, 7033, 7034},
+	{0x10796, 7034, 7035},
+	{0x10797, 7035, 7036},
+	{0x10798, 7036, 7037},
+	{0x10799, 7037, 7038},
+	{0x1079A, 7038, 7039},
+	{0x1079B, 7039, 7040},
+	{0x1079C, 7040, 7041},
+	{0x1079D, 7041, 7042},
+	{0x1079E, 7042, 7043},
+	{0x1079F, 7043, 7044},
+	{0x107A0, 7044, 7045},
+	{0x107A1, 7045, 7046},
+	{0x107A2, 7046, 7047},
+	{0x107A3, 7047, 7048},
+	{0x107A4, 7048, 7049},
+	{0x107A5, 7049, 7050},
+	{0x107A6, 7050, 7051},
+	{0x107A7, 7051, 7052},
+	{0x107A8, 7052, 7053},
+	{0x107A9, 7053, 7054},
+	{0x107AA, 7054, 7055},
+	{0x107AB, 7055, 7056},
+	{0x107AC, 7056, 7057},
+	{0x107AD, 7057, 7058},
+	{0x107AE, 7058, 7059},
+	{0x107AF, 7059, 7060},
+	{0x107B0, 7060, 7061},
+	{0x107B2, 7061, 7062},
+	{0x107B3, 7062, 7063},
+	{0x107B4, 7063, 7064},
+	{0x107B5, 7064, 7065},
+	{0x107B6, 7065, 7066},
+	{0x107B7, 7066, 7067},
+	{0x107B8, 7067, 7068},
+	{0x107B9, 7068, 7069},
+	{0x107BA, 7069, 7070},
+	{0x1109A, 7070, 7072},
+	{0x1109C, 7072, 7074},
+	{0x110AB, 7074, 7076},
+	{0x1112E, 7076, 7078},
+	{0x1112F, 7078, 7080},
+	{0x1134B, 7080, 7082},
+	{0x1134C, 7082, 7084},
+	{0x11383, 7084, 7086},
+	{0x11385, 7086, 7088},
+	{0x1138E, 7088, 7090},
+	{0x11391, 7090, 7092},
+	{0x113C5, 7092, 7094},
+	{0x113C7, 7094, 7096},
+	{0x113C8, 7096, 7098},
+	{0x114BB, 7098, 7100},
+	{0x114BC, 7100, 7102},
+	{0x114BE, 7102, 7104},
+	{0x115BA, 7104, 7106},
+	{0x115BB, 7106, 7108},
+	{0x11938, 7108, 7110},
+	{0x16121, 7110, 7112},
+	{0x16122, 7112, 7114},
+	{0x16123, 7114, 7116},
+	{0x16124, 7116, 7118},
+	{0x16125, 7118, 7120},
+	{0x16126, 7120, 7123},
+	{0x16127, 7123, 7126},
+	{0x16128, 7126, 7129},
+	{0x16D68, 7129, 7131},
+	{0x16D69, 7131, 7133},
+	{0x16D6A, 7133, 7136},
+	{0x1CCD6, 7136, 7137},
+	{0x1CCD7, 7137, 7138},
+	{0x1CCD8, 7138, 7139},
+	{0x1CCD9, 7139, 7140},
+	{0x1CCDA, 7140, 7141},
+	{0x1CCDB, 7141, 7142},
+	{0x1CCDC, 7142, 7143},
+	{0x1CCDD, 7143, 7144},
+	{0x1CCDE, 7144, 7145},
+	{0x1CCDF, 7145, 7146},
+	{0x1CCE0, 7146, 7147},
+	{0x1CCE1, 7147, 7148},
+	{0x1CCE2, 7148, 7149},
+	{0x1CCE3, 7149, 7150},
+	{0x1CCE4, 7150, 7151},
+	{0x1CCE5, 7151, 7152},
+	{0x1CCE6, 7152, 7153},
+	{0x1CCE7, 7153, 7154},
+	{0x1CCE8, 7154, 7155},
+	{0x1CCE9, 7155, 7156},
+	{0x1CCEA, 7156, 7157},
+	{0x1CCEB, 7157, 7158},
+	{0x1CCEC, 7158, 7159},
+	{0x1CCED, 7159, 7160},
+	{0x1CCEE, 7160, 7161},
+	{0x1CCEF, 7161, 7162},
+	{0x1CCF0, 7162, 7163},
+	{0x1CCF1, 7163, 7164},
+	{0x1CCF2, 7164, 7165},
+	{0x1CCF3, 7165, 7166},
+	{0x1CCF4, 7166, 7167},
+	{0x1CCF5, 7167, 7168},
+	{0x1CCF6, 7168, 7169},
+	{0x1CCF7, 7169, 7170},
+	{0x1CCF8, 7170, 7171},
+	{0x1CCF9, 7171, 7172},
+	{0x1D15E, 7172, 7174},
+	{0x1D15F, 7174, 7176},
+	{0x1D160, 7176, 7179},
+	{0x1D161, 7179, 7182},
+	{0x1D162, 7182, 7185},
+	{0x1D163, 7185, 7188},
+	{0x1D164, 7188, 7191},
+	{0x1D1BB, 7191, 7193},
+	{0x1D1BC, 7193, 7195},
+	{0x1D1BD, 7195, 7198},
+	{0x1D1BE, 7198, 7201},
+	{0x1D1BF, 7201, 7204},
+	{0x1D1C0, 7204, 7207},
+	{0x1D400, 7207, 7208},
+	{0x1D401, 7208, 7209},
+	{0x1D402, 7209, 7210},
+	{0x1D403, 7210, 7211},
+	{0x1D404, 7211, 7212},
+	{0x1D405, 7212, 7213},
+	{0x1D406, 7213, 7214},
+	{0x1D407, 7214, 7215},
+	{0x1D408, 7215, 7216},
+	{0x1D409, 7216, 7217},
+	{0x1D40A, 7217, 7218},
+	{0x1D40B, 7218, 7219},
+	{0x1D40C, 7219, 7220},
+	{0x1D40D, 7220, 7221},
+	{0x1D40E, 7221, 7222},
+	{0x1D40F, 7222, 7223},
+	{0x1D410, 7223, 7224},
+	{0x1D411, 7224, 7225},
+	{0x1D412, 7225, 7226},
+	{0x1D413, 7226, 7227},
+	{0x1D414, 7227, 7228},
+	{0x1D415, 7228, 7229},
+	{0x1D416, 7229, 7230},
+	{0x1D417, 7230, 7231},
+	{0x1D418, 7231, 7232},
+	{0x1D419, 7232, 7233},
+	{0x1D41A, 7233, 7234},
+	{0x1D41B, 7234, 7235},
+	{0x1D41C, 7235, 7236},
+	{0x1D41D, 7236, 7237},
+	{0x1D41E, 7237, 7238},
+	{0x1D41F, 7238, 7239},
+	{0x1D420, 7239, 7240},
+	{0x1D421, 7240, 7241},
+	{0x1D422, 7241, 7242},
+	{0x1D423, 7242, 7243},
+	{0x1D424, 7243, 7244},
+	{0x1D425, 7244, 7245},
+	{0x1D426, 7245, 7246},
+	{0x1D427, 7246, 7247},
+	{0x1D428, 7247, 7248},
+	{0x1D429, 7248, 7249},
+	{0x1D42A, 7249, 7250},
+	{0x1D42B, 7250, 7251},
+	{0x1D42C, 7251, 7252},
+	{0x1D42D, 7252, 7253},
+	{0x1D42E, 7253, 7254},
+	{0x1D42F, 7254, 7255},
+	{0x1D430, 7255, 7256},
+	{0x1D431, 7256, 7257},
+	{0x1D432, 7257, 7258},
+	{0x1D433, 7258, 7259},
+	{0x1D434, 7259, 7260},
+	{0x1D435, 7260, 7261},
+	{0x1D436, 7261, 7262},
+	{0x1D437, 7262, 7263},
+	{0x1D438, 7263, 7264},
+	{0x1D439, 7264, 7265},
+	{0x1D43A, 7265, 7266},
+	{0x1D43B, 7266, 7267},
+	{0x1D43C, 7267, 7268},
+	{0x1D43D, 7268, 7269},
+	{0x1D43E, 7269, 7270},
+	{0x1D43F, 7270, 7271},
+	{0x1D440, 7271, 7272},
+	{0x1D441, 7272, 7273},
+	{0x1D442, 7273, 7274},
+	{0x1D443, 7274, 7275},
+	{0x1D444, 7275, 7276},
+	{0x1D445, 7276, 7277},
+	{0x1D446, 7277, 7278},
+	{0x1D447, 7278, 7279},
+	{0x1D448, 7279, 7280},
+	{0x1D449, 7280, 7281},
+	{0x1D44A, 7281, 7282},
+	{0x1D44B, 7282, 7283},
+	{0x1D44C, 7283, 7284},
+	{0x1D44D, 7284, 7285},
+	{0x1D44E, 7285, 7286},
+	{0x1D44F, 7286, 7287},
+	{0x1D450, 7287, 7288},
+	{0x1D451, 7288, 7289},
+	{0x1D452, 7289, 7290},
+	{0x1D453, 7290, 7291},
+	{0x1D454, 7291, 7292},
+	{0x1D456, 7292, 7293},
+	{0x1D457, 7293, 7294},
+	{0x1D458, 7294, 7295},
+	{0x1D459, 7295, 7296},
+	{0x1D45A, 7296, 7297},
+	{0x1D45B, 7297, 7298},
+	{0x1D45C, 7298, 7299},
+	{0x1D45D, 7299, 7300},
+	{0x1D45E, 7300, 7301},
+	{0x1D45F, 7301, 7302},
+	{0x1D460, 7302, 7303},
+	{0x1D461, 7303, 7304},
+	{0x1D462, 7304, 7305},
+	{0x1D463, 7305, 7306},
+	{0x1D464, 7306, 7307},
+	{0x1D465, 7307, 7308},
+	{0x1D466, 7308, 7309},
+	{0x1D467, 7309, 7310},
+	{0x1D468, 7310, 7311},
+	{0x1D469, 7311, 7312},
+	{0x1D46A, 7312, 7313},
+	{0x1D46B, 7313, 7314},
+	{0x1D46C, 7314, 7315},
+	{0x1D46D, 7315, 7316},
+	{0x1D46E, 7316, 7317},
+	{0x1D46F, 7317, 7318},
+	{0x1D470, 7318, 7319},
+	{0x1D471, 7319, 7320},
+	{0x1D472, 7320, 7321},
+	{0x1D473, 7321, 7322},
+	{0x1D474, 7322, 7323},
+	{0x1D475, 7323, 7324},
+	{0x1D476, 7324, 7325},
+	{0x1D477, 7325, 7326},
+	{0x1D478, 7326, 7327},
+	{0x1D479, 7327, 7328},
+	{0x1D47A, 7328, 7329},
+	{0x1D47B, 7329, 7330},
+	{0x1D47C, 7330, 7331},
+	{0x1D47D, 7331, 7332},
+	{0x1D47E, 7332, 7333},
+	{0x1D47F, 7333, 7334},
+	{0x1D480, 7334, 7335},
+	{0x1D481, 7335, 7336},
+	{0x1D482, 7336, 7337},
+	{0x1D483, 7337, 7338},
+	{0x1D484, 7338, 7339},
+	{0x1D485, 7339, 7340},
+	{0x1D486, 7340, 7341},
+	{0x1D487, 7341, 7342},
+	{0x1D488, 7342, 7343},
+	{0x1D489, 7343, 7344},
+	{0x1D48A, 7344, 7345},
+	{0x1D48B, 7345, 7346},
+	{0x1D48C, 7346, 7347},
+	{0x1D48D, 7347, 7348},
+	{0x1D48E, 7348, 7349},
+	{0x1D48F, 7349, 7350},
+	{0x1D490, 7350, 7351},
+	{0x1D491, 7351, 7352},
+	{0x1D492, 7352, 7353},
+	{0x1D493, 7353, 7354},
+	{0x1D494, 7354, 7355},
+	{0x1D495, 7355, 7356},
+	{0x1D496, 7356, 7357},
+	{0x1D497, 7357, 7358},
+	{0x1D498, 7358, 7359},
+	{0x1D499, 7359, 7360},
+	{0x1D49A, 7360, 7361},
+	{0x1D49B, 7361, 7362},
+	{0x1D49C, 7362, 7363},
+	{0x1D49E, 7363, 7364},
+	{0x1D49F, 7364, 7365},
+	{0x1D4A2, 7365, 7366},
+	{0x1D4A5, 7366, 7367},
+	{0x1D4A6, 7367, 7368},
+	{0x1D4A9, 7368, 7369},
+	{0x1D4AA, 7369, 7370},
+	{0x1D4AB, 7370, 7371},
+	{0x1D4AC, 7371, 7372},
+	{0x1D4AE, 7372, 7373},
+	{0x1D4AF, 7373, 7374},
+	{0x1D4B0, 7374, 7375},
+	{0x1D4B1, 7375, 7376},
+	{0x1D4B2, 7376, 7377},
+	{0x1D4B3, 7377, 7378},
+	{0x1D4B4, 7378, 7379},
+	{0x1D4B5, 7379, 7380},
+	{0x1D4B6, 7380, 7381},
+	{0x1D4B7, 7381, 7382},
+	{0x1D4B8, 7382, 7383},
+	{0x1D4B9, 7383, 7384},
+	{0x1D4BB, 7384, 7385},
+	{0x1D4BD, 7385, 7386},
+	{0x1D4BE, 7386, 7387},
+	{0x1D4BF, 7387, 7388},
+	{0x1D4C0, 7388, 7389},
+	{0x1D4C1, 7389, 7390},
+	{0x1D4C2, 7390, 7391},
+	{0x1D4C3, 7391, 7392},
+	{0x1D4C5, 7392, 7393},
+	{0x1D4C6, 7393, 7394},
+	{0x1D4C7, 7394, 7395},
+	{0x1D4C8, 7395, 7396},
+	{0x1D4C9, 7396, 7397},
+	{0x1D4CA, 7397, 7398},
+	{0x1D4CB, 7398, 7399},
+	{0x1D4CC, 7399, 7400},
+	{0x1D4CD, 7400, 7401},
+	{0x1D4CE, 7401, 7402},
+	{0x1D4CF, 7402, 7403},
+	{0x1D4D0, 7403, 7404},
+	{0x1D4D1, 7404, 7405},
+	{0x1D4D2, 7405, 7406},
+	{0x1D4D3, 7406, 7407},
+	{0x1D4D4, 7407, 7408},
+	{0x1D4D5, 7408, 7409},
+	{0x1D4D6, 7409, 7410},
+	{0x1D4D7, 7410, 7411},
+	{0x1D4D8, 7411, 7412},
+	{0x1D4D9, 7412, 7413},
+	{0x1D4DA, 7413, 7414},
+	{0x1D4DB, 7414, 7415},
+	{0x1D4DC, 7415, 7416},
+	{0x1D4DD, 7416, 7417},
+	{0x1D4DE, 7417, 7418},
+	{0x1D4DF, 7418, 7419},
+	{0x1D4E0, 7419, 7420},
+	{0x1D4E1, 7420, 7421},
+	{0x1D4E2, 7421, 7422},
+	{0x1D4E3, 7422, 7423},
+	{0x1D4E4, 7423, 7424},
+	{0x1D4E5, 7424, 7425},
+	{0x1D4E6, 7425, 7426},
+	{0x1D4E7, 7426, 7427},
+	{0x1D4E8, 7427, 7428},
+	{0x1D4E9, 7428, 7429},
+	{0x1D4EA, 7429, 7430},
+	{0x1D4EB, 7430, 7431},
+	{0x1D4EC, 7431, 7432},
+	{0x1D4ED, 7432, 7433},
+	{0x1D4EE, 7433, 7434},
+	{0x1D4EF, 7434, 7435},
+	{0x1D4F0, 7435, 7436},
+	{0x1D4F1, 7436, 7437},
+	{0x1D4F2, 7437, 7438},
+	{0x1D4F3, 7438, 7439},
+	{0x1D4F4, 7439, 7440},
+	{0x1D4F5, 7440, 7441},
+	{0x1D4F6, 7441, 7442},
+	{0x1D4F7, 7442, 7443},
+	{0x1D4F8, 7443, 7444},
+	{0x1D4F9, 7444, 7445},
+	{0x1D4FA, 7445, 7446},
+	{0x1D4FB, 7446, 7447},
+	{0x1D4FC, 7447, 7448},
+	{0x1D4FD, 7448, 7449},
+	{0x1D4FE, 7449, 7450},
+	{0x1D4FF, 7450, 7451},
+	{0x1D500, 7451, 7452},
+	{0x1D501, 7452, 7453},
+	{0x1D502, 7453, 7454},
+	{0x1D503, 7454, 7455},
+	{0x1D504, 7455, 7456},
+	{0x1D505, 7456, 7457},
+	{0x1D507, 7457, 7458},
+	{0x1D508, 7458, 7459},
+	{0x1D509, 7459, 7460},
+	{0x1D50A, 7460, 7461},
+	{0x1D50D, 7461, 7462},
+	{0x1D50E, 7462, 7463},
+	{0x1D50F, 7463, 7464},
+	{0x1D510, 7464, 7465},
+	{0x1D511, 7465, 7466},
+	{0x1D512, 7466, 7467},
+	{0x1D513, 7467, 7468},
+	{0x1D514, 7468, 7469},
+	{0x1D516, 7469, 7470},
+	{0x1D517, 7470, 7471},
+	{0x1D518, 7471, 7472},
+	{0x1D519, 7472, 7473},
+	{0x1D51A, 7473, 7474},
+	{0x1D51B, 7474, 7475},
+	{0x1D51C, 7475, 7476},
+	{0x1D51E, 7476, 7477},
+	{0x1D51F, 7477, 7478},
+	{0x1D520, 7478, 7479},
+	{0x1D521, 7479, 7480},
+	{0x1D522, 7480, 7481},
+	{0x1D523, 7481, 7482},
+	{0x1D524, 7482, 7483},
+	{0x1D525, 7483, 7484},
+	{0x1D526, 7484, 7485},
+	{0x1D527, 7485, 7486},
+	{0x1D528, 7486, 7487},
+	{0x1D529, 7487, 7488},
+	{0x1D52A, 7488, 7489},
+	{0x1D52B, 7489, 7490},
+	{0x1D52C, 7490, 7491},
+	{0x1D52D, 7491, 7492},
+	{0x1D52E, 7492, 7493},
+	{0x1D52F, 7493, 7494},
+	{0x1D530, 7494, 7495},
+	{0x1D531, 7495, 7496},
+	{0x1D532, 7496, 7497},
+	{0x1D533, 7497, 7498},
+	{0x1D534, 7498, 7499},
+	{0x1D535, 7499, 7500},
+	{0x1D536, 7500, 7501},
+	{0x1D537, 7501, 7502},
+	{0x1D538, 7502, 7503},
+	{0x1D539, 7503, 7504},
+	{0x1D53B, 7504, 7505},
+	{0x1D53C, 7505, 7506},
+	{0x1D53D, 7506, 7507},
+	{0x1D53E, 7507, 7508},
+	{0x1D540, 7508, 7509},
+	{0x1D541, 7509, 7510},
+	{0x1D542, 7510, 7511},
+	{0x1D543, 7511, 7512},
+	{0x1D544, 7512, 7513},
+	{0x1D546, 7513, 7514},
+	{0x1D54A, 7514, 7515},
+	{0x1D54B, 7515, 7516},
+	{0x1D54C, 7516, 7517},
+	{0x1D54D, 7517, 7518},
+	{0x1D54E, 7518, 7519},
+	{0x1D54F, 7519, 7520},
+	{0x1D550, 7520, 7521},
+	{0x1D552, 7521, 7522},
+	{0x1D553, 7522, 7523},
+	{0x1D554, 7523, 7524},
+	{0x1D555, 7524, 7525},
+	{0x1D556, 7525, 7526},
+	{0x1D557, 7526, 7527},
+	{0x1D558, 7527, 7528},
+	{0x1D559, 7528, 7529},
+	{0x1D55A, 7529, 7530},
+	{0x1D55B, 7530, 7531},
+	{0x1D55C, 7531, 7532},
+	{0x1D55D, 7532, 7533},
+	{0x1D55E, 7533, 7534},
+	{0x1D55F, 7534, 7535},
+	{0x1D560, 7535, 7536},
+	{0x1D561, 7536, 7537},
+	{0x1D562, 7537, 7538},
+	{0x1D563, 7538, 7539},
+	{0x1D564, 7539, 7540},
+	{0x1D565, 7540, 7541},
+	{0x1D566, 7541, 7542},
+	{0x1D567, 7542, 7543},
+	{0x1D568, 7543, 7544},
+	{0x1D569, 7544, 7545},
+	{0x1D56A, 7545, 7546},
+	{0x1D56B, 7546, 7547},
+	{0x1D56C, 7547, 7548},
+	{0x1D56D, 7548, 7549},
+	{0x1D56E, 7549, 7550},
+	{0x1D56F, 7550, 7551},
+	{0x1D570, 7551, 7552},
+	{0x1D571, 7552, 7553},
+	{0x1D572, 7553, 7554},
+	{0x1D573, 7554, 7555},
+	{0x1D574, 7555, 7556},
+	{0x1D575, 7556, 7557},
+	{0x1D576, 7557, 7558},
+	{0x1D577, 7558, 7559},
+	{0x1D578, 7559, 7560},
+	{0x1D579, 7560, 7561},
+	{0x1D57A, 7561, 7562},
+	{0x1D57B, 7562, 7563},
+	{0x1D57C, 7563, 7564},
+	{0x1D57D, 7564, 7565},
+	{0x1D57E, 7565, 7566},
+	{0x1D57F, 7566, 7567},
+	{0x1D580, 7567, 7568},
+	{0x1D581, 7568, 7569},
+	{0x1D582, 7569, 7570},
+	{0x1D583, 7570, 7571},
+	{0x1D584, 7571, 7572},
+	{0x1D585, 7572, 7573},
+	{0x1D586, 7573, 7574},
+	{0x1D587, 7574, 7575},
+	{0x1D588, 7575, 7576},
+	{0x1D589, 7576, 7577},
+	{0x1D58A, 7577, 7578},
+	{0x1D58B, 7578, 7579},
+	{0x1D58C, 7579, 7580},
+	{0x1D58D, 7580, 7581},
+	{0x1D58E, 7581, 7582},
+	{0x1D58F, 7582, 7583},
+	{0x1D590, 7583, 7584},
+	{0x1D591, 7584, 7585},
+	{0x1D592, 7585, 7586},
+	{0x1D593, 7586, 7587},
+	{0x1D594, 7587, 7588},
+	{0x1D595, 7588, 7589},
+	{0x1D596, 7589, 7590},
+	{0x1D597, 7590, 7591},
+	{0x1D598, 7591, 7592},
+	{0x1D599, 7592, 7593},
+	{0x1D59A, 7593, 7594},
+	{0x1D59B, 7594, 7595},
+	{0x1D59C, 7595, 7596},
+	{0x1D59D, 7596, 7597},
+	{0x1D59E, 7597, 7598},
+	{0x1D59F, 7598, 7599},
+	{0x1D5A0, 7599, 7600},
+	{0x1D5A1, 7600, 7601},
+	{0x1D5A2, 7601, 7602},
+	{0x1D5A3, 7602, 7603},
+	{0x1D5A4, 7603, 7604},
+	{0x1D5A5, 7604, 7605},
+	{0x1D5A6, 7605, 7606},
+	{0x1D5A7, 7606, 7607},
+	{0x1D5A8, 7607, 7608},
+	{0x1D5A9, 7608, 7609},
+	{0x1D5AA, 7609, 7610},
+	{0x1D5AB, 7610, 7611},
+	{0x1D5AC, 7611, 7612},
+	{0x1D5AD, 7612, 7613},
+	{0x1D5AE, 7613, 7614},
+	{0x1D5AF, 7614, 7615},
+	{0x1D5B0, 7615, 7616},
+	{0x1D5B1, 7616, 7617},
+	{0x1D5B2, 7617, 7618},
+	{0x1D5B3, 7618, 7619},
+	{0x1D5B4, 7619, 7620},
+	{0x1D5B5, 7620, 7621},
+	{0x1D5B6, 7621, 7622},
+	{0x1D5B7, 7622, 7623},
+	{0x1D5B8, 7623, 7624},
+	{0x1D5B9, 7624, 7625},
+	{0x1D5BA, 7625, 7626},
+	{0x1D5BB, 7626, 7627},
+	{0x1D5BC, 7627, 7628},
+	{0x1D5BD, 7628, 7629},
+	{0x1D5BE, 7629, 7630},
+	{0x1D5BF, 7630, 7631},
+	{0x1D5C0, 7631, 7632},
+	{0x1D5C1, 7632, 7633},
+	{0x1D5C2, 7633, 7634},
+	{0x1D5C3, 7634, 7635},
+	{0x1D5C4, 7635, 7636},
+	{0x1D5C5, 7636, 7637},
+	{0x1D5C6, 7637, 7638},
+	{0x1D5C7, 7638, 7639},
+	{0x1D5C8, 7639, 7640},
+	{0x1D5C9, 7640, 7641},
+	{0x1D5CA, 7641, 7642},
+	{0x1D5CB, 7642, 7643},
+	{0x1D5CC, 7643, 7644},
+	{0x1D5CD, 7644, 7645},
+	{0x1D5CE, 7645, 7646},
+	{0x1D5CF, 7646, 7647},
+	{0x1D5D0, 7647, 7648},
+	{0x1D5D1, 7648, 7649},
+	{0x1D5D2, 7649, 7650},
+	{0x1D5D3, 7650, 7651},
+	{0x1D5D4, 7651, 7652},
+	{0x1D5D5, 7652, 7653},
+	{0x1D5D6, 7653, 7654},
+	{0x1D5D7, 7654, 7655},
+	{0x1D5D8, 7655, 7656},
+	{0x1D5D9, 7656, 7657},
+	{0x1D5DA, 7657, 7658},
+	{0x1D5DB, 7658, 7659},
+	{0x1D5DC, 7659, 7660},
+	{0x1D5DD, 7660, 7661},
+	{0x1D5DE, 7661, 7662},
+	{0x1D5DF, 7662, 7663},
+	{0x1D5E0, 7663, 7664},
+	{0x1D5E1, 7664, 7665},
+	{0x1D5E2, 7665, 7666},
+	{0x1D5E3, 7666, 7667},
+	{0x1D5E4, 7667, 7668},
+	{0x1D5E5, 7668, 7669},
+	{0x1D5E6, 7669, 7670},
+	{0x1D5E7, 7670, 7671},
+	{0x1D5E8, 7671, 7672},
+	{0x1D5E9, 7672, 7673},
+	{0x1D5EA, 7673, 7674},
+	{0x1D5EB, 7674, 7675},
+	{0x1D5EC, 7675, 7676},
+	{0x1D5ED, 7676, 7677},
+	{0x1D5EE, 7677, 7678},
+	{0x1D5EF, 7678, 7679},
+	{0x1D5F0, 7679, 7680},
+	{0x1D5F1, 7680, 7681},
+	{0x1D5F2, 7681, 7682},
+	{0x1D5F3, 7682, 7683},
+	{0x1D5F4, 7683, 7684},
+	{0x1D5F5, 7684, 7685},
+	{0x1D5F6, 7685, 7686},
+	{0x1D5F7, 7686, 7687},
+	{0x1D5F8, 7687, 7688},
+	{0x1D5F9, 7688, 7689},
+	{0x1D5FA, 7689, 7690},
+	{0x1D5FB, 7690, 7691},
+	{0x1D5FC, 7691, 7692},
+	{0x1D5FD, 7692, 7693},
+	{0x1D5FE, 7693, 7694},
+	{0x1D5FF, 7694, 7695},
+	{0x1D600, 7695, 7696},
+	{0x1D601, 7696, 7697},
+	{0x1D602, 7697, 7698},
+	{0x1D603, 7698, 7699},
+	{0x1D604, 7699, 7700},
+	{0x1D605, 7700, 7701},
+	{0x1D606, 7701, 7702},
+	{0x1D607, 7702, 7703},
+	{0x1D608, 7703, 7704},
+	{0x1D609, 7704, 7705},
+	{0x1D60A, 7705, 7706},
+	{0x1D60B, 7706, 7707},
+	{0x1D60C, 7707, 7708},
+	{0x1D60D, 7708, 7709},
+	{0x1D60E, 7709, 7710},
+	{0x1D60F, 7710, 7711},
+	{0x1D610, 7711, 7712},
+	{0x1D611, 7712, 7713},
+	{0x1D612, 7713, 7714},
+	{0x1D613, 7714, 7715},
+	{0x1D614, 7715, 7716},
+	{0x1D615, 7716, 7717},
+	{0x1D616, 7717, 7718},
+	{0x1D617, 7718, 7719},
+	{0x1D618, 7719, 7720},
+	{0x1D619, 7720, 7721},
+	{0x1D61A, 7721, 7722},
+	{0x1D61B, 7722, 7723},
+	{0x1D61C, 7723, 7724},
+	{0x1D61D, 7724, 7725},
+	{0x1D61E, 7725, 7726},
+	{0x1D61F, 7726, 7727},
+	{0x1D620, 7727, 7728},
+	{0x1D621, 7728, 7729},
+	{0x1D622, 7729, 7730},
+	{0x1D623, 7730, 7731},
+	{0x1D624, 7731, 7732},
+	{0x1D625, 7732, 7733},
+	{0x1D626, 7733, 7734},
+	{0x1D627, 7734, 7735},
+	{0x1D628, 7735, 7736},
+	{0x1D629, 7736, 7737},
+	{0x1D62A, 7737, 7738},
+	{0x1D62B, 7738, 7739},
+	{0x1D62C, 7739, 7740},
+	{0x1D62D, 7740, 7741},
+	{0x1D62E, 7741, 7742},
+	{0x1D62F, 7742, 7743},
+	{0x1D630, 7743, 7744},
+	{0x1D631, 7744, 7745},
+	{0x1D632, 7745, 7746},
+	{0x1D633, 7746, 7747},
+	{0x1D634, 7747, 7748},
+	{0x1D635, 7748, 7749},
+	{0x1D636, 7749, 7750},
+	{0x1D637, 7750, 7751},
+	{0x1D638, 7751, 7752},
+	{0x1D639, 7752, 7753},
+	{0x1D63A, 7753, 7754},
+	{0x1D63B, 7754, 7755},
+	{0x1D63C, 7755, 7756},
+	{0x1D63D, 7756, 7757},
+	{0x1D63E, 7757, 7758},
+	{0x1D63F, 7758, 7759},
+	{0x1D640, 7759, 7760},
+	{0x1D641, 7760, 7761},
+	{0x1D642, 7761, 7762},
+	{0x1D643, 7762, 7763},
+	{0x1D644, 7763, 7764},
+	{0x1D645, 7764, 7765},
+	{0x1D646, 7765, 7766},
+	{0x1D647, 7766, 7767},
+	{0x1D648, 7767, 7768},
+	{0x1D649, 7768, 7769},
+	{0x1D64A, 7769, 7770},
+	{0x1D64B, 7770, 7771},
+	{0x1D64C, 7771, 7772},
+	{0x1D64D, 7772, 7773},
+	{0x1D64E, 7773, 7774},
+	{0x1D64F, 7774, 7775},
+	{0x1D650, 7775, 7776},
+	{0x1D651, 7776, 7777},
+	{0x1D652, 7777, 7778},
+	{0x1D653, 7778, 7779},
+	{0x1D654, 7779, 7780},
+	{0x1D655, 7780, 7781},
+	{0x1D656, 7781, 7782},
+	{0x1D657, 7782, 7783},
+	{0x1D658, 7783, 7784},
+	{0x1D659, 7784, 7785},
+	{0x1D65A, 7785, 7786},
+	{0x1D65B, 7786, 7787},
+	{0x1D65C, 7787, 7788},
+	{0x1D65D, 7788, 7789},
+	{0x1D65E, 7789, 7790},
+	{0x1D65F, 7790, 7791},
+	{0x1D660, 7791, 7792},
+	{0x1D661, 7792, 7793},
+	{0x1D662, 7793, 7794},
+	{0x1D663, 7794, 7795},
+	{0x1D664, 7795, 7796},
+	{0x1D665, 7796, 7797},
+	{0x1D666, 7797, 7798},
+	{0x1D667, 7798, 7799},
+	{0x1D668, 7799, 7800},
+	{0x1D669, 7800, 7801},
+	{0x1D66A, 7801, 7802},
+	{0x1D66B, 7802, 7803},
+	{0x1D66C, 7803, 7804},
+	{0x1D66D, 7804, 7805},
+	{0x1D66E, 7805, 7806},
+	{0x1D66F, 7806, 7807},
+	{0x1D670, 7807, 7808},
+	{0x1D671, 7808, 7809},
+	{0x1D672, 7809, 7810},
+	{0x1D673, 7810, 7811},
+	{0x1D674, 7811, 7812},
+	{0x1D675, 7812, 7813},
+	{0x1D676, 7813, 7814},
+	{0x1D677, 7814, 7815},
+	{0x1D678, 7815, 7816},
+	{0x1D679, 7816, 7817},
+	{0x1D67A, 7817, 7818},
+	{0x1D67B, 7818, 7819},
+	{0x1D67C, 7819, 7820},
+	{0x1D67D, 7820, 7821},
+	{0x1D67E, 7821, 7822},
+	{0x1D67F, 7822, 7823},
+	{0x1D680, 7823, 7824},
+	{0x1D681, 7824, 7825},
+	{0x1D682, 7825, 7826},
+	{0x1D683, 7826, 7827},
+	{0x1D684, 7827, 7828},
+	{0x1D685, 7828, 7829},
+	{0x1D686, 7829, 7830},
+	{0x1D687, 7830, 7831},
+	{0x1D688, 7831, 7832},
+	{0x1D689, 7832, 7833},
+	{0x1D68A, 7833, 7834},
+	{0x1D68B, 7834, 7835},
+	{0x1D68C, 7835, 7836},
+	{0x1D68D, 7836, 7837},
+	{0x1D68E, 7837, 7838},
+	{0x1D68F, 7838, 7839},
+	{0x1D690, 7839, 7840},
+	{0x1D691, 7840, 7841},
+	{0x1D692, 7841, 7842},
+	{0x1D693, 7842, 7843},
+	{0x1D694, 7843, 7844},
+	{0x1D695, 7844, 7845},
+	{0x1D696, 7845, 7846},
+	{0x1D697, 7846, 7847},
+	{0x1D698, 7847, 7848},
+	{0x1D699, 7848, 7849},
+	{0x1D69A, 7849, 7850},
+	{0x1D69B, 7850, 7851},
+	{0x1D69C, 7851, 7852},
+	{0x1D69D, 7852, 7853},
+	{0x1D69E, 7853, 7854},
+	{0x1D69F, 7854, 7855},
+	{0x1D6A0, 7855, 7856},
+	{0x1D6A1, 7856, 7857},
+	{0x1D6A2, 7857, 7858},
+	{0x1D6A3, 7858, 7859},
+	{0x1D6A4, 7859, 7860},
+	{0x1D6A5, 7860, 7861},
+	{0x1D6A8, 7861, 7862},
+	{0x1D6A9, 7862, 7863},
+	{0x1D6AA, 7863, 7864},
+	{0x1D6AB, 7864, 7865},
+	{0x1D6AC, 7865, 7866},
+	{0x1D6AD, 7866, 7867},
+	{0x1D6AE, 7867, 7868},
+	{0x1D6AF, 7868, 7869},
+	{0x1D6B0, 7869, 7870},
+	{0x1D6B1, 7870, 7871},
+	{0x1D6B2, 7871, 7872},
+	{0x1D6B3, 7872, 7873},
+	{0x1D6B4, 7873, 7874},
+	{0x1D6B5, 7874, 7875},
+	{0x1D6B6, 7875, 7876},
+	{0x1D6B7, 7876, 7877},
+	{0x1D6B8, 7877, 7878},
+	{0x1D6B9, 7878, 7879},
+	{0x1D6BA, 7879, 7880},
+	{0x1D6BB, 7880, 7881},
+	{0x1D6BC, 7881, 7882},
+	{0x1D6BD, 7882, 7883},
+	{0x1D6BE, 7883, 7884},
+	{0x1D6BF, 7884, 7885},
+	{0x1D6C0, 7885, 7886},
+	{0x1D6C1, 7886, 7887},
+	{0x1D6C2, 7887, 7888},
+	{0x1D6C3, 7888, 7889},
+	{0x1D6C4, 7889, 7890},
+	{0x1D6C5, 7890, 7891},
+	{0x1D6C6, 7891, 7892},
+	{0x1D6C7, 7892, 7893},
+	{0x1D6C8, 7893, 7894},
+	{0x1D6C9, 7894, 7895},
+	{0x1D6CA, 7895, 7896},
+	{0x1D6CB, 7896, 7897},
+	{0x1D6CC, 7897, 7898},
+	{0x1D6CD, 7898, 7899},
+	{0x1D6CE, 7899, 7900},
+	{0x1D6CF, 7900, 7901},
+	{0x1D6D0, 7901, 7902},
+	{0x1D6D1, 7902, 7903},
+	{0x1D6D2, 7903, 7904},
+	{0x1D6D3, 7904, 7905},
+	{0x1D6D4, 7905, 7906},
+	{0x1D6D5, 7906, 7907},
+	{0x1D6D6, 7907, 7908},
+	{0x1D6D7, 7908, 7909},
+	{0x1D6D8, 7909, 7910},
+	{0x1D6D9, 7910, 7911},
+	{0x1D6DA, 7911, 7912},
+	{0x1D6DB, 7912, 7913},
+	{0x1D6DC, 7913, 7914},
+	{0x1D6DD, 7914, 7915},
+	{0x1D6DE, 7915, 7916},
+	{0x1D6DF, 7916, 7917},
+	{0x1D6E0, 7917, 7918},
+	{0x1D6E1, 7918, 7919},
+	{0x1D6E2, 7919, 7920},
+	{0x1D6E3, 7920, 7921},
+	{0x1D6E4, 7921, 7922},
+	{0x1D6E5, 7922, 7923},
+	{0x1D6E6, 7923, 7924},
+	{0x1D6E7, 7924, 7925},
+	{0x1D6E8, 7925, 7926},
+	{0x1D6E9, 7926, 7927},
+	{0x1D6EA, 7927, 7928},
+	{0x1D6EB, 7928, 7929},
+	{0x1D6EC, 7929, 7930},
+	{0x1D6ED, 7930, 7931},
+	{0x1D6EE, 7931, 7932},
+	{0x1D6EF, 7932, 7933},
+	{0x1D6F0, 7933, 7934},
+	{0x1D6F1, 7934, 7935},
+	{0x1D6F2, 7935, 7936},
+	{0x1D6F3, 7936, 7937},
+	{0x1D6F4, 7937, 7938},
+	{0x1D6F5, 7938, 7939},
+	{0x1D6F6, 7939, 7940},
+	{0x1D6F7, 7940, 7941},
+	{0x1D6F8, 7941, 7942},
+	{0x1D6F9, 7942, 7943},
+	{0x1D6FA, 7943, 7944},
+	{0x1D6FB, 7944, 7945},
+	{0x1D6FC, 7945, 7946},
+	{0x1D6FD, 7946, 7947},
+	{0x1D6FE, 7947, 7948},
+	{0x1D6FF, 7948, 7949},
+	{0x1D700, 7949, 7950},
+	{0x1D701, 7950, 7951},
+	{0x1D702, 7951, 7952},
+	{0x1D703, 7952, 7953},
+	{0x1D704, 7953, 7954},
+	{0x1D705, 7954, 7955},
+	{0x1D706, 7955, 7956},
+	{0x1D707, 7956, 7957},
+	{0x1D708, 7957, 7958},
+	{0x1D709, 7958, 7959},
+	{0x1D70A, 7959, 7960},
+	{0x1D70B, 7960, 7961},
+	{0x1D70C, 7961, 7962},
+	{0x1D70D, 7962, 7963},
+	{0x1D70E, 7963, 7964},
+	{0x1D70F, 7964, 7965},
+	{0x1D710, 7965, 7966},
+	{0x1D711, 7966, 7967},
+	{0x1D712, 7967, 7968},
+	{0x1D713, 7968, 7969},
+	{0x1D714, 7969, 7970},
+	{0x1D715, 7970, 7971},
+	{0x1D716, 7971, 7972},
+	{0x1D717, 7972, 7973},
+	{0x1D718, 7973, 7974},
+	{0x1D719, 7974, 7975},
+	{0x1D71A, 7975, 7976},
+	{0x1D71B, 7976, 7977},
+	{0x1D71C, 7977, 7978},
+	{0x1D71D, 7978, 7979},
+	{0x1D71E, 7979, 7980},
+	{0x1D71F, 7980, 7981},
+	{0x1D720, 7981, 7982},
+	{0x1D721, 7982, 7983},
+	{0x1D722, 7983, 7984},
+	{0x1D723, 7984, 7985},
+	{0x1D724, 7985, 7986},
+	{0x1D725, 7986, 7987},
+	{0x1D726, 7987, 7988},
+	{0x1D727, 7988, 7989},
+	{0x1D728, 7989, 7990},
+	{0x1D729, 7990, 7991},
+	{0x1D72A, 7991, 7992},
+	{0x1D72B, 7992, 7993},
+	{0x1D72C, 7993, 7994},
+	{0x1D72D, 7994, 7995},
+	{0x1D72E, 7995, 7996},
+	{0x1D72F, 7996, 7997},
+	{0x1D730, 7997, 7998},
+	{0x1D731, 7998, 7999},
+	{0x1D732, 7999, 8000},
+	{0x1D733, 8000, 8001},
+	{0x1D734, 8001, 8002},
+	{0x1D735, 8002, 8003},
+	{0x1D736, 8003, 8004},
+	{0x1D737, 8004, 8005},
+	{0x1D738, 8005, 8006},
+	{0x1D739, 8006, 8007},
+	{0x1D73A, 8007, 8008},
+	{0x1D73B, 8008, 8009},
+	{0x1D73C, 8009, 8010},
+	{0x1D73D, 8010, 8011},
+	{0x1D73E, 8011, 8012},
+	{0x1D73F, 8012, 8013},
+	{0x1D740, 8013, 8014},
+	{0x1D741, 8014, 8015},
+	{0x1D742, 8015, 8016},
+	{0x1D743, 8016, 8017},
+	{0x1D744, 8017, 8018},
+	{0x1D745, 8018, 8019},
+	{0x1D746, 8019, 8020},
+	{0x1D747, 8020, 8021},
+	{0x1D748, 8021, 8022},
+	{0x1D749, 8022, 8023},
+	{0x1D74A, 8023, 8024},
+	{0x1D74B, 8024, 8025},
+	{0x1D74C, 8025, 8026},
+	{0x1D74D, 8026, 8027},
+	{0x1D74E, 8027, 8028},
+	{0x1D74F, 8028, 8029},
+	{0x1D750, 8029, 8030},
+	{0x1D751, 8030, 8031},
+	{0x1D752, 8031, 8032},
+	{0x1D753, 8032, 8033},
+	{0x1D754, 8033, 8034},
+	{0x1D755, 8034, 8035},
+	{0x1D756, 8035, 8036},
+	{0x1D757, 8036, 8037},
+	{0x1D758, 8037, 8038},
+	{0x1D759, 8038, 8039},
+	{0x1D75A, 8039, 8040},
+	{0x1D75B, 8040, 8041},
+	{0x1D75C, 8041, 8042},
+	{0x1D75D, 8042, 8043},
+	{0x1D75E, 8043, 8044},
+	{0x1D75F, 8044, 8045},
+	{0x1D760, 8045, 8046},
+	{0x1D761, 8046, 8047},
+	{0x1D762, 8047, 8048},
+	{0x1D763, 8048, 8049},
+	{0x1D764, 8049, 8050},
+	{0x1D765, 8050, 8051},
+	{0x1D766, 8051, 8052},
+	{0x1D767, 8052, 8053},
+	{0x1D768, 8053, 8054},
+	{0x1D769, 8054, 8055},
+	{0x1D76A, 8055, 8056},
+	{0x1D76B, 8056, 8057},
+	{0x1D76C, 8057, 8058},
+	{0x1D76D, 8058, 8059},
+	{0x1D76E, 8059, 8060},
+	{0x1D76F, 8060, 8061},
+	{0x1D770, 8061, 8062},
+	{0x1D771, 8062, 8063},
+	{0x1D772, 8063, 8064},
+	{0x1D773, 8064, 8065},
+	{0x1D774, 8065, 8066},
+	{0x1D775, 8066, 8067},
+	{0x1D776, 8067, 8068},
+	{0x1D777, 8068, 8069},
+	{0x1D778, 8069, 8070},
+	{0x1D779, 8070, 8071},
+	{0x1D77A, 8071, 8072},
+	{0x1D77B, 8072, 8073},
+	{0x1D77C, 8073, 8074},
+	{0x1D77D, 8074, 8075},
+	{0x1D77E, 8075, 8076},
+	{0x1D77F, 8076, 8077},
+	{0x1D780, 8077, 8078},
+	{0x1D781, 8078, 8079},
+	{0x1D782, 8079, 8080},
+	{0x1D783, 8080, 8081},
+	{0x1D784, 8081, 8082},
+	{0x1D785, 8082, 8083},
+	{0x1D786, 8083, 8084},
+	{0x1D787, 8084, 8085},
+	{0x1D788, 8085, 8086},
+	{0x1D789, 8086, 8087},
+	{0x1D78A, 8087, 8088},
+	{0x1D78B, 8088, 8089},
+	{0x1D78C, 8089, 8090},
+	{0x1D78D, 8090, 8091},
+	{0x1D78E, 8091, 8092},
+	{0x1D78F, 8092, 8093},
+	{0x1D790, 8093, 8094},
+	{0x1D791, 8094, 8095},
+	{0x1D792, 8095, 8096},
+	{0x1D793, 8096, 8097},
+	{0x1D794, 8097, 8098},
+	{0x1D795, 8098, 8099},
+	{0x1D796, 8099, 8100},
+	{0x1D797, 8100, 8101},
+	{0x1D798, 8101, 8102},
+	{0x1D799, 8102, 8103},
+	{0x1D79A, 8103, 8104},
+	{0x1D79B, 8104, 8105},
+	{0x1D79C, 8105, 8106},
+	{0x1D79D, 8106, 8107},
+	{0x1D79E, 8107, 8108},
+	{0x1D79F, 8108, 8109},
+	{0x1D7A0, 8109, 8110},
+	{0x1D7A1, 8110, 8111},
+	{0x1D7A2, 8111, 8112},
+	{0x1D7A3, 8112, 8113},
+	{0x1D7A4, 8113, 8114},
+	{0x1D7A5, 8114, 8115},
+	{0x1D7A6, 8115, 8116},
+	{0x1D7A7, 8116, 8117},
+	{0x1D7A8, 8117, 8118},
+	{0x1D7A9, 8118, 8119},
+	{0x1D7AA, 8119, 8120},
+	{0x1D7AB, 8120, 8121},
+	{0x1D7AC, 8121, 8122},
+	{0x1D7AD, 8122, 8123},
+	{0x1D7AE, 8123, 8124},
+	{0x1D7AF, 8124, 8125},
+	{0x1D7B0, 8125, 8126},
+	{0x1D7B1, 8126, 8127},
+	{0x1D7B2, 8127, 8128},
+	{0x1D7B3, 8128, 8129},
+	{0x1D7B4, 8129, 8130},
+	{0x1D7B5, 8130, 8131},
+	{0x1D7B6, 8131, 8132},
+	{0x1D7B7, 8132, 8133},
+	{0x1D7B8, 8133, 8134},
+	{0x1D7B9, 8134, 8135},
+	{0x1D7BA, 8135, 8136},
+	{0x1D7BB, 8136, 8137},
+	{0x1D7BC, 8137, 8138},
+	{0x1D7BD, 8138, 8139},
+	{0x1D7BE, 8139, 8140},
+	{0x1D7BF, 8140, 8141},
+	{0x1D7C0, 8141, 8142},
+	{0x1D7C1, 8142, 8143},
+	{0x1D7C2, 8143, 8144},
+	{0x1D7C3, 8144, 8145},
+	{0x1D7C4, 8145, 8146},
+	{0x1D7C5, 8146, 8147},
+	{0x1D7C6, 8147, 8148},
+	{0x1D7C7, 8148, 8149},
+	{0x1D7C8, 8149, 8150},
+	{0x1D7C9, 8150, 8151},
+	{0x1D7CA, 8151, 8152},
+	{0x1D7CB, 8152, 8153},
+	{0x1D7CE, 8153, 8154},
+	{0x1D7CF, 8154, 8155},
+	{0x1D7D0, 8155, 8156},
+	{0x1D7D1, 8156, 8157},
+	{0x1D7D2, 8157, 8158},
+	{0x1D7D3, 8158, 8159},
+	{0x1D7D4, 8159, 8160},
+	{0x1D7D5, 8160, 8161},
+	{0x1D7D6, 8161, 8162},
+	{0x1D7D7, 8162, 8163},
+	{0x1D7D8, 8163, 8164},
+	{0x1D7D9, 8164, 8165},
+	{0x1D7DA, 8165, 8166},
+	{0x1D7DB, 8166, 8167},
+	{0x1D7DC, 8167, 8168},
+	{0x1D7DD, 8168, 8169},
+	{0x1D7DE, 8169, 8170},
+	{0x1D7DF, 8170, 8171},
+	{0x1D7E0, 8171, 8172},
+	{0x1D7E1, 8172, 8173},
+	{0x1D7E2, 8173, 8174},
+	{0x1D7E3, 8174, 8175},
+	{0x1D7E4, 8175, 8176},
+	{0x1D7E5, 8176, 8177},
+	{0x1D7E6, 8177, 8178},
+	{0x1D7E7, 8178, 8179},
+	{0x1D7E8, 8179, 8180},
+	{0x1D7E9, 8180, 8181},
+	{0x1D7EA, 8181, 8182},
+	{0x1D7EB, 8182, 8183},
+	{0x1D7EC, 8183, 8184},
+	{0x1D7ED, 8184, 8185},
+	{0x1D7EE, 8185, 8186},
+	{0x1D7EF, 8186, 8187},
+	{0x1D7F0, 8187, 8188},
+	{0x1D7F1, 8188, 8189},
+	{0x1D7F2, 8189, 8190},
+	{0x1D7F3, 8190, 8191},
+	{0x1D7F4, 8191, 8192},
+	{0x1D7F5, 8192, 8193},
+	{0x1D7F6, 8193, 8194},
+	{0x1D7F7, 8194, 8195},
+	{0x1D7F8, 8195, 8196},
+	{0x1D7F9, 8196, 8197},
+	{0x1D7FA, 8197, 8198},
+	{0x1D7FB, 8198, 8199},
+	{0x1D7FC, 8199, 8200},
+	{0x1D7FD, 8200, 8201},
+	{0x1D7FE, 8201, 8202},
+	{0x1D7FF, 8202, 8203},
+	{0x1E030, 8203, 8204},
+	{0x1E031, 8204, 8205},
+	{0x1E032, 8205, 8206},
+	{0x1E033, 8206, 8207},
+	{0x1E034, 8207, 8208},
+	{0x1E035, 8208, 8209},
+	{0x1E036, 8209, 8210},
+	{0x1E037, 8210, 8211},
+	{0x1E038, 8211, 8212},
+	{0x1E039, 8212, 8213},
+	{0x1E03A, 8213, 8214},
+	{0x1E03B, 8214, 8215},
+	{0x1E03C, 8215, 8216},
+	{0x1E03D, 8216, 8217},
+	{0x1E03E, 8217, 8218},
+	{0x1E03F, 8218, 8219},
+	{0x1E040, 8219, 8220},
+	{0x1E041, 8220, 8221},
+	{0x1E042, 8221, 8222},
+	{0x1E043, 8222, 8223},
+	{0x1E044, 8223, 8224},
+	{0x1E045, 8224, 8225},
+	{0x1E046, 8225, 8226},
+	{0x1E047, 8226, 8227},
+	{0x1E048, 8227, 8228},
+	{0x1E049, 8228, 8229},
+	{0x1E04A, 8229, 8230},
+	{0x1E04B, 8230, 8231},
+	{0x1E04C, 8231, 8232},
+	{0x1E04D, 8232, 8233},
+	{0x1E04E, 8233, 8234},
+	{0x1E04F, 8234, 8235},
+	{0x1E050, 8235, 8236},
+	{0x1E051, 8236, 8237},
+	{0x1E052, 8237, 8238},
+	{0x1E053, 8238, 8239},
+	{0x1E054, 8239, 8240},
+	{0x1E055, 8240, 8241},
+	{0x1E056, 8241, 8242},
+	{0x1E057, 8242, 8243},
+	{0x1E058, 8243, 8244},
+	{0x1E059, 8244, 8245},
+	{0x1E05A, 8245, 8246},
+	{0x1E05B, 8246, 8247},
+	{0x1E05C, 8247, 8248},
+	{0x1E05D, 8248, 8249},
+	{0x1E05E, 8249, 8250},
+	{0x1E05F, 8250, 8251},
+	{0x1E060, 8251, 8252},
+	{0x1E061, 8252, 8253},
+	{0x1E062, 8253, 8254},
+	{0x1E063, 8254, 8255},
+	{0x1E064, 8255, 8256},
+	{0x1E065, 8256, 8257},
+	{0x1E066, 8257, 8258},
+	{0x1E067, 8258, 8259},
+	{0x1E068, 8259, 8260},
+	{0x1E069, 8260, 8261},
+	{0x1E06A, 8261, 8262},
+	{0x1E06B, 8262, 8263},
+	{0x1E06C, 8263, 8264},
+	{0x1E06D, 8264, 8265},
+	{0x1EE00, 8265, 8266},
+	{0x1EE01, 8266, 8267},
+	{0x1EE02, 8267, 8268},
+	{0x1EE03, 8268, 8269},
+	{0x1EE05, 8269, 8270},
+	{0x1EE06, 8270, 8271},
+	{0x1EE07, 8271, 8272},
+	{0x1EE08, 8272, 8273},
+	{0x1EE09, 8273, 8274},
+	{0x1EE0A, 8274, 8275},
+	{0x1EE0B, 8275, 8276},
+	{0x1EE0C, 8276, 8277},
+	{0x1EE0D, 8277, 8278},
+	{0x1EE0E, 8278, 8279},
+	{0x1EE0F, 8279, 8280},
+	{0x1EE10, 8280, 8281},
+	{0x1EE11, 8281, 8282},
+	{0x1EE12, 8282, 8283},
+	{0x1EE13, 8283, 8284},
+	{0x1EE14, 8284, 8285},
+	{0x1EE15, 8285, 8286},
+	{0x1EE16, 8286, 8287},
+	{0x1EE17, 8287, 8288},
+	{0x1EE18, 8288, 8289},
+	{0x1EE19, 8289, 8290},
+	{0x1EE1A, 8290, 8291},
+	{0x1EE1B, 8291, 8292},
+	{0x1EE1C, 8292, 8293},
+	{0x1EE1D, 8293, 8294},
+	{0x1EE1E, 8294, 8295},
+	{0x1EE1F, 8295, 8296},
+	{0x1EE21, 8296, 8297},
+	{0x1EE22, 8297, 8298},
+	{0x1EE24, 8298, 8299},
+	{0x1EE27, 8299, 8300},
+	{0x1EE29, 8300, 8301},
+	{0x1EE2A, 8301, 8302},
+	{0x1EE2B, 8302, 8303},
+	{0x1EE2C, 8303, 8304},
+	{0x1EE2D, 8304, 8305},
+	{0x1EE2E, 8305, 8306},
+	{0x1EE2F, 8306, 8307},
+	{0x1EE30, 8307, 8308},
+	{0x1EE31, 8308, 8309},
+	{0x1EE32, 8309, 8310},
+	{0x1EE34, 8310, 8311},
+	{0x1EE35, 8311, 8312},
+	{0x1EE36, 8312, 8313},
+	{0x1EE37, 8313, 8314},
+	{0x1EE39, 8314, 8315},
+	{0x1EE3B, 8315, 8316},
+	{0x1EE42, 8316, 8317},
+	{0x1EE47, 8317, 8318},
+	{0x1EE49, 8318, 8319},
+	{0x1EE4B, 8319, 8320},
+	{0x1EE4D, 8320, 8321},
+	{0x1EE4E, 8321, 8322},
+	{0x1EE4F, 8322, 8323},
+	{0x1EE51, 8323, 8324},
+	{0x1EE52, 8324, 8325},
+	{0x1EE54, 8325, 8326},
+	{0x1EE57, 8326, 8327},
+	{0x1EE59, 8327, 8328},
+	{0x1EE5B, 8328, 8329},
+	{0x1EE5D, 8329, 8330},
+	{0x1EE5F, 8330, 8331},
+	{0x1EE61, 8331, 8332},
+	{0x1EE62, 8332, 8333},
+	{0x1EE64, 8333, 8334},
+	{0x1EE67, 8334, 8335},
+	{0x1EE68, 8335, 8336},
+	{0x1EE69, 8336, 8337},
+	{0x1EE6A, 8337, 8338},
+	{0x1EE6C, 8338, 8339},
+	{0x1EE6D, 8339, 8340},
+	{0x1EE6E, 8340, 8341},
+	{0x1EE6F, 8341, 8342},
+	{0x1EE70, 8342, 8343},
+	{0x1EE71, 8343, 8344},
+	{0x1EE72, 8344, 8345},
+	{0x1EE74, 8345, 8346},
+	{0x1EE75, 8346, 8347},
+	{0x1EE76, 8347, 8348},
+	{0x1EE77, 8348, 8349},
+	{0x1EE79, 8349, 8350},
+	{0x1EE7A, 8350, 8351},
+	{0x1EE7B, 8351, 8352},
+	{0x1EE7C, 8352, 8353},
+	{0x1EE7E, 8353, 8354},
+	{0x1EE80, 8354, 8355},
+	{0x1EE81, 8355, 8356},
+	{0x1EE82, 8356, 8357},
+	{0x1EE83, 8357, 8358},
+	{0x1EE84, 8358, 8359},
+	{0x1EE85, 8359, 8360},
+	{0x1EE86, 8360, 8361},
+	{0x1EE87, 8361, 8362},
+	{0x1EE88, 8362, 8363},
+	{0x1EE89, 8363, 8364},
+	{0x1EE8B, 8364, 8365},
+	{0x1EE8C, 8365, 8366},
+	{0x1EE8D, 8366, 8367},
+	{0x1EE8E, 8367, 8368},
+	{0x1EE8F, 8368, 8369},
+	{0x1EE90, 8369, 8370},
+	{0x1EE91, 8370, 8371},
+	{0x1EE92, 8371, 8372},
+	{0x1EE93, 8372, 8373},
+	{0x1EE94, 8373, 8374},
+	{0x1EE95, 8374, 8375},
+	{0x1EE96, 8375, 8376},
+	{0x1EE97, 8376, 8377},
+	{0x1EE98, 8377, 8378},
+	{0x1EE99, 8378, 8379},
+	{0x1EE9A, 8379, 8380},
+	{0x1EE9B, 8380, 8381},
+	{0x1EEA1, 8381, 8382},
+	{0x1EEA2, 8382, 8383},
+	{0x1EEA3, 8383, 8384},
+	{0x1EEA5, 8384, 8385},
+	{0x1EEA6, 8385, 8386},
+	{0x1EEA7, 8386, 8387},
+	{0x1EEA8, 8387, 8388},
+	{0x1EEA9, 8388, 8389},
+	{0x1EEAB, 8389, 8390},
+	{0x1EEAC, 8390, 8391},
+	{0x1EEAD, 8391, 8392},
+	{0x1EEAE, 8392, 8393},
+	{0x1EEAF, 8393, 8394},
+	{0x1EEB0, 8394, 8395},
+	{0x1EEB1, 8395, 8396},
+	{0x1EEB2, 8396, 8397},
+	{0x1EEB3, 8397, 8398},
+	{0x1EEB4, 8398, 8399},
+	{0x1EEB5, 8399, 8400},
+	{0x1EEB6, 8400, 8401},
+	{0x1EEB7, 8401, 8402},
+	{0x1EEB8, 8402, 8403},
+	{0x1EEB9, 8403, 8404},
+	{0x1EEBA, 8404, 8405},
+	{0x1EEBB, 8405, 8406},
+	{0x1F100, 8406, 8408},
+	{0x1F101, 8408, 8410},
+	{0x1F102, 8410, 8412},
+	{0x1F103, 8412, 8414},
+	{0x1F104, 8414, 8416},
+	{0x1F105, 8416, 8418},
+	{0x1F106, 8418, 8420},
+	{0x1F107, 8420, 8422},
+	{0x1F108, 8422, 8424},
+	{0x1F109, 8424, 8426},
+	{0x1F10A, 8426, 8428},
+	{0x1F110, 8428, 8431},
+	{0x1F111, 8431, 8434},
+	{0x1F112, 8434, 8437},
+	{0x1F113, 8437, 8440},
+	{0x1F114, 8440, 8443},
+	{0x1F115, 8443, 8446},
+	{0x1F116, 8446, 8449},
+	{0x1F117, 8449, 8452},
+	{0x1F118, 8452, 8455},
+	{0x1F119, 8455, 8458},
+	{0x1F11A, 8458, 8461},
+	{0x1F11B, 8461, 8464},
+	{0x1F11C, 8464, 8467},
+	{0x1F11D, 8467, 8470},
+	{0x1F11E, 8470, 8473},
+	{0x1F11F, 8473, 8476},
+	{0x1F120, 8476, 8479},
+	{0x1F121, 8479, 8482},
+	{0x1F122, 8482, 8485},
+	{0x1F123, 8485, 8488},
+	{0x1F124, 8488, 8491},
+	{0x1F125, 8491, 8494},
+	{0x1F126, 8494, 8497},
+	{0x1F127, 8497, 8500},
+	{0x1F128, 8500, 8503},
+	{0x1F129, 8503, 8506},
+	{0x1F12A, 8506, 8509},
+	{0x1F12B, 8509, 8510},
+	{0x1F12C, 8510, 8511},
+	{0x1F12D, 8511, 8513},
+	{0x1F12E, 8513, 8515},
+	{0x1F130, 8515, 8516},
+	{0x1F131, 8516, 8517},
+	{0x1F132, 8517, 8518},
+	{0x1F133, 8518, 8519},
+	{0x1F134, 8519, 8520},
+	{0x1F135, 8520, 8521},
+	{0x1F136, 8521, 8522},
+	{0x1F137, 8522, 8523},
+	{0x1F138, 8523, 8524},
+	{0x1F139, 8524, 8525},
+	{0x1F13A, 8525, 8526},
+	{0x1F13B, 8526, 8527},
+	{0x1F13C, 8527, 8528},
+	{0x1F13D, 8528, 8529},
+	{0x1F13E, 8529, 8530},
+	{0x1F13F, 8530, 8531},
+	{0x1F140, 8531, 8532},
+	{0x1F141, 8532, 8533},
+	{0x1F142, 8533, 8534},
+	{0x1F143, 8534, 8535},
+	{0x1F144, 8535, 8536},
+	{0x1F145, 8536, 8537},
+	{0x1F146, 8537, 8538},
+	{0x1F147, 8538, 8539},
+	{0x1F148, 8539, 8540},
+	{0x1F149, 8540, 8541},
+	{0x1F14A, 8541, 8543},
+	{0x1F14B, 8543, 8545},
+	{0x1F14C, 8545, 8547},
+	{0x1F14D, 8547, 8549},
+	{0x1F14E, 8549, 8552},
+	{0x1F14F, 8552, 8554},
+	{0x1F16A, 8554, 8556},
+	{0x1F16B, 8556, 8558},
+	{0x1F16C, 8558, 8560},
+	{0x1F190, 8560, 8562},
+	{0x1F200, 8562, 8564},
+	{0x1F201, 8564, 8566},
+	{0x1F202, 8566, 8567},
+	{0x1F210, 8567, 8568},
+	{0x1F211, 8568, 8569},
+	{0x1F212, 8569, 8570},
+	{0x1F213, 8570, 8572},
+	{0x1F214, 8572, 8573},
+	{0x1F215, 8573, 8574},
+	{0x1F216, 8574, 8575},
+	{0x1F217, 8575, 8576},
+	{0x1F218, 8576, 8577},
+	{0x1F219, 8577, 8578},
+	{0x1F21A, 8578, 8579},
+	{0x1F21B, 8579, 8580},
+	{0x1F21C, 8580, 8581},
+	{0x1F21D, 8581, 8582},
+	{0x1F21E, 8582, 8583},
+	{0x1F21F, 8583, 8584},
+	{0x1F220, 8584, 8585},
+	{0x1F221, 8585, 8586},
+	{0x1F222, 8586, 8587},
+	{0x1F223, 8587, 8588},
+	{0x1F224, 8588, 8589},
+	{0x1F225, 8589, 8590},
+	{0x1F226, 8590, 8591},
+	{0x1F227, 8591, 8592},
+	{0x1F228, 8592, 8593},
+	{0x1F229, 8593, 8594},
+	{0x1F22A, 8594, 8595},
+	{0x1F22B, 8595, 8596},
+	{0x1F22C, 8596, 8597},
+	{0x1F22D, 8597, 8598},
+	{0x1F22E, 8598, 8599},
+	{0x1F22F, 8599, 8600},
+	{0x1F230, 8600, 8601},
+	{0x1F231, 8601, 8602},
+	{0x1F232, 8602, 8603},
+	{0x1F233, 8603, 8604},
+	{0x1F234, 8604, 8605},
+	{0x1F235, 8605, 8606},
+	{0x1F236, 8606, 8607},
+	{0x1F237, 8607, 8608},
+	{0x1F238, 8608, 8609},
+	{0x1F239, 8609, 8610},
+	{0x1F23A, 8610, 8611},
+	{0x1F23B, 8611, 8612},
+	{0x1F240, 8612, 8615},
+	{0x1F241, 8615, 8618},
+	{0x1F242, 8618, 8621},
+	{0x1F243, 8621, 8624},
+	{0x1F244, 8624, 8627},
+	{0x1F245, 8627, 8630},
+	{0x1F246, 8630, 8633},
+	{0x1F247, 8633, 8636},
+	{0x1F248, 8636, 8639},
+	{0x1F250, 8639, 8640},
+	{0x1F251, 8640, 8641},
+	{0x1FBF0, 8641, 8642},
+	{0x1FBF1, 8642, 8643},
+	{0x1FBF2, 8643, 8644},
+	{0x1FBF3, 8644, 8645},
+	{0x1FBF4, 8645, 8646},
+	{0x1FBF5, 8646, 8647},
+	{0x1FBF6, 8647, 8648},
+	{0x1FBF7, 8648, 8649},
+	{0x1FBF8, 8649, 8650},
+	{0x1FBF9, 8650, 8651},
+	{0x2F800, 8651, 8652},
+	{0x2F801, 8652, 8653},
+	{0x2F802, 8653, 8654},
+	{0x2F803, 8654, 8655},
+	{0x2F804, 8655, 8656},
+	{0x2F805, 8656, 8657},
+	{0x2F806, 8657, 8658},
+	{0x2F807, 8658, 8659},
+	{0x2F808, 8659, 8660},
+	{0x2F809, 8660, 8661},
+	{0x2F80A, 8661, 8662},
+	{0x2F80B, 8662, 8663},
+	{0x2F80C, 8663, 8664},
+	{0x2F80D, 8664, 8665},
+	{0x2F80E, 8665, 8666},
+	{0x2F80F, 8666, 8667},
+	{0x2F810, 8667, 8668},
+	{0x2F811, 8668, 8669},
+	{0x2F812, 8669, 8670},
+	{0x2F813, 8670, 8671},
+	{0x2F814, 8671, 8672},
+	{0x2F815, 8672, 8673},
+	{0x2F816, 8673, 8674},
+	{0x2F817, 8674, 8675},
+	{0x2F818, 8675, 8676},
+	{0x2F819, 8676, 8677},
+	{0x2F81A, 8677, 8678},
+	{0x2F81B, 8678, 8679},
+	{0x2F81C, 8679, 8680},
+	{0x2F81D, 8680, 8681},
+	{0x2F81E, 8681, 8682},
+	{0x2F81F, 8682, 8683},
+	{0x2F820, 8683, 8684},
+	{0x2F821, 8684, 8685},
+	{0x2F822, 8685, 8686},
+	{0x2F823, 8686, 8687},
+	{0x2F824, 8687, 8688},
+	{0x2F825, 8688, 8689},
+	{0x2F826, 8689, 8690},
+	{0x2F827, 8690, 8691},
+	{0x2F828, 8691, 8692},
+	{0x2F829, 8692, 8693},
+	{0x2F82A, 8693, 8694},
+	{0x2F82B, 8694, 8695},
+	{0x2F82C, 8695, 8696},
+	{0x2F82D, 8696, 8697},
+	{0x2F82E, 8697, 8698},
+	{0x2F82F, 8698, 8699},
+	{0x2F830, 8699, 8700},
+	{0x2F831, 8700, 8701},
+	{0x2F832, 8701, 8702},
+	{0x2F833, 8702, 8703},
+	{0x2F834, 8703, 8704},
+	{0x2F835, 8704, 8705},
+	{0x2F836, 8705, 8706},
+	{0x2F837, 8706, 8707},
+	{0x2F838, 8707, 8708},
+	{0x2F839, 8708, 8709},
+	{0x2F83A, 8709, 8710},
+	{0x2F83B, 8710, 8711},
+	{0x2F83C, 8711, 8712},
+	{0x2F83D, 8712, 8713},
+	{0x2F83E, 8713, 8714},
+	{0x2F83F, 8714, 8715},
+	{0x2F840, 8715, 8716},
+	{0x2F841, 8716, 8717},
+	{0x2F842, 8717, 8718},
+	{0x2F843, 8718, 8719},
+	{0x2F844, 8719, 8720},
+	{0x2F845, 8720, 8721},
+	{0x2F846, 8721, 8722},
+	{0x2F847, 8722, 8723},
+	{0x2F848, 8723, 8724},
+	{0x2F849, 8724, 8725},
+	{0x2F84A, 8725, 8726},
+	{0x2F84B, 8726, 8727},
+	{0x2F84C, 8727, 8728},
+	{0x2F84D, 8728, 8729},
+	{0x2F84E, 8729, 8730},
+	{0x2F84F, 8730, 8731},
+	{0x2F850, 8731, 8732},
+	{0x2F851, 8732, 8733},
+	{0x2F852, 8733, 8734},
+	{0x2F853, 8734, 8735},
+	{0x2F854, 8735, 8736},
+	{0x2F855, 8736, 8737},
+	{0x2F856, 8737, 8738},
+	{0x2F857, 8738, 8739},
+	{0x2F858, 8739, 8740},
+	{0x2F859, 8740, 8741},
+	{0x2F85A, 8741, 8742},
+	{0x2F85B, 8742, 8743},
+	{0x2F85C, 8743, 8744},
+	{0x2F85D, 8744, 8745},
+	{0x2F85E, 8745, 8746},
+	{0x2F85F, 8746, 8747},
+	{0x2F860, 8747, 8748},
+	{0x2F861, 8748, 8749},
+	{0x2F862, 8749, 8750},
+	{0x2F863, 8750, 8751},
+	{0x2F864, 8751, 8752},
+	{0x2F865, 8752, 8753},
+	{0x2F866, 8753, 8754},
+	{0x2F867, 8754, 8755},
+	{0x2F868, 8755, 8756},
+	{0x2F869, 8756, 8757},
+	{0x2F86A, 8757, 8758},
+	{0x2F86B, 8758, 8759},
+	{0x2F86C, 8759, 8760},
+	{0x2F86D, 8760, 8761},
+	{0x2F86E, 8761, 8762},
+	{0x2F86F, 8762, 8763},
+	{0x2F870, 8763, 8764},
+	{0x2F871, 8764, 8765},
+	{0x2F872, 8765, 8766},
+	{0x2F873, 8766, 8767},
+	{0x2F874, 8767, 8768},
+	{0x2F875, 8768, 8769},
+	{0x2F876, 8769, 8770},
+	{0x2F877, 8770, 8771},
+	{0x2F878, 8771, 8772},
+	{0x2F879, 8772, 8773},
+	{0x2F87A, 8773, 8774},
+	{0x2F87B, 8774, 8775},
+	{0x2F87C, 8775, 8776},
+	{0x2F87D, 8776, 8777},
+	{0x2F87E, 8777, 8778},
+	{0x2F87F, 8778, 8779},
+	{0x2F880, 8779, 8780},
+	{0x2F881, 8780, 8781},
+	{0x2F882, 8781, 8782},
+	{0x2F883, 8782, 8783},
+	{0x2F884, 8783, 8784},
+	{0x2F885, 8784, 8785},
+	{0x2F886, 8785, 8786},
+	{0x2F887, 8786, 8787},
+	{0x2F888, 8787, 8788},
+	{0x2F889, 8788, 8789},
+	{0x2F88A, 8789, 8790},
+	{0x2F88B, 8790, 8791},
+	{0x2F88C, 8791, 8792},
+	{0x2F88D, 8792, 8793},
+	{0x2F88E, 8793, 8794},
+	{0x2F88F, 8794, 8795},
+	{0x2F890, 8795, 8796},
+	{0x2F891, 8796, 8797},
+	{0x2F892, 8797, 8798},
+	{0x2F893, 8798, 8799},
+	{0x2F894, 8799, 8800},
+	{0x2F895, 8800, 8801},
+	{0x2F896, 8801, 8802},
+	{0x2F897, 8802, 8803},
+	{0x2F898, 8803, 8804},
+	{0x2F899, 8804, 8805},
+	{0x2F89A, 8805, 8806},
+	{0x2F89B, 8806, 8807},
+	{0x2F89C, 8807, 8808},
+	{0x2F89D, 8808, 8809},
+	{0x2F89E, 8809, 8810},
+	{0x2F89F, 8810, 8811},
+	{0x2F8A0, 8811, 8812},
+	{0x2F8A1, 8812, 8813},
+	{0x2F8A2, 8813, 8814},
+	{0x2F8A3, 8814, 8815},
+	{0x2F8A4, 8815, 8816},
+	{0x2F8A5, 8816, 8817},
+	{0x2F8A6, 8817, 8818},
+	{0x2F8A7, 8818, 8819},
+	{0x2F8A8, 8819, 8820},
+	{0x2F8A9, 8820, 8821},
+	{0x2F8AA, 8821, 8822},
+	{0x2F8AB, 8822, 8823},
+	{0x2F8AC, 8823, 8824},
+	{0x2F8AD, 8824, 8825},
+	{0x2F8AE, 8825, 8826},
+	{0x2F8AF, 8826, 8827},
+	{0x2F8B0, 8827, 8828},
+	{0x2F8B1, 8828, 8829},
+	{0x2F8B2, 8829, 8830},
+	{0x2F8B3, 8830, 8831},
+	{0x2F8B4, 8831, 8832},
+	{0x2F8B5, 8832, 8833},
+	{0x2F8B6, 8833, 8834},
+	{0x2F8B7, 8834, 8835},
+	{0x2F8B8, 8835, 8836},
+	{0x2F8B9, 8836, 8837},
+	{0x2F8BA, 8837, 8838},
+	{0x2F8BB, 8838, 8839},
+	{0x2F8BC, 8839, 8840},
+	{0x2F8BD, 8840, 8841},
+	{0x2F8BE, 8841, 8842},
+	{0x2F8BF, 8842, 8843},
+	{0x2F8C0, 8843, 8844},
+	{0x2F8C1, 8844, 8845},
+	{0x2F8C2, 8845, 8846},
+	{0x2F8C3, 8846, 8847},
+	{0x2F8C4, 8847, 8848},
+	{0x2F8C5, 8848, 8849},
+	{0x2F8C6, 8849, 8850},
+	{0x2F8C7, 8850, 8851},
+	{0x2F8C8, 8851, 8852},
+	{0x2F8C9, 8852, 8853},
+	{0x2F8CA, 8853, 8854},
+	{0x2F8CB, 8854, 8855},
+	{0x2F8CC, 8855, 8856},
+	{0x2F8CD, 8856, 8857},
+	{0x2F8CE, 8857, 8858},
+	{0x2F8CF, 8858, 8859},
+	{0x2F8D0, 8859, 8860},
+	{0x2F8D1, 8860, 8861},
+	{0x2F8D2, 8861, 8862},
+	{0x2F8D3, 8862, 8863},
+	{0x2F8D4, 8863, 8864},
+	{0x2F8D5, 8864, 8865},
+	{0x2F8D6, 8865, 8866},
+	{0x2F8D7, 8866, 8867},
+	{0x2F8D8, 8867, 8868},
+	{0x2F8D9, 8868, 8869},
+	{0x2F8DA, 8869, 8870},
+	{0x2F8DB, 8870, 8871},
+	{0x2F8DC, 8871, 8872},
+	{0x2F8DD, 8872, 8873},
+	{0x2F8DE, 8873, 8874},
+	{0x2F8DF, 8874, 8875},
+	{0x2F8E0, 8875, 8876},
+	{0x2F8E1, 8876, 8877},
+	{0x2F8E2, 8877, 8878},
+	{0x2F8E3, 8878, 8879},
+	{0x2F8E4, 8879, 8880},
+	{0x2F8E5, 8880, 8881},
+	{0x2F8E6, 8881, 8882},
+	{0x2F8E7, 8882, 8883},
+	{0x2F8E8, 8883, 8884},
+	{0x2F8E9, 8884, 8885},
+	{0x2F8EA, 8885, 8886},
+	{0x2F8EB, 8886, 8887},
+	{0x2F8EC, 8887, 8888},
+	{0x2F8ED, 8888, 8889},
+	{0x2F8EE, 8889, 8890},
+	{0x2F8EF, 8890, 8891},
+	{0x2F8F0, 8891, 8892},
+	{0x2F8F1, 8892, 8893},
+	{0x2F8F2, 8893, 8894},
+	{0x2F8F3, 8894, 8895},
+	{0x2F8F4, 8895, 8896},
+	{0x2F8F5, 8896, 8897},
+	{0x2F8F6, 8897, 8898},
+	{0x2F8F7, 8898, 8899},
+	{0x2F8F8, 8899, 8900},
+	{0x2F8F9, 8900, 8901},
+	{0x2F8FA, 8901, 8902},
+	{0x2F8FB, 8902, 8903},
+	{0x2F8FC, 8903, 8904},
+	{0x2F8FD, 8904, 8905},
+	{0x2F8FE, 8905, 8906},
+	{0x2F8FF, 8906, 8907},
+	{0x2F900, 8907, 8908},
+	{0x2F901, 8908, 8909},
+	{0x2F902, 8909, 8910},
+	{0x2F903, 8910, 8911},
+	{0x2F904, 8911, 8912},
+	{0x2F905, 8912, 8913},
+	{0x2F906, 8913, 8914},
+	{0x2F907, 8914, 8915},
+	{0x2F908, 8915, 8916},
+	{0x2F909, 8916, 8917},
+	{0x2F90A, 8917, 8918},
+	{0x2F90B, 8918, 8919},
+	{0x2F90C, 8919, 8920},
+	{0x2F90D, 8920, 8921},
+	{0x2F90E, 8921, 8922},
+	{0x2F90F, 8922, 8923},
+	{0x2F910, 8923, 8924},
+	{0x2F911, 8924, 8925},
+	{0x2F912, 8925, 8926},
+	{0x2F913, 8926, 8927},
+	{0x2F914, 8927, 8928},
+	{0x2F915, 8928, 8929},
+	{0x2F916, 8929, 8930},
+	{0x2F917, 8930, 8931},
+	{0x2F918, 8931, 8932},
+	{0x2F919, 8932, 8933},
+	{0x2F91A, 8933, 8934},
+	{0x2F91B, 8934, 8935},
+	{0x2F91C, 8935, 8936},
+	{0x2F91D, 8936, 8937},
+	{0x2F91E, 8937, 8938},
+	{0x2F91F, 8938, 8939},
+	{0x2F920, 8939, 8940},
+	{0x2F921, 8940, 8941},
+	{0x2F922, 8941, 8942},
+	{0x2F923, 8942, 8943},
+	{0x2F924, 8943, 8944},
+	{0x2F925, 8944, 8945},
+	{0x2F926, 8945, 8946},
+	{0x2F927, 8946, 8947},
+	{0x2F928, 8947, 8948},
+	{0x2F929, 8948, 8949},
+	{0x2F92A, 8949, 8950},
+	{0x2F92B, 8950, 8951},
+	{0x2F92C, 8951, 8952},
+	{0x2F92D, 8952, 8953},
+	{0x2F92E, 8953, 8954},
+	{0x2F92F, 8954, 8955},
+	{0x2F930, 8955, 8956},
+	{0x2F931, 8956, 8957},
+	{0x2F932, 8957, 8958},
+	{0x2F933, 8958, 8959},
+	{0x2F934, 8959, 8960},
+	{0x2F935, 8960, 8961},
+	{0x2F936, 8961, 8962},
+	{0x2F937, 8962, 8963},
+	{0x2F938, 8963, 8964},
+	{0x2F939, 8964, 8965},
+	{0x2F93A, 8965, 8966},
+	{0x2F93B, 8966, 8967},
+	{0x2F93C, 8967, 8968},
+	{0x2F93D, 8968, 8969},
+	{0x2F93E, 8969, 8970},
+	{0x2F93F, 8970, 8971},
+	{0x2F940, 8971, 8972},
+	{0x2F941, 8972, 8973},
+	{0x2F942, 8973, 8974},
+	{0x2F943, 8974, 8975},
+	{0x2F944, 8975, 8976},
+	{0x2F945, 8976, 8977},
+	{0x2F946, 8977, 8978},
+	{0x2F947, 8978, 8979},
+	{0x2F948, 8979, 8980},
+	{0x2F949, 8980, 8981},
+	{0x2F94A, 8981, 8982},
+	{0x2F94B, 8982, 8983},
+	{0x2F94C, 8983, 8984},
+	{0x2F94D, 8984, 8985},
+	{0x2F94E, 8985, 8986},
+	{0x2F94F, 8986, 8987},
+	{0x2F950, 8987, 8988},
+	{0x2F951, 8988, 8989},
+	{0x2F952, 8989, 8990},
+	{0x2F953, 8990, 8991},
+	{0x2F954, 8991, 8992},
+	{0x2F955, 8992, 8993},
+	{0x2F956, 8993, 8994},
+	{0x2F957, 8994, 8995},
+	{0x2F958, 8995, 8996},
+	{0x2F959, 8996, 8997},
+	{0x2F95A, 8997, 8998},
+	{0x2F95B, 8998, 8999},
+	{0x2F95C, 8999, 9000},
+	{0x2F95D, 9000, 9001},
+	{0x2F95E, 9001, 9002},
+	{0x2F95F, 9002, 9003},
+	{0x2F960, 9003, 9004},
+	{0x2F961, 9004, 9005},
+	{0x2F962, 9005, 9006},
+	{0x2F963, 9006, 9007},
+	{0x2F964, 9007, 9008},
+	{0x2F965, 9008, 9009},
+	{0x2F966, 9009, 9010},
+	{0x2F967, 9010, 9011},
+	{0x2F968, 9011, 9012},
+	{0x2F969, 9012, 9013},
+	{0x2F96A, 9013, 9014},
+	{0x2F96B, 9014, 9015},
+	{0x2F96C, 9015, 9016},
+	{0x2F96D, 9016, 9017},
+	{0x2F96E, 9017, 9018},
+	{0x2F96F, 9018, 9019},
+	{0x2F970, 9019, 9020},
+	{0x2F971, 9020, 9021},
+	{0x2F972, 9021, 9022},
+	{0x2F973, 9022, 9023},
+	{0x2F974, 9023, 9024},
+	{0x2F975, 9024, 9025},
+	{0x2F976, 9025, 9026},
+	{0x2F977, 9026, 9027},
+	{0x2F978, 9027, 9028},
+	{0x2F979, 9028, 9029},
+	{0x2F97A, 9029, 9030},
+	{0x2F97B, 9030, 9031},
+	{0x2F97C, 9031, 9032},
+	{0x2F97D, 9032, 9033},
+	{0x2F97E, 9033, 9034},
+	{0x2F97F, 9034, 9035},
+	{0x2F980, 9035, 9036},
+	{0x2F981, 9036, 9037},
+	{0x2F982, 9037, 9038},
+	{0x2F983, 9038, 9039},
+	{0x2F984, 9039, 9040},
+	{0x2F985, 9040, 9041},
+	{0x2F986, 9041, 9042},
+	{0x2F987, 9042, 9043},
+	{0x2F988, 9043, 9044},
+	{0x2F989, 9044, 9045},
+	{0x2F98A, 9045, 9046},
+	{0x2F98B, 9046, 9047},
+	{0x2F98C, 9047, 9048},
+	{0x2F98D, 9048, 9049},
+	{0x2F98E, 9049, 9050},
+	{0x2F98F, 9050, 9051},
+	{0x2F990, 9051, 9052},
+	{0x2F991, 9052, 9053},
+	{0x2F992, 9053, 9054},
+	{0x2F993, 9054, 9055},
+	{0x2F994, 9055, 9056},
+	{0x2F995, 9056, 9057},
+	{0x2F996, 9057, 9058},
+	{0x2F997, 9058, 9059},
+	{0x2F998, 9059, 9060},
+	{0x2F999, 9060, 9061},
+	{0x2F99A, 9061, 9062},
+	{0x2F99B, 9062, 9063},
+	{0x2F99C, 9063, 9064},
+	{0x2F99D, 9064, 9065},
+	{0x2F99E, 9065, 9066},
+	{0x2F99F, 9066, 9067},
+	{0x2F9A0, 9067, 9068},
+	{0x2F9A1, 9068, 9069},
+	{0x2F9A2, 9069, 9070},
+	{0x2F9A3, 9070, 9071},
+	{0x2F9A4, 9071, 9072},
+	{0x2F9A5, 9072, 9073},
+	{0x2F9A6, 9073, 9074},
+	{0x2F9A7, 9074, 9075},
+	{0x2F9A8, 9075, 9076},
+	{0x2F9A9, 9076, 9077},
+	{0x2F9AA, 9077, 9078},
+	{0x2F9AB, 9078, 9079},
+	{0x2F9AC, 9079, 9080},
+	{0x2F9AD, 9080, 9081},
+	{0x2F9AE, 9081, 9082},
+	{0x2F9AF, 9082, 9083},
+	{0x2F9B0, 9083, 9084},
+	{0x2F9B1, 9084, 9085},
+	{0x2F9B2, 9085, 9086},
+	{0x2F9B3, 9086, 9087},
+	{0x2F9B4, 9087, 9088},
+	{0x2F9B5, 9088, 9089},
+	{0x2F9B6, 9089, 9090},
+	{0x2F9B7, 9090, 9091},
+	{0x2F9B8, 9091, 9092},
+	{0x2F9B9, 9092, 9093},
+	{0x2F9BA, 9093, 9094},
+	{0x2F9BB, 9094, 9095},
+	{0x2F9BC, 9095, 9096},
+	{0x2F9BD, 9096, 9097},
+	{0x2F9BE, 9097, 9098},
+	{0x2F9BF, 9098, 9099},
+	{0x2F9C0, 9099, 9100},
+	{0x2F9C1, 9100, 9101},
+	{0x2F9C2, 9101, 9102},
+	{0x2F9C3, 9102, 9103},
+	{0x2F9C4, 9103, 9104},
+	{0x2F9C5, 9104, 9105},
+	{0x2F9C6, 9105, 9106},
+	{0x2F9C7, 9106, 9107},
+	{0x2F9C8, 9107, 9108},
+	{0x2F9C9, 9108, 9109},
+	{0x2F9CA, 9109, 9110},
+	{0x2F9CB, 9110, 9111},
+	{0x2F9CC, 9111, 9112},
+	{0x2F9CD, 9112, 9113},
+	{0x2F9CE, 9113, 9114},
+	{0x2F9CF, 9114, 9115},
+	{0x2F9D0, 9115, 9116},
+	{0x2F9D1, 9116, 9117},
+	{0x2F9D2, 9117, 9118},
+	{0x2F9D3, 9118, 9119},
+	{0x2F9D4, 9119, 9120},
+	{0x2F9D5, 9120, 9121},
+	{0x2F9D6, 9121, 9122},
+	{0x2F9D7, 9122, 9123},
+	{0x2F9D8, 9123, 9124},
+	{0x2F9D9, 9124, 9125},
+	{0x2F9DA, 9125, 9126},
+	{0x2F9DB, 9126, 9127},
+	{0x2F9DC, 9127, 9128},
+	{0x2F9DD, 9128, 9129},
+	{0x2F9DE, 9129, 9130},
+	{0x2F9DF, 9130, 9131},
+	{0x2F9E0, 9131, 9132},
+	{0x2F9E1, 9132, 9133},
+	{0x2F9E2, 9133, 9134},
+	{0x2F9E3, 9134, 9135},
+	{0x2F9E4, 9135, 9136},
+	{0x2F9E5, 9136, 9137},
+	{0x2F9E6, 9137, 9138},
+	{0x2F9E7, 9138, 9139},
+	{0x2F9E8, 9139, 9140},
+	{0x2F9E9, 9140, 9141},
+	{0x2F9EA, 9141, 9142},
+	{0x2F9EB, 9142, 9143},
+	{0x2F9EC, 9143, 9144},
+	{0x2F9ED, 9144, 9145},
+	{0x2F9EE, 9145, 9146},
+	{0x2F9EF, 9146, 9147},
+	{0x2F9F0, 9147, 9148},
+	{0x2F9F1, 9148, 9149},
+	{0x2F9F2, 9149, 9150},
+	{0x2F9F3, 9150, 9151},
+	{0x2F9F4, 9151, 9152},
+	{0x2F9F5, 9152, 9153},
+	{0x2F9F6, 9153, 9154},
+	{0x2F9F7, 9154, 9155},
+	{0x2F9F8, 9155, 9156},
+	{0x2F9F9, 9156, 9157},
+	{0x2F9FA, 9157, 9158},
+	{0x2F9FB, 9158, 9159},
+	{0x2F9FC, 9159, 9160},
+	{0x2F9FD, 9160, 9161},
+	{0x2F9FE, 9161, 9162},
+	{0x2F9FF, 9162, 9163},
+	{0x2FA00, 9163, 9164},
+	{0x2FA01, 9164, 9165},
+	{0x2FA02, 9165, 9166},
+	{0x2FA03, 9166, 9167},
+	{0x2FA04, 9167, 9168},
+	{0x2FA05, 9168, 9169},
+	{0x2FA06, 9169, 9170},
+	{0x2FA07, 9170, 9171},
+	{0x2FA08, 9171, 9172},
+	{0x2FA09, 9172, 9173},
+	{0x2FA0A, 9173, 9174},
+	{0x2FA0B, 9174, 9175},
+	{0x2FA0C, 9175, 9176},
+	{0x2FA0D, 9176, 9177},
+	{0x2FA0E, 9177, 9178},
+	{0x2FA0F, 9178, 9179},
+	{0x2FA10, 9179, 9180},
+	{0x2FA11, 9180, 9181},
+	{0x2FA12, 9181, 9182},
+	{0x2FA13, 9182, 9183},
+	{0x2FA14, 9183, 9184},
+	{0x2FA15, 9184, 9185},
+	{0x2FA16, 9185, 9186},
+	{0x2FA17, 9186, 9187},
+	{0x2FA18, 9187, 9188},
+	{0x2FA19, 9188, 9189},
+	{0x2FA1A, 9189, 9190},
+	{0x2FA1B, 9190, 9191},
+	{0x2FA1C, 9191, 9192},
+	{0x2FA1D, 9192, 9193},
+}
+
+var compatibilityRunes = []rune{
+	0x0020, 0x0020, 0x0308, 0x0061, 0x0020, 0x0304, 0x0032, 0x0033, 0x0020, 0x0301, 0x03BC, 0x0020,
+	0x0327, 0x0031, 0x006F, 0x0031, 0x2044, 0x0034, 0x0031, 0x2044, 0x0032, 0x0033, 0x2044, 0x0034,
+	0x0041, 0x0300, 0x0041, 0x0301, 0x0041, 0x0302, 0x0041, 0x0303, 0x0041, 0x0308, 0x0041, 0x030A,
+	0x0043, 0x0327, 0x0045, 0x0300, 0x0045, 0x0301, 0x0045, 0x0302, 0x0045, 0x0308, 0x0049, 0x0300,
+	0x0049, 0x0301, 0x0049, 0x0302, 0x0049, 0x0308, 0x004E, 0x0303, 0x004F, 0x0300, 0x004F, 0x0301,
+	0x004F, 0x0302, 0x004F, 0x0303, 0x004F, 0x0308, 0x0055, 0x0300, 0x0055, 0x0301, 0x0055, 0x0302,
+	0x0055, 0x0308, 0x0059, 0x0301, 0x0061, 0x0300, 0x0061, 0x0301, 0x0061, 0x0302, 0x0061, 0x0303,
+	0x0061, 0x0308, 0x0061, 0x030A, 0x0063, 0x0327, 0x0065, 0x0300, 0x0065, 0x0301, 0x0065, 0x0302,
+	0x0065, 0x0308, 0x0069, 0x0300, 0x0069, 0x0301, 0x0069, 0x0302, 0x0069, 0x0308, 0x006E, 0x0303,
+	0x006F, 0x0300, 0x006F, 0x0301, 0x006F, 0x0302, 0x006F, 0x0303, 0x006F, 0x0308, 0x0075, 0x0300,
+	0x0075, 0x0301, 0x0075, 0x0302, 0x0075, 0x0308, 0x0079, 0x0301, 0x0079, 0x0308, 0x0041, 0x0304,
+	0x0061, 0x0304, 0x0041, 0x0306, 0x0061, 0x0306, 0x0041, 0x0328, 0x0061, 0x0328, 0x0043, 0x0301,
+	0x0063, 0x0301, 0x0043, 0x0302, 0x0063, 0x0302, 0x0043, 0x0307, 0x0063, 0x0307, 0x0043, 0x030C,
+	0x0063, 0x030C, 0x0044, 0x030C, 0x0064, 0x030C, 0x0045, 0x0304, 0x0065, 0x0304, 0x0045, 0x0306,
+	0x0065, 0x0306, 0x0045, 0x0307, 0x0065, 0x0307, 0x0045, 0x0328, 0x0065, 0x0328, 0x0045, 0x030C,
+	0x0065, 0x030C, 0x0047, 0x0302, 0x0067, 0x0302, 0x0047, 0x0306, 0x0067, 0x0306, 0x0047, 0x0307,
+	0x0067, 0x0307, 0x0047, 0x0327, 0x0067, 0x0327, 0x0048, 0x0302, 0x0068, 0x0302, 0x0049, 0x0303,
+	0x0069, 0x0303, 0x0049, 0x0304, 0x0069, 0x0304, 0x0049, 0x0306, 0x0069, 0x0306, 0x0049, 0x0328,
+	0x0069, 0x0328, 0x0049, 0x0307, 0x0049, 0x004A, 0x0069, 0x006A, 0x004A, 0x0302, 0x006A, 0x0302,
+	0x004B, 0x0327, 0x006B, 0x0327, 0x004C, 0x0301, 0x006C, 0x0301, 0x004C, 0x0327, 0x006C, 0x0327,
+	0x004C, 0x030C, 0x006C, 0x030C, 0x004C, 0x00B7, 0x006C, 0x00B7, 0x004E, 0x0301, 0x006E, 0x0301,
+	0x004E, 0x0327, 0x006E, 0x0327, 0x004E, 0x030C, 0x006E, 0x030C, 0x02BC, 0x006E, 0x004F, 0x0304,
+	0x006F, 0x0304, 0x004F, 0x0306, 0x006F, 0x0306, 0x004F, 0x030B, 0x006F, 0x030B, 0x0052, 0x0301,
+	0x0072, 0x0301, 0x0052, 0x0327, 0x0072, 0x0327, 0x0052, 0x030C, 0x0072, 0x030C, 0x0053, 0x0301,
+	0x0073, 0x0301, 0x0053, 0x0302, 0x0073, 0x0302, 0x0053, 0x0327, 0x0073, 0x0327, 0x0053, 0x030C,
+	0x0073, 0x030C, 0x0054, 0x0327, 0x0074, 0x0327, 0x0054, 0x030C, 0x0074, 0x030C, 0x0055, 0x0303,
+	0x0075, 0x0303, 0x0055, 0x0304, 0x0075, 0x0304, 0x0055, 0x0306, 0x0075, 0x0306, 0x0055, 0x030A,
+	0x0075, 0x030A, 0x0055, 0x030B, 0x0075, 0x030B, 0x0055, 0x0328, 0x0075, 0x0328, 0x0057, 0x0302,
+	0x0077, 0x0302, 0x0059, 0x0302, 0x0079, 0x0302, 0x0059, 0x0308, 0x005A, 0x0301, 0x007A, 0x0301,
+	0x005A, 0x0307, 0x007A, 0x0307, 0x005A, 0x030C, 0x007A, 0x030C, 0x0073, 0x004F, 0x031B, 0x006F,
+	0x031B, 0x0055, 0x031B, 0x0075, 0x031B, 0x0044, 0x005A, 0x030C, 0x0044, 0x007A, 0x030C, 0x0064,
+	0x007A, 0x030C, 0x004C, 0x004A, 0x004C, 0x006A, 0x006C, 0x006A, 0x004E, 0x004A, 0x004E, 0x006A,
+	0x006E, 0x006A, 0x0041, 0x030C, 0x0061, 0x030C, 0x0049, 0x030C, 0x0069, 0x030C, 0x004F, 0x030C,
+	0x006F, 0x030C, 0x0055, 0x030C, 0x0075, 0x030C, 0x0055, 0x0308, 0x0304, 0x0075, 0x0308, 0x0304,
+	0x0055, 0x0308, 0x0301, 0x0075, 0x0308, 0x0301, 0x0055, 0x0308, 0x030C, 0x0075, 0x0308, 0x030C,
+	0x0055, 0x0308, 0x0300, 0x0075, 0x0308, 0x0300, 0x0041, 0x0308, 0x0304, 0x0061, 0x0308, 0x0304,
+	0x0041, 0x0307, 0x0304, 0x0061, 0x0307, 0x0304, 0x00C6, 0x0304, 0x00E6, 0x0304, 0x0047, 0x030C,
+	0x0067, 0x030C, 0x004B, 0x030C, 0x006B, 0x030C, 0x004F, 0x0328, 0x006F, 0x0328, 0x004F, 0x0328,
+	0x0304, 0x006F, 0x0328, 0x0304, 0x01B7, 0x030C, 0x0292, 0x030C, 0x006A, 0x030C, 0x0044, 0x005A,
+	0x0044, 0x007A, 0x0064, 0x007A, 0x0047, 0x0301, 0x0067, 0x0301, 0x004E, 0x0300, 0x006E, 0x0300,
+	0x0041, 0x030A, 0x0301, 0x0061, 0x030A, 0x0301, 0x00C6, 0x0301, 0x00E6, 0x0301, 0x00D8, 0x0301,
+	0x00F8, 0x0301, 0x0041, 0x030F, 0x0061, 0x030F, 0x0041, 0x0311, 0x0061, 0x0311, 0x0045, 0x030F,
+	0x0065, 0x030F, 0x0045, 0x0311, 0x0065, 0x0311, 0x0049, 0x030F, 0x0069, 0x030F, 0x0049, 0x0311,
+	0x0069, 0x0311, 0x004F, 0x030F, 0x006F, 0x030F, 0x004F, 0x0311, 0x006F, 0x0311, 0x0052, 0x030F,
+	0x0072, 0x030F, 0x0052, 0x0311, 0x0072, 0x0311, 0x0055, 0x030F, 0x0075, 0x030F, 0x0055, 0x0311,
+	0x0075, 0x0311, 0x0053, 0x0326, 0x0073, 0x0326, 0x0054, 0x0326, 0x0074, 0x0326, 0x0048, 0x030C,
+	0x0068, 0x030C, 0x0041, 0x0307, 0x0061, 0x0307, 0x0045, 0x0327, 0x0065, 0x0327, 0x004F, 0x0308,
+	0x0304, 0x006F, 0x0308, 0x0304, 0x004F, 0x0303, 0x0304, 0x006F, 0x0303, 0x0304, 0x004F, 0x0307,
+	0x006F, 0x0307, 0x004F, 0x0307, 0x0304, 0x006F, 0x0307, 0x0304, 0x0059, 0x0304, 0x0079, 0x0304,
+	0x0068, 0x0266, 0x006A, 0x0072, 0x0279, 0x027B, 0x0281, 0x0077, 0x0079, 0x0020, 0x0306, 0x0020,
+	0x0307, 0x0020, 0x030A, 0x0020, 0x0328, 0x0020, 0x0303, 0x0020, 0x030B, 0x0263, 0x006C, 0x0073,
+	0x0078, 0x0295, 0x0300, 0x0301, 0x0313, 0x0308, 0x0301, 0x02B9, 0x0020, 0x0345, 0x003B, 0x0020,
+	0x0301, 0x0020, 0x0308, 0x0301, 0x0391, 0x0301, 0x00B7, 0x0395, 0x0301, 0x0397, 0x0301, 0x0399,
+	0x0301, 0x039F, 0x0301, 0x03A5, 0x0301, 0x03A9, 0x0301, 0x03B9, 0x0308, 0x0301, 0x0399, 0x0308,
+	0x03A5, 0x0308, 0x03B1, 0x0301, 0x03B5, 0x0301, 0x03B7, 0x0301, 0x03B9, 0x0301, 0x03C5, 0x0308,
+	0x0301, 0x03B9, 0x0308, 0x03C5, 0x0308, 0x03BF, 0x0301, 0x03C5, 0x0301, 0x03C9, 0x0301, 0x03B2,
+	0x03B8, 0x03A5, 0x03A5, 0x0301, 0x03A5, 0x0308, 0x03C6, 0x03C0, 0x03BA, 0x03C1, 0x03C2, 0x0398,
+	0x03B5, 0x03A3, 0x0415, 0x0300, 0x0415, 0x0308, 0x0413, 0x0301, 0x0406, 0x0308, 0x041A, 0x0301,
+	0x0418, 0x0300, 0x0423, 0x0306, 0x0418, 0x0306, 0x0438, 0x0306, 0x0435, 0x0300, 0x0435, 0x0308,
+	0x0433, 0x0301, 0x0456, 0x0308, 0x043A, 0x0301, 0x0438, 0x0300, 0x0443, 0x0306, 0x0474, 0x030F,
+	0x0475, 0x030F, 0x0416, 0x0306, 0x0436, 0x0306, 0x0410, 0x0306, 0x0430, 0x0306, 0x0410, 0x0308,
+	0x0430, 0x0308, 0x0415, 0x0306, 0x0435, 0x0306, 0x04D8, 0x0308, 0x04D9, 0x0308, 0x0416, 0x0308,
+	0x0436, 0x0308, 0x0417, 0x0308, 0x0437, 0x0308, 0x0418, 0x0304, 0x0438, 0x0304, 0x0418, 0x0308,
+	0x0438, 0x0308, 0x041E, 0x0308, 0x043E, 0x0308, 0x04E8, 0x0308, 0x04E9, 0x0308, 0x042D, 0x0308,
+	0x044D, 0x0308, 0x0423, 0x0304, 0x0443, 0x0304, 0x0423, 0x0308, 0x0443, 0x0308, 0x0423, 0x030B,
+	0x0443, 0x030B, 0x0427, 0x0308, 0x0447, 0x0308, 0x042B, 0x0308, 0x044B, 0x0308, 0x0565, 0x0582,
+	0x0627, 0x0653, 0x0627, 0x0654, 0x0648, 0x0654, 0x0627, 0x0655, 0x064A, 0x0654, 0x0627, 0x0674,
+	0x0648, 0x0674, 0x06C7, 0x0674, 0x064A, 0x0674, 0x06D5, 0x0654, 0x06C1, 0x0654, 0x06D2, 0x0654,
+	0x0928, 0x093C, 0x0930, 0x093C, 0x0933, 0x093C, 0x0915, 0x093C, 0x0916, 0x093C, 0x0917, 0x093C,
+	0x091C, 0x093C, 0x0921, 0x093C, 0x0922, 0x093C, 0x092B, 0x093C, 0x092F, 0x093C, 0x09C7, 0x09BE,
+	0x09C7, 0x09D7, 0x09A1, 0x09BC, 0x09A2, 0x09BC, 0x09AF, 0x09BC, 0x0A32, 0x0A3C, 0x0A38, 0x0A3C,
+	0x0A16, 0x0A3C, 0x0A17, 0x0A3C, 0x0A1C, 0x0A3C, 0x0A2B, 0x0A3C, 0x0B47, 0x0B56, 0x0B47, 0x0B3E,
+	0x0B47, 0x0B57, 0x0B21, 0x0B3C, 0x0B22, 0x0B3C, 0x0B92, 0x0BD7, 0x0BC6, 0x0BBE, 0x0BC7, 0x0BBE,
+	0x0BC6, 0x0BD7, 0x0C46, 0x0C56, 0x0CBF, 0x0CD5, 0x0CC6, 0x0CD5, 0x0CC6, 0x0CD6, 0x0CC6, 0x0CC2,
+	0x0CC6, 0x0CC2, 0x0CD5, 0x0D46, 0x0D3E, 0x0D47, 0x0D3E, 0x0D46, 0x0D57, 0x0DD9, 0x0DCA, 0x0DD9,
+	0x0DCF, 0x0DD9, 0x0DCF, 0x0DCA, 0x0DD9, 0x0DDF, 0x0E4D, 0x0E32, 0x0ECD, 0x0EB2, 0x0EAB, 0x0E99,
+	0x0EAB, 0x0EA1, 0x0F0B, 0x0F42, 0x0FB7, 0x0F4C, 0x0FB7, 0x0F51, 0x0FB7, 0x0F56, 0x0FB7, 0x0F5B,
+	0x0FB7, 0x0F40, 0x0FB5, 0x0F71, 0x0F72, 0x0F71, 0x0F74, 0x0FB2, 0x0F80, 0x0FB2, 0x0F71, 0x0F80,
+	0x0FB3, 0x0F80, 0x0FB3, 0x0F71, 0x0F80, 0x0F71, 0x0F80, 0x0F92, 0x0FB7, 0x0F9C, 0x0FB7, 0x0FA1,
+	0x0FB7, 0x0FA6, 0x0FB7, 0x0FAB, 0x0FB7, 0x0F90, 0x0FB5, 0x1025, 0x102E, 0x10DC, 0x1B05, 0x1B35,
+	0x1B07, 0x1B35, 0x1B09, 0x1B35, 0x1B0B, 0x1B35, 0x1B0D, 0x1B35, 0x1B11, 0x1B35, 0x1B3A, 0x1B35,
+	0x1B3C, 0x1B35, 0x1B3E, 0x1B35, 0x1B3F, 0x1B35, 0x1B42, 0x1B35, 0x0041, 0x00C6, 0x0042, 0x0044,
+	0x0045, 0x018E, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0222,
+	0x0050, 0x0052, 0x0054, 0x0055, 0x0057, 0x0061, 0x0250, 0x0251, 0x1D02, 0x0062, 0x0064, 0x0065,
+	0x0259, 0x025B, 0x025C, 0x0067, 0x006B, 0x006D, 0x014B, 0x006F, 0x0254, 0x1D16, 0x1D17, 0x0070,
+	0x0074, 0x0075, 0x1D1D, 0x026F, 0x0076, 0x1D25, 0x03B2, 0x03B3, 0x03B4, 0x03C6, 0x03C7, 0x0069,
+	0x0072, 0x0075, 0x0076, 0x03B2, 0x03B3, 0x03C1, 0x03C6, 0x03C7, 0x043D, 0x0252, 0x0063, 0x0255,
+	0x00F0, 0x025C, 0x0066, 0x025F, 0x0261, 0x0265, 0x0268, 0x0269, 0x026A, 0x1D7B, 0x029D, 0x026D,
+	0x1D85, 0x029F, 0x0271, 0x0270, 0x0272, 0x0273, 0x0274, 0x0275, 0x0278, 0x0282, 0x0283, 0x01AB,
+	0x0289, 0x028A, 0x1D1C, 0x028B, 0x028C, 0x007A, 0x0290, 0x0291, 0x0292, 0x03B8, 0x0041, 0x0325,
+	0x0061, 0x0325, 0x0042, 0x0307, 0x0062, 0x0307, 0x0042, 0x0323, 0x0062, 0x0323, 0x0042, 0x0331,
+	0x0062, 0x0331, 0x0043, 0x0327, 0x0301, 0x0063, 0x0327, 0x0301, 0x0044, 0x0307, 0x0064, 0x0307,
+	0x0044, 0x0323, 0x0064, 0x0323, 0x0044, 0x0331, 0x0064, 0x0331, 0x0044, 0x0327, 0x0064, 0x0327,
+	0x0044, 0x032D, 0x0064, 0x032D, 0x0045, 0x0304, 0x0300, 0x0065, 0x0304, 0x0300, 0x0045, 0x0304,
+	0x0301, 0x0065, 0x0304, 0x0301, 0x0045, 0x032D, 0x0065, 0x032D, 0x0045, 0x0330, 0x0065, 0x0330,
+	0x0045, 0x0327, 0x0306, 0x0065, 0x0327, 0x0306, 0x0046, 0x0307, 0x0066, 0x0307, 0x0047, 0x0304,
+	0x0067, 0x0304, 0x0048, 0x0307, 0x0068, 0x0307, 0x0048, 0x0323, 0x0068, 0x0323, 0x0048, 0x0308,
+	0x0068, 0x0308, 0x0048, 0x0327, 0x0068, 0x0327, 0x0048, 0x032E, 0x0068, 0x032E, 0x0049, 0x0330,
+	0x0069, 0x0330, 0x0049, 0x0308, 0x0301, 0x0069, 0x0308, 0x0301, 0x004B, 0x0301, 0x006B, 0x0301,
+	0x004B, 0x0323, 0x006B, 0x0323, 0x004B, 0x0331, 0x006B, 0x0331, 0x004C, 0x0323, 0x006C, 0x0323,
+	0x004C, 0x0323, 0x0304, 0x006C, 0x0323, 0x0304, 0x004C, 0x0331, 0x006C, 0x0331, 0x004C, 0x032D,
+	0x006C, 0x032D, 0x004D, 0x0301, 0x006D, 0x0301, 0x004D, 0x0307, 0x006D, 0x0307, 0x004D, 0x0323,
+	0x006D, 0x0323, 0x004E, 0x0307, 0x006E, 0x0307, 0x004E, 0x0323, 0x006E, 0x0323, 0x004E, 0x0331,
+	0x006E, 0x0331, 0x004E, 0x032D, 0x006E, 0x032D, 0x004F, 0x0303, 0x0301, 0x006F, 0x0303, 0x0301,
+	0x004F, 0x0303, 0x0308, 0x006F, 0x0303, 0x0308, 0x004F, 0x0304, 0x0300, 0x006F, 0x0304, 0x0300,
+	0x004F, 0x0304, 0x0301, 0x006F, 0x0304, 0x0301, 0x0050, 0x0301, 0x0070, 0x0301, 0x0050, 0x0307,
+	0x0070, 0x0307, 0x0052, 0x0307, 0x0072, 0x0307, 0x0052, 0x0323, 0x0072, 0x0323, 0x0052, 0x0323,
+	0x0304, 0x0072, 0x0323, 0x0304, 0x0052, 0x0331, 0x0072, 0x0331, 0x0053, 0x0307, 0x0073, 0x0307,
+	0x0053, 0x0323, 0x0073, 0x0323, 0x0053, 0x0301, 0x0307, 0x0073, 0x0301, 0x0307, 0x0053, 0x030C,
+	0x0307, 0x0073, 0x030C, 0x0307, 0x0053, 0x0323, 0x0307, 0x0073, 0x0323, 0x0307, 0x0054, 0x0307,
+	0x0074, 0x0307, 0x0054, 0x0323, 0x0074, 0x0323, 0x0054, 0x0331, 0x0074, 0x0331, 0x0054, 0x032D,
+	0x0074, 0x032D, 0x0055, 0x0324, 0x0075, 0x0324, 0x0055, 0x0330, 0x0075, 0x0330, 0x0055, 0x032D,
+	0x0075, 0x032D, 0x0055, 0x0303, 0x0301, 0x0075, 0x0303, 0x0301, 0x0055, 0x0304, 0x0308, 0x0075,
+	0x0304, 0x0308, 0x0056, 0x0303, 0x0076, 0x0303, 0x0056, 0x0323, 0x0076, 0x0323, 0x0057, 0x0300,
+	0x0077, 0x0300, 0x0057, 0x0301, 0x0077, 0x0301, 0x0057, 0x0308, 0x0077, 0x0308, 0x0057, 0x0307,
+	0x0077, 0x0307, 0x0057, 0x0323, 0x0077, 0x0323, 0x0058, 0x0307, 0x0078, 0x0307, 0x0058, 0x0308,
+	0x0078, 0x0308, 0x0059, 0x0307, 0x0079, 0x0307, 0x005A, 0x0302, 0x007A, 0x0302, 0x005A, 0x0323,
+	0x007A, 0x0323, 0x005A, 0x0331, 0x007A, 0x0331, 0x0068, 0x0331, 0x0074, 0x0308, 0x0077, 0x030A,
+	0x0079, 0x030A, 0x0061, 0x02BE, 0x0073, 0x0307, 0x0041, 0x0323, 0x0061, 0x0323, 0x0041, 0x0309,
+	0x0061, 0x0309, 0x0041, 0x0302, 0x0301, 0x0061, 0x0302, 0x0301, 0x0041, 0x0302, 0x0300, 0x0061,
+	0x0302, 0x0300, 0x0041, 0x0302, 0x0309, 0x0061, 0x0302, 0x0309, 0x0041, 0x0302, 0x0303, 0x0061,
+	0x0302, 0x0303, 0x0041, 0x0323, 0x0302, 0x0061, 0x0323, 0x0302, 0x0041, 0x0306, 0x0301, 0x0061,
+	0x0306, 0x0301, 0x0041, 0x0306, 0x0300, 0x0061, 0x0306, 0x0300, 0x0041, 0x0306, 0x0309, 0x0061,
+	0x0306, 0x0309, 0x0041, 0x0306, 0x0303, 0x0061, 0x0306, 0x0303, 0x0041, 0x0323, 0x0306, 0x0061,
+	0x0323, 0x0306, 0x0045, 0x0323, 0x0065, 0x0323, 0x0045, 0x0309, 0x0065, 0x0309, 0x0045, 0x0303,
+	0x0065, 0x0303, 0x0045, 0x0302, 0x0301, 0x0065, 0x0302, 0x0301, 0x0045, 0x0302, 0x0300, 0x0065,
+	0x0302, 0x0300, 0x0045, 0x0302, 0x0309, 0x0065, 0x0302, 0x0309, 0x0045, 0x0302, 0x0303, 0x0065,
+	0x0302, 0x0303, 0x0045, 0x0323, 0x0302, 0x0065, 0x0323, 0x0302, 0x0049, 0x0309, 0x0069, 0x0309,
+	0x0049, 0x0323, 0x0069, 0x0323, 0x004F, 0x0323, 0x006F, 0x0323, 0x004F, 0x0309, 0x006F, 0x0309,
+	0x004F, 0x0302, 0x0301, 0x006F, 0x0302, 0x0301, 0x004F, 0x0302, 0x0300, 0x006F, 0x0302, 0x0300,
+	0x004F, 0x0302, 0x0309, 0x006F, 0x0302, 0x0309, 0x004F, 0x0302, 0x0303, 0x006F, 0x0302, 0x0303,
+	0x004F, 0x0323, 0x0302, 0x006F, 0x0323, 0x0302, 0x004F, 0x031B, 0x0301, 0x006F, 0x031B, 0x0301,
+	0x004F, 0x031B, 0x0300, 0x006F, 0x031B, 0x0300, 0x004F, 0x031B, 0x0309, 0x006F, 0x031B, 0x0309,
+	0x004F, 0x031B, 0x0303, 0x006F, 0x031B, 0x0303, 0x004F, 0x031B, 0x0323, 0x006F, 0x031B, 0x0323,
+	0x0055, 0x0323, 0x0075, 0x0323, 0x0055, 0x0309, 0x0075, 0x0309, 0x0055, 0x031B, 0x0301, 0x0075,
+	0x031B, 0x0301, 0x0055, 0x031B, 0x0300, 0x0075, 0x031B, 0x0300, 0x0055, 0x031B, 0x0309, 0x0075,
+	0x031B, 0x0309, 0x0055, 0x031B, 0x0303, 0x0075, 0x031B, 0x0303, 0x0055, 0x031B, 0x0323, 0x0075,
+	0x031B, 0x0323, 0x0059, 0x0300, 0x0079, 0x0300, 0x0059, 0x0323, 0x0079, 0x0323, 0x0059, 0x0309,
+	0x0079, 0x0309, 0x0059, 0x0303, 0x0079, 0x0303, 0x03B1, 0x0313, 0x03B1, 0x0314, 0x03B1, 0x0313,
+	0x0300, 0x03B1, 0x0314, 0x0300, 0x03B1, 0x0313, 0x0301, 0x03B1, 0x0314, 0x0301, 0x03B1, 0x0313,
+	0x0342, 0x03B1, 0x0314, 0x0342, 0x0391, 0x0313, 0x0391, 0x0314, 0x0391, 0x0313, 0x0300, 0x0391,
+	0x0314, 0x0300, 0x0391, 0x0313, 0x0301, 0x0391, 0x0314, 0x0301, 0x0391, 0x0313, 0x0342, 0x0391,
+	0x0314, 0x0342, 0x03B5, 0x0313, 0x03B5, 0x0314, 0x03B5, 0x0313, 0x0300, 0x03B5, 0x0314, 0x0300,
+	0x03B5, 0x0313, 0x0301, 0x03B5, 0x0314, 0x0301, 0x0395, 0x0313, 0x0395, 0x0314, 0x0395, 0x0313,
+	0x0300, 0x0395, 0x0314, 0x0300, 0x0395, 0x0313, 0x0301, 0x0395, 0x0314, 0x0301, 0x03B7, 0x0313,
+	0x03B7, 0x0314, 0x03B7, 0x0313, 0x0300, 0x03B7, 0x0314, 0x0300, 0x03B7, 0x0313, 0x0301, 0x03B7,
+	0x0314, 0x0301, 0x03B7, 0x0313, 0x0342, 0x03B7, 0x0314, 0x0342, 0x0397, 0x0313, 0x0397, 0x0314,
+	0x0397, 0x0313, 0x0300, 0x0397, 0x0314, 0x0300, 0x0397, 0x0313, 0x0301, 0x0397, 0x0314, 0x0301,
+	0x0397, 0x0313, 0x0342, 0x0397, 0x0314, 0x0342, 0x03B9, 0x0313, 0x03B9, 0x0314, 0x03B9, 0x0313,
+	0x0300, 0x03B9, 0x0314, 0x0300, 0x03B9, 0x0313, 0x0301, 0x03B9, 0x0314, 0x0301, 0x03B9, 0x0313,
+	0x0342, 0x03B9, 0x0314, 0x0342, 0x0399, 0x0313, 0x0399, 0x0314, 0x0399, 0x0313, 0x0300, 0x0399,
+	0x0314, 0x0300, 0x0399, 0x0313, 0x0301, 0x0399, 0x0314, 0x0301, 0x0399, 0x0313, 0x0342, 0x0399,
+	0x0314, 0x0342, 0x03BF, 0x0313, 0x03BF, 0x0314, 0x03BF, 0x0313, 0x0300, 0x03BF, 0x0314, 0x0300,
+	0x03BF, 0x0313, 0x0301, 0x03BF, 0x0314, 0x0301, 0x039F, 0x0313, 0x039F, 0x0314, 0x039F, 0x0313,
+	0x0300, 0x039F, 0x0314, 0x0300, 0x039F, 0x0313, 0x0301, 0x039F, 0x0314, 0x0301, 0x03C5, 0x0313,
+	0x03C5, 0x0314, 0x03C5, 0x0313, 0x0300, 0x03C5, 0x0314, 0x0300, 0x03C5, 0x0313, 0x0301, 0x03C5,
+	0x0314, 0x0301, 0x03C5, 0x0313, 0x0342, 0x03C5, 0x0314, 0x0342, 0x03A5, 0x0314, 0x03A5, 0x0314,
+	0x0300, 0x03A5, 0x0314, 0x0301, 0x03A5, 0x0314, 0x0342, 0x03C9, 0x0313, 0x03C9, 0x0314, 0x03C9,
+	0x0313, 0x0300, 0x03C9, 0x0314, 0x0300, 0x03C9, 0x0313, 0x0301, 0x03C9, 0x0314, 0x0301, 0x03C9,
+	0x0313, 0x0342, 0x03C9, 0x0314, 0x0342, 0x03A9, 0x0313, 0x03A9, 0x0314, 0x03A9, 0x0313, 0x0300,
+	0x03A9, 0x0314, 0x0300, 0x03A9, 0x0313, 0x0301, 0x03A9, 0x0314, 0x0301, 0x03A9, 0x0313, 0x0342,
+	0x03A9, 0x0314, 0x0342, 0x03B1, 0x0300, 0x03B1, 0x0301, 0x03B5, 0x0300, 0x03B5, 0x0301, 0x03B7,
+	0x0300, 0x03B7, 0x0301, 0x03B9, 0x0300, 0x03B9, 0x0301, 0x03BF, 0x0300, 0x03BF, 0x0301, 0x03C5,
+	0x0300, 0x03C5, 0x0301, 0x03C9, 0x0300, 0x03C9, 0x0301, 0x03B1, 0x0313, 0x0345, 0x03B1, 0x0314,
+	0x0345, 0x03B1, 0x0313, 0x0300, 0x0345, 0x03B1, 0x0314, 0x0300, 0x0345, 0x03B1, 0x0313, 0x0301,
+	0x0345, 0x03B1, 0x0314, 0x0301, 0x0345, 0x03B1, 0x0313, 0x0342, 0x0345, 0x03B1, 0x0314, 0x0342,
+	0x0345, 0x0391, 0x0313, 0x0345, 0x0391, 0x0314, 0x0345, 0x0391, 0x0313, 0x0300, 0x0345, 0x0391,
+	0x0314, 0x0300, 0x0345, 0x0391, 0x0313, 0x0301, 0x0345, 0x0391, 0x0314, 0x0301, 0x0345, 0x0391,
+	0x0313, 0x0342, 0x0345, 0x0391, 0x0314, 0x0342, 0x0345, 0x03B7, 0x0313, 0x0345, 0x03B7, 0x0314,
+	0x0345, 0x03B7, 0x0313, 0x0300, 0x0345, 0x03B7, 0x0314, 0x0300, 0x0345, 0x03B7, 0x0313, 0x0301,
+	0x0345, 0x03B7, 0x0314, 0x0301, 0x0345, 0x03B7, 0x0313, 0x0342, 0x0345, 0x03B7, 0x0314, 0x0342,
+	0x0345, 0x0397, 0x0313, 0x0345, 0x0397, 0x0314, 0x0345, 0x0397, 0x0313, 0x0300, 0x0345, 0x0397,
+	0x0314, 0x0300, 0x0345, 0x0397, 0x0313, 0x0301, 0x0345, 0x0397, 0x0314, 0x0301, 0x0345, 0x0397,
+	0x0313, 0x0342, 0x0345, 0x0397, 0x0314, 0x0342, 0x0345, 0x03C9, 0x0313, 0x0345, 0x03C9, 0x0314,
+	0x0345, 0x03C9, 0x0313, 0x0300, 0x0345, 0x03C9, 0x0314, 0x0300, 0x0345, 0x03C9, 0x0313, 0x0301,
+	0x0345, 0x03C9, 0x0314, 0x0301, 0x0345, 0x03C9, 0x0313, 0x0342, 0x0345, 0x03C9, 0x0314, 0x0342,
+	0x0345, 0x03A9, 0x0313, 0x0345, 0x03A9, 0x0314, 0x0345, 0x03A9, 0x0313, 0x0300, 0x0345, 0x03A9,
+	0x0314, 0x0300, 0x0345, 0x03A9, 0x0313, 0x0301, 0x0345, 0x03A9, 0x0314, 0x0301, 0x0345, 0x03A9,
+	0x0313, 0x0342, 0x0345, 0x03A9, 0x0314, 0x0342, 0x0345, 0x03B1, 0x0306, 0x03B1, 0x0304, 0x03B1,
+	0x0300, 0x0345, 0x03B1, 0x0345, 0x03B1, 0x0301, 0x0345, 0x03B1, 0x0342, 0x03B1, 0x0342, 0x0345,
+	0x0391, 0x0306, 0x0391, 0x0304, 0x0391, 0x0300, 0x0391, 0x0301, 0x0391, 0x0345, 0x0020, 0x0313,
+	0x03B9, 0x0020, 0x0313, 0x0020, 0x0342, 0x0020, 0x0308, 0x0342, 0x03B7, 0x0300, 0x0345, 0x03B7,
+	0x0345, 0x03B7, 0x0301, 0x0345, 0x03B7, 0x0342, 0x03B7, 0x0342, 0x0345, 0x0395, 0x0300, 0x0395,
+	0x0301, 0x0397, 0x0300, 0x0397, 0x0301, 0x0397, 0x0345, 0x0020, 0x0313, 0x0300, 0x0020, 0x0313,
+	0x0301, 0x0020, 0x0313, 0x0342, 0x03B9, 0x0306, 0x03B9, 0x0304, 0x03B9, 0x0308, 0x0300, 0x03B9,
+	0x0308, 0x0301, 0x03B9, 0x0342, 0x03B9, 0x0308, 0x0342, 0x0399, 0x0306, 0x0399, 0x0304, 0x0399,
+	0x0300, 0x0399, 0x0301, 0x0020, 0x0314, 0x0300, 0x0020, 0x0314, 0x0301, 0x0020, 0x0314, 0x0342,
+	0x03C5, 0x0306, 0x03C5, 0x0304, 0x03C5, 0x0308, 0x0300, 0x03C5, 0x0308, 0x0301, 0x03C1, 0x0313,
+	0x03C1, 0x0314, 0x03C5, 0x0342, 0x03C5, 0x0308, 0x0342, 0x03A5, 0x0306, 0x03A5, 0x0304, 0x03A5,
+	0x0300, 0x03A5, 0x0301, 0x03A1, 0x0314, 0x0020, 0x0308, 0x0300, 0x0020, 0x0308, 0x0301, 0x0060,
+	0x03C9, 0x0300, 0x0345, 0x03C9, 0x0345, 0x03C9, 0x0301, 0x0345, 0x03C9, 0x0342, 0x03C9, 0x0342,
+	0x0345, 0x039F, 0x0300, 0x039F, 0x0301, 0x03A9, 0x0300, 0x03A9, 0x0301, 0x03A9, 0x0345, 0x0020,
+	0x0301, 0x0020, 0x0314, 0x0020, 0x0020, 0x0020, 0x0020, 0x0020, 0x0020, 0x0020, 0x0020, 0x0020,
+	0x0020, 0x0020, 0x2010, 0x0020, 0x0333, 0x002E, 0x002E, 0x002E, 0x002E, 0x002E, 0x002E, 0x0020,
+	0x2032, 0x2032, 0x2032, 0x2032, 0x2032, 0x2035, 0x2035, 0x2035, 0x2035, 0x2035, 0x0021, 0x0021,
+	0x0020, 0x0305, 0x003F, 0x003F, 0x003F, 0x0021, 0x0021, 0x003F, 0x2032, 0x2032, 0x2032, 0x2032,
+	0x0020, 0x0030, 0x0069, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x002B, 0x2212, 0x003D,
+	0x0028, 0x0029, 0x006E, 0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038,
+	0x0039, 0x002B, 0x2212, 0x003D, 0x0028, 0x0029, 0x0061, 0x0065, 0x006F, 0x0078, 0x0259, 0x0068,
+	0x006B, 0x006C, 0x006D, 0x006E, 0x0070, 0x0073, 0x0074, 0x0052, 0x0073, 0x0061, 0x002F, 0x0063,
+	0x0061, 0x002F, 0x0073, 0x0043, 0x00B0, 0x0043, 0x0063, 0x002F, 0x006F, 0x0063, 0x002F, 0x0075,
+	0x0190, 0x00B0, 0x0046, 0x0067, 0x0048, 0x0048, 0x0048, 0x0068, 0x0127, 0x0049, 0x0049, 0x004C,
+	0x006C, 0x004E, 0x004E, 0x006F, 0x0050, 0x0051, 0x0052, 0x0052, 0x0052, 0x0053, 0x004D, 0x0054,
+	0x0045, 0x004C, 0x0054, 0x004D, 0x005A, 0x03A9, 0x005A, 0x004B, 0x0041, 0x030A, 0x0042, 0x0043,
+	0x0065, 0x0045, 0x0046, 0x004D, 0x006F, 0x05D0, 0x05D1, 0x05D2, 0x05D3, 0x0069, 0x0046, 0x0041,
+	0x0058, 0x03C0, 0x03B3, 0x0393, 0x03A0, 0x2211, 0x0044, 0x0064, 0x0065, 0x0069, 0x006A, 0x0031,
+	0x2044, 0x0037, 0x0031, 0x2044, 0x0039, 0x0031, 0x2044, 0x0031, 0x0030, 0x0031, 0x2044, 0x0033,
+	0x0032, 0x2044, 0x0033, 0x0031, 0x2044, 0x0035, 0x0032, 0x2044, 0x0035, 0x0033, 0x2044, 0x0035,
+	0x0034, 0x2044, 0x0035, 0x0031, 0x2044, 0x0036, 0x0035, 0x2044, 0x0036, 0x0031, 0x2044, 0x0038,
+	0x0033, 0x2044, 0x0038, 0x0035, 0x2044, 0x0038, 0x0037, 0x2044, 0x0038, 0x0031, 0x2044, 0x0049,
+	0x0049, 0x0049, 0x0049, 0x0049, 0x0049, 0x0049, 0x0056, 0x0056, 0x0056, 0x0049, 0x0056, 0x0049,
+	0x0049, 0x0056, 0x0049, 0x0049, 0x0049, 0x0049, 0x0058, 0x0058, 0x0058, 0x0049, 0x0058, 0x0049,
+	0x0049, 0x004C, 0x0043, 0x0044, 0x004D, 0x0069, 0x0069, 0x0069, 0x0069, 0x0069, 0x0069, 0x0069,
+	0x0076, 0x0076, 0x0076, 0x0069, 0x0076, 0x0069, 0x0069, 0x0076, 0x0069, 0x0069, 0x0069, 0x0069,
+	0x0078, 0x0078, 0x0078, 0x0069, 0x0078, 0x0069, 0x0069, 0x006C, 0x0063, 0x0064, 0x006D, 0x0030,
+	0x2044, 0x0033, 0x2190, 0x0338, 0x2192, 0x0338, 0x2194, 0x0338, 0x21D0, 0x0338, 0x21D4, 0x0338,
+	0x21D2, 0x0338, 0x2203, 0x0338, 0x2208, 0x0338, 0x220B, 0x0338, 0x2223, 0x0338, 0x2225, 0x0338,
+	0x222B, 0x222B, 0x222B, 0x222B, 0x222B, 0x222E, 0x222E, 0x222E, 0x222E, 0x222E, 0x223C, 0x0338,
+	0x2243, 0x0338, 0x2245, 0x0338, 0x2248, 0x0338, 0x003D, 0x0338, 0x2261, 0x0338, 0x224D, 0x0338,
+	0x003C, 0x0338, 0x003E, 0x0338, 0x2264, 0x0338, 0x2265, 0x0338, 0x2272, 0x0338, 0x2273, 0x0338,
+	0x2276, 0x0338, 0x2277, 0x0338, 0x227A, 0x0338, 0x227B, 0x0338, 0x2282, 0x0338, 0x2283, 0x0338,
+	0x2286, 0x0338, 0x2287, 0x0338, 0x22A2, 0x0338, 0x22A8, 0x0338, 0x22A9, 0x0338, 0x22AB, 0x0338,
+	0x227C, 0x0338, 0x227D, 0x0338, 0x2291, 0x0338, 0x2292, 0x0338, 0x22B2, 0x0338, 0x22B3, 0x0338,
+	0x22B4, 0x0338, 0x22B5, 0x0338, 0x3008, 0x3009, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036,
+	0x0037, 0x0038, 0x0039, 0x0031, 0x0030, 0x0031, 0x0031, 0x0031, 0x0032, 0x0031, 0x0033, 0x0031,
+	0x0034, 0x0031, 0x0035, 0x0031, 0x0036, 0x0031, 0x0037, 0x0031, 0x0038, 0x0031, 0x0039, 0x0032,
+	0x0030, 0x0028, 0x0031, 0x0029, 0x0028, 0x0032, 0x0029, 0x0028, 0x0033, 0x0029, 0x0028, 0x0034,
+	0x0029, 0x0028, 0x0035, 0x0029, 0x0028, 0x0036, 0x0029, 0x0028, 0x0037, 0x0029, 0x0028, 0x0038,
+	0x0029, 0x0028, 0x0039, 0x0029, 0x0028, 0x0031, 0x0030, 0x0029, 0x0028, 0x0031, 0x0031, 0x0029,
+	0x0028, 0x0031, 0x0032, 0x0029, 0x0028, 0x0031, 0x0033, 0x0029, 0x0028, 0x0031, 0x0034, 0x0029,
+	0x0028, 0x0031, 0x0035, 0x0029, 0x0028, 0x0031, 0x0036, 0x0029, 0x0028, 0x0031, 0x0037, 0x0029,
+	0x0028, 0x0031, 0x0038, 0x0029, 0x0028, 0x0031, 0x0039, 0x0029, 0x0028, 0x0032, 0x0030, 0x0029,
+	0x0031, 0x002E, 0x0032, 0x002E, 0x0033, 0x002E, 0x0034, 0x002E, 0x0035, 0x002E, 0x0036, 0x002E,
+	0x0037, 0x002E, 0x0038, 0x002E, 0x0039, 0x002E, 0x0031, 0x0030, 0x002E, 0x0031, 0x0031, 0x002E,
+	0x0031, 0x0032, 0x002E, 0x0031, 0x0033, 0x002E, 0x0031, 0x0034, 0x002E, 0x0031, 0x0035, 0x002E,
+	0x0031, 0x0036, 0x002E, 0x0031, 0x0037, 0x002E, 0x0031, 0x0038, 0x002E, 0x0031, 0x0039, 0x002E,
+	0x0032, 0x0030, 0x002E, 0x0028, 0x0061, 0x0029, 0x0028, 0x0062, 0x0029, 0x0028, 0x0063, 0x0029,
+	0x0028, 0x0064, 0x0029, 0x0028, 0x0065, 0x0029, 0x0028, 0x0066, 0x0029, 0x0028, 0x0067, 0x0029,
+	0x0028, 0x0068, 0x0029, 0x0028, 0x0069, 0x0029, 0x0028, 0x006A, 0x0029, 0x0028, 0x006B, 0x0029,
+	0x0028, 0x006C, 0x0029, 0x0028, 0x006D, 0x0029, 0x0028, 0x006E, 0x0029, 0x0028, 0x006F, 0x0029,
+	0x0028, 0x0070, 0x0029, 0x0028, 0x0071, 0x0029, 0x0028, 0x0072, 0x0029, 0x0028, 0x0073, 0x0029,
+	0x0028, 0x0074, 0x0029, 0x0028, 0x0075, 0x0029, 0x0028, 0x0076, 0x0029, 0x0028, 0x0077, 0x0029,
+	0x0028, 0x0078, 0x0029, 0x0028, 0x0079, 0x0029, 0x0028, 0x007A, 0x0029, 0x0041, 0x0042, 0x0043,
+	0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F,
+	0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0061,
+	0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D,
+	0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079,
+	0x007A, 0x0030, 0x222B, 0x222B, 0x222B, 0x222B, 0x003A, 0x003A, 0x003D, 0x003D, 0x003D, 0x003D,
+	0x003D, 0x003D, 0x2ADD, 0x0338, 0x006A, 0x0056, 0x2D61, 0x6BCD, 0x9F9F, 0x4E00, 0x4E28, 0x4E36,
+	0x4E3F, 0x4E59, 0x4E85, 0x4E8C, 0x4EA0, 0x4EBA, 0x513F, 0x5165, 0x516B, 0x5182, 0x5196, 0x51AB,
+	0x51E0, 0x51F5, 0x5200, 0x529B, 0x52F9, 0x5315, 0x531A, 0x5338, 0x5341, 0x535C, 0x5369, 0x5382,
+	0x53B6, 0x53C8, 0x53E3, 0x56D7, 0x571F, 0x58EB, 0x5902, 0x590A, 0x5915, 0x5927, 0x5973, 0x5B50,
+	0x5B80, 0x5BF8, 0x5C0F, 0x5C22, 0x5C38, 0x5C6E, 0x5C71, 0x5DDB, 0x5DE5, 0x5DF1, 0x5DFE, 0x5E72,
+	0x5E7A, 0x5E7F, 0x5EF4, 0x5EFE, 0x5F0B, 0x5F13, 0x5F50, 0x5F61, 0x5F73, 0x5FC3, 0x6208, 0x6236,
+	0x624B, 0x652F, 0x6534, 0x6587, 0x6597, 0x65A4, 0x65B9, 0x65E0, 0x65E5, 0x66F0, 0x6708, 0x6728,
+	0x6B20, 0x6B62, 0x6B79, 0x6BB3, 0x6BCB, 0x6BD4, 0x6BDB, 0x6C0F, 0x6C14, 0x6C34, 0x706B, 0x722A,
+	0x7236, 0x723B, 0x723F, 0x7247, 0x7259, 0x725B, 0x72AC, 0x7384, 0x7389, 0x74DC, 0x74E6, 0x7518,
+	0x751F, 0x7528, 0x7530, 0x758B, 0x7592, 0x7676, 0x767D, 0x76AE, 0x76BF, 0x76EE, 0x77DB, 0x77E2,
+	0x77F3, 0x793A, 0x79B8, 0x79BE, 0x7A74, 0x7ACB, 0x7AF9, 0x7C73, 0x7CF8, 0x7F36, 0x7F51, 0x7F8A,
+	0x7FBD, 0x8001, 0x800C, 0x8012, 0x8033, 0x807F, 0x8089, 0x81E3, 0x81EA, 0x81F3, 0x81FC, 0x820C,
+	0x821B, 0x821F, 0x826E, 0x8272, 0x8278, 0x864D, 0x866B, 0x8840, 0x884C, 0x8863, 0x897E, 0x898B,
+	0x89D2, 0x8A00, 0x8C37, 0x8C46, 0x8C55, 0x8C78, 0x8C9D, 0x8D64, 0x8D70, 0x8DB3, 0x8EAB, 0x8ECA,
+	0x8F9B, 0x8FB0, 0x8FB5, 0x9091, 0x9149, 0x91C6, 0x91CC, 0x91D1, 0x9577, 0x9580, 0x961C, 0x96B6,
+	0x96B9, 0x96E8, 0x9751, 0x975E, 0x9762, 0x9769, 0x97CB, 0x97ED, 0x97F3, 0x9801, 0x98A8, 0x98DB,
+	0x98DF, 0x9996, 0x9999, 0x99AC, 0x9AA8, 0x9AD8, 0x9ADF, 0x9B25, 0x9B2F, 0x9B32, 0x9B3C, 0x9B5A,
+	0x9CE5, 0x9E75, 0x9E7F, 0x9EA5, 0x9EBB, 0x9EC3, 0x9ECD, 0x9ED1, 0x9EF9, 0x9EFD, 0x9F0E, 0x9F13,
+	0x9F20, 0x9F3B, 0x9F4A, 0x9F52, 0x9F8D, 0x9F9C, 0x9FA0, 0x0020, 0x3012, 0x5341, 0x5344, 0x5345,
+	0x304B, 0x3099, 0x304D, 0x3099, 0x304F, 0x3099, 0x3051, 0x3099, 0x3053, 0x3099, 0x3055, 0x3099,
+	0x3057, 0x3099, 0x3059, 0x3099, 0x305B, 0x3099, 0x305D, 0x3099, 0x305F, 0x3099, 0x3061, 0x3099,
+	0x3064, 0x3099, 0x3066, 0x3099, 0x3068, 0x3099, 0x306F, 0x3099, 0x306F, 0x309A, 0x3072, 0x3099,
+	0x3072, 0x309A, 0x3075, 0x3099, 0x3075, 0x309A, 0x3078, 0x3099, 0x3078, 0x309A, 0x307B, 0x3099,
+	0x307B, 0x309A, 0x3046, 0x3099, 0x0020, 0x3099, 0x0020, 0x309A, 0x309D, 0x3099, 0x3088, 0x308A,
+	0x30AB, 0x3099, 0x30AD, 0x3099, 0x30AF, 0x3099, 0x30B1, 0x3099, 0x30B3, 0x3099, 0x30B5, 0x3099,
+	0x30B7, 0x3099, 0x30B9, 0x3099, 0x30BB, 0x3099, 0x30BD, 0x3099, 0x30BF, 0x3099, 0x30C1, 0x3099,
+	0x30C4, 0x3099, 0x30C6, 0x3099, 0x30C8, 0x3099, 0x30CF, 0x3099, 0x30CF, 0x309A, 0x30D2, 0x3099,
+	0x30D2, 0x309A, 0x30D5, 0x3099, 0x30D5, 0x309A, 0x30D8, 0x3099, 0x30D8, 0x309A, 0x30DB, 0x3099,
+	0x30DB, 0x309A, 0x30A6, 0x3099, 0x30EF, 0x3099, 0x30F0, 0x3099, 0x30F1, 0x3099, 0x30F2, 0x3099,
+	0x30FD, 0x3099, 0x30B3, 0x30C8, 0x1100, 0x1101, 0x11AA, 0x1102, 0x11AC, 0x11AD, 0x1103, 0x1104,
+	0x1105, 0x11B0, 0x11B1, 0x11B2, 0x11B3, 0x11B4, 0x11B5, 0x111A, 0x1106, 0x1107, 0x1108, 0x1121,
+	0x1109, 0x110A, 0x110B, 0x110C, 0x110D, 0x110E, 0x110F, 0x1110, 0x1111, 0x1112, 0x1161, 0x1162,
+	0x1163, 0x1164, 0x1165, 0x1166, 0x1167, 0x1168, 0x1169, 0x116A, 0x116B, 0x116C, 0x116D, 0x116E,
+	0x116F, 0x1170, 0x1171, 0x1172, 0x1173, 0x1174, 0x1175, 0x1160, 0x1114, 0x1115, 0x11C7, 0x11C8,
+	0x11CC, 0x11CE, 0x11D3, 0x11D7, 0x11D9, 0x111C, 0x11DD, 0x11DF, 0x111D, 0x111E, 0x1120, 0x1122,
+	0x1123, 0x1127, 0x1129, 0x112B, 0x112C, 0x112D, 0x112E, 0x112F, 0x1132, 0x1136, 0x1140, 0x1147,
+	0x114C, 0x11F1, 0x11F2, 0x1157, 0x1158, 0x1159, 0x1184, 0x1185, 0x1188, 0x1191, 0x1192, 0x1194,
+	0x119E, 0x11A1, 0x4E00, 0x4E8C, 0x4E09, 0x56DB, 0x4E0A, 0x4E2D, 0x4E0B, 0x7532, 0x4E59, 0x4E19,
+	0x4E01, 0x5929, 0x5730, 0x4EBA, 0x0028, 0x1100, 0x0029, 0x0028, 0x1102, 0x0029, 0x0028, 0x1103,
+	0x0029, 0x0028, 0x1105, 0x0029, 0x0028, 0x1106, 0x0029, 0x0028, 0x1107, 0x0029, 0x0028, 0x1109,
+	0x0029, 0x0028, 0x110B, 0x0029, 0x0028, 0x110C, 0x0029, 0x0028, 0x110E, 0x0029, 0x0028, 0x110F,
+	0x0029, 0x0028, 0x1110, 0x0029, 0x0028, 0x1111, 0x0029, 0x0028, 0x1112, 0x0029, 0x0028, 0x1100,
+	0x1161, 0x0029, 0x0028, 0x1102, 0x1161, 0x0029, 0x0028, 0x1103, 0x1161, 0x0029, 0x0028, 0x1105,
+	0x1161, 0x0029, 0x0028, 0x1106, 0x1161, 0x0029, 0x0028, 0x1107, 0x1161, 0x0029, 0x0028, 0x1109,
+	0x1161, 0x0029, 0x0028, 0x110B, 0x1161, 0x0029, 0x0028, 0x110C, 0x1161, 0x0029, 0x0028, 0x110E,
+	0x1161, 0x0029, 0x0028, 0x110F, 0x1161, 0x0029, 0x0028, 0x1110, 0x1161, 0x0029, 0x0028, 0x1111,
+	0x1161, 0x0029, 0x0028, 0x1112, 0x1161, 0x0029, 0x0028, 0x110C, 0x116E, 0x0029, 0x0028, 0x110B,
+	0x1169, 0x110C, 0x1165, 0x11AB, 0x0029, 0x0028, 0x110B, 0x1169, 0x1112, 0x116E, 0x0029, 0x0028,
+	0x4E00, 0x0029, 0x0028, 0x4E8C, 0x0029, 0x0028, 0x4E09, 0x0029, 0x0028, 0x56DB, 0x0029, 0x0028,
+	0x4E94, 0x0029, 0x0028, 0x516D, 0x0029, 0x0028, 0x4E03, 0x0029, 0x0028, 0x516B, 0x0029, 0x0028,
+	0x4E5D, 0x0029, 0x0028, 0x5341, 0x0029, 0x0028, 0x6708, 0x0029, 0x0028, 0x706B, 0x0029, 0x0028,
+	0x6C34, 0x0029, 0x0028, 0x6728, 0x0029, 0x0028, 0x91D1, 0x0029, 0x0028, 0x571F, 0x0029, 0x0028,
+	0x65E5, 0x0029, 0x0028, 0x682A, 0x0029, 0x0028, 0x6709, 0x0029, 0x0028, 0x793E, 0x0029, 0x0028,
+	0x540D, 0x0029, 0x0028, 0x7279, 0x0029, 0x0028, 0x8CA1, 0x0029, 0x0028, 0x795D, 0x0029, 0x0028,
+	0x52B4, 0x0029, 0x0028, 0x4EE3, 0x0029, 0x0028, 0x547C, 0x0029, 0x0028, 0x5B66, 0x0029, 0x0028,
+	0x76E3, 0x0029, 0x0028, 0x4F01, 0x0029, 0x0028, 0x8CC7, 0x0029, 0x0028, 0x5354, 0x0029, 0x0028,
+	0x796D, 0x0029, 0x0028, 0x4F11, 0x0029, 0x0028, 0x81EA, 0x0029, 0x0028, 0x81F3, 0x0029, 0x554F,
+	0x5E7C, 0x6587, 0x7B8F, 0x0050, 0x0054, 0x0045, 0x0032, 0x0031, 0x0032, 0x0032, 0x0032, 0x0033,
+	0x0032, 0x0034, 0x0032, 0x0035, 0x0032, 0x0036, 0x0032, 0x0037, 0x0032, 0x0038, 0x0032, 0x0039,
+	0x0033, 0x0030, 0x0033, 0x0031, 0x0033, 0x0032, 0x0033, 0x0033, 0x0033, 0x0034, 0x0033, 0x0035,
+	0x1100, 0x1102, 0x1103, 0x1105, 0x1106, 0x1107, 0x1109, 0x110B, 0x110C, 0x110E, 0x110F, 0x1110,
+	0x1111, 0x1112, 0x1100, 0x1161, 0x1102, 0x1161, 0x1103, 0x1161, 0x1105, 0x1161, 0x1106, 0x1161,
+	0x1107, 0x1161, 0x1109, 0x1161, 0x110B, 0x1161, 0x110C, 0x1161, 0x110E, 0x1161, 0x110F, 0x1161,
+	0x1110, 0x1161, 0x1111, 0x1161, 0x1112, 0x1161, 0x110E, 0x1161, 0x11B7, 0x1100, 0x1169, 0x110C,
+	0x116E, 0x110B, 0x1174, 0x110B, 0x116E, 0x4E00, 0x4E8C, 0x4E09, 0x56DB, 0x4E94, 0x516D, 0x4E03,
+	0x516B, 0x4E5D, 0x5341, 0x6708, 0x706B, 0x6C34, 0x6728, 0x91D1, 0x571F, 0x65E5, 0x682A, 0x6709,
+	0x793E, 0x540D, 0x7279, 0x8CA1, 0x795D, 0x52B4, 0x79D8, 0x7537, 0x5973, 0x9069, 0x512A, 0x5370,
+	0x6CE8, 0x9805, 0x4F11, 0x5199, 0x6B63, 0x4E0A, 0x4E2D, 0x4E0B, 0x5DE6, 0x53F3, 0x533B, 0x5B97,
+	0x5B66, 0x76E3, 0x4F01, 0x8CC7, 0x5354, 0x591C, 0x0033, 0x0036, 0x0033, 0x0037, 0x0033, 0x0038,
+	0x0033, 0x0039, 0x0034, 0x0030, 0x0034, 0x0031, 0x0034, 0x0032, 0x0034, 0x0033, 0x0034, 0x0034,
+	0x0034, 0x0035, 0x0034, 0x0036, 0x0034, 0x0037, 0x0034, 0x0038, 0x0034, 0x0039, 0x0035, 0x0030,
+	0x0031, 0x6708, 0x0032, 0x6708, 0x0033, 0x6708, 0x0034, 0x6708, 0x0035, 0x6708, 0x0036, 0x6708,
+	0x0037, 0x6708, 0x0038, 0x6708, 0x0039, 0x6708, 0x0031, 0x0030, 0x6708, 0x0031, 0x0031, 0x6708,
+	0x0031, 0x0032, 0x6708, 0x0048, 0x0067, 0x0065, 0x0072, 0x0067, 0x0065, 0x0056, 0x004C, 0x0054,
+	0x0044, 0x30A2, 0x30A4, 0x30A6, 0x30A8, 0x30AA, 0x30AB, 0x30AD, 0x30AF, 0x30B1, 0x30B3, 0x30B5,
+	0x30B7, 0x30B9, 0x30BB, 0x30BD, 0x30BF, 0x30C1, 0x30C4, 0x30C6, 0x30C8, 0x30CA, 0x30CB, 0x30CC,
+	0x30CD, 0x30CE, 0x30CF, 0x30D2, 0x30D5, 0x30D8, 0x30DB, 0x30DE, 0x30DF, 0x30E0, 0x30E1, 0x30E2,
+	0x30E4, 0x30E6, 0x30E8, 0x30E9, 0x30EA, 0x30EB, 0x30EC, 0x30ED, 0x30EF, 0x30F0, 0x30F1, 0x30F2,
+	0x4EE4, 0x548C, 0x30A2, 0x30CF, 0x309A, 0x30FC, 0x30C8, 0x30A2, 0x30EB, 0x30D5, 0x30A1, 0x30A2,
+	0x30F3, 0x30D8, 0x309A, 0x30A2, 0x30A2, 0x30FC, 0x30EB, 0x30A4, 0x30CB, 0x30F3, 0x30AF, 0x3099,
+	0x30A4, 0x30F3, 0x30C1, 0x30A6, 0x30A9, 0x30F3, 0x30A8, 0x30B9, 0x30AF, 0x30FC, 0x30C8, 0x3099,
+	0x30A8, 0x30FC, 0x30AB, 0x30FC, 0x30AA, 0x30F3, 0x30B9, 0x30AA, 0x30FC, 0x30E0, 0x30AB, 0x30A4,
+	0x30EA, 0x30AB, 0x30E9, 0x30C3, 0x30C8, 0x30AB, 0x30ED, 0x30EA, 0x30FC, 0x30AB, 0x3099, 0x30ED,
+	0x30F3, 0x30AB, 0x3099, 0x30F3, 0x30DE, 0x30AD, 0x3099, 0x30AB, 0x3099, 0x30AD, 0x3099, 0x30CB,
+	0x30FC, 0x30AD, 0x30E5, 0x30EA, 0x30FC, 0x30AD, 0x3099, 0x30EB, 0x30BF, 0x3099, 0x30FC, 0x30AD,
+	0x30ED, 0x30AD, 0x30ED, 0x30AF, 0x3099, 0x30E9, 0x30E0, 0x30AD, 0x30ED, 0x30E1, 0x30FC, 0x30C8,
+	0x30EB, 0x30AD, 0x30ED, 0x30EF, 0x30C3, 0x30C8, 0x30AF, 0x3099, 0x30E9, 0x30E0, 0x30AF, 0x3099,
+	0x30E9, 0x30E0, 0x30C8, 0x30F3, 0x30AF, 0x30EB, 0x30BB, 0x3099, 0x30A4, 0x30ED, 0x30AF, 0x30ED,
+	0x30FC, 0x30CD, 0x30B1, 0x30FC, 0x30B9, 0x30B3, 0x30EB, 0x30CA, 0x30B3, 0x30FC, 0x30DB, 0x309A,
+	0x30B5, 0x30A4, 0x30AF, 0x30EB, 0x30B5, 0x30F3, 0x30C1, 0x30FC, 0x30E0, 0x30B7, 0x30EA, 0x30F3,
+	0x30AF, 0x3099, 0x30BB, 0x30F3, 0x30C1, 0x30BB, 0x30F3, 0x30C8, 0x30BF, 0x3099, 0x30FC, 0x30B9,
+	0x30C6, 0x3099, 0x30B7, 0x30C8, 0x3099, 0x30EB, 0x30C8, 0x30F3, 0x30CA, 0x30CE, 0x30CE, 0x30C3,
+	0x30C8, 0x30CF, 0x30A4, 0x30C4, 0x30CF, 0x309A, 0x30FC, 0x30BB, 0x30F3, 0x30C8, 0x30CF, 0x309A,
+	0x30FC, 0x30C4, 0x30CF, 0x3099, 0x30FC, 0x30EC, 0x30EB, 0x30D2, 0x309A, 0x30A2, 0x30B9, 0x30C8,
+	0x30EB, 0x30D2, 0x309A, 0x30AF, 0x30EB, 0x30D2, 0x309A, 0x30B3, 0x30D2, 0x3099, 0x30EB, 0x30D5,
+	0x30A1, 0x30E9, 0x30C3, 0x30C8, 0x3099, 0x30D5, 0x30A3, 0x30FC, 0x30C8, 0x30D5, 0x3099, 0x30C3,
+	0x30B7, 0x30A7, 0x30EB, 0x30D5, 0x30E9, 0x30F3, 0x30D8, 0x30AF, 0x30BF, 0x30FC, 0x30EB, 0x30D8,
+	0x309A, 0x30BD, 0x30D8, 0x309A, 0x30CB, 0x30D2, 0x30D8, 0x30EB, 0x30C4, 0x30D8, 0x309A, 0x30F3,
+	0x30B9, 0x30D8, 0x309A, 0x30FC, 0x30B7, 0x3099, 0x30D8, 0x3099, 0x30FC, 0x30BF, 0x30DB, 0x309A,
+	0x30A4, 0x30F3, 0x30C8, 0x30DB, 0x3099, 0x30EB, 0x30C8, 0x30DB, 0x30F3, 0x30DB, 0x309A, 0x30F3,
+	0x30C8, 0x3099, 0x30DB, 0x30FC, 0x30EB, 0x30DB, 0x30FC, 0x30F3, 0x30DE, 0x30A4, 0x30AF, 0x30ED,
+	0x30DE, 0x30A4, 0x30EB, 0x30DE, 0x30C3, 0x30CF, 0x30DE, 0x30EB, 0x30AF, 0x30DE, 0x30F3, 0x30B7,
+	0x30E7, 0x30F3, 0x30DF, 0x30AF, 0x30ED, 0x30F3, 0x30DF, 0x30EA, 0x30DF, 0x30EA, 0x30CF, 0x3099,
+	0x30FC, 0x30EB, 0x30E1, 0x30AB, 0x3099, 0x30E1, 0x30AB, 0x3099, 0x30C8, 0x30F3, 0x30E1, 0x30FC,
+	0x30C8, 0x30EB, 0x30E4, 0x30FC, 0x30C8, 0x3099, 0x30E4, 0x30FC, 0x30EB, 0x30E6, 0x30A2, 0x30F3,
+	0x30EA, 0x30C3, 0x30C8, 0x30EB, 0x30EA, 0x30E9, 0x30EB, 0x30D2, 0x309A, 0x30FC, 0x30EB, 0x30FC,
+	0x30D5, 0x3099, 0x30EB, 0x30EC, 0x30E0, 0x30EC, 0x30F3, 0x30C8, 0x30B1, 0x3099, 0x30F3, 0x30EF,
+	0x30C3, 0x30C8, 0x0030, 0x70B9, 0x0031, 0x70B9, 0x0032, 0x70B9, 0x0033, 0x70B9, 0x0034, 0x70B9,
+	0x0035, 0x70B9, 0x0036, 0x70B9, 0x0037, 0x70B9, 0x0038, 0x70B9, 0x0039, 0x70B9, 0x0031, 0x0030,
+	0x70B9, 0x0031, 0x0031, 0x70B9, 0x0031, 0x0032, 0x70B9, 0x0031, 0x0033, 0x70B9, 0x0031, 0x0034,
+	0x70B9, 0x0031, 0x0035, 0x70B9, 0x0031, 0x0036, 0x70B9, 0x0031, 0x0037, 0x70B9, 0x0031, 0x0038,
+	0x70B9, 0x0031, 0x0039, 0x70B9, 0x0032, 0x0030, 0x70B9, 0x0032, 0x0031, 0x70B9, 0x0032, 0x0032,
+	0x70B9, 0x0032, 0x0033, 0x70B9, 0x0032, 0x0034, 0x70B9, 0x0068, 0x0050, 0x0061, 0x0064, 0x0061,
+	0x0041, 0x0055, 0x0062, 0x0061, 0x0072, 0x006F, 0x0056, 0x0070, 0x0063, 0x0064, 0x006D, 0x0064,
+	0x006D, 0x0032, 0x0064, 0x006D, 0x0033, 0x0049, 0x0055, 0x5E73, 0x6210, 0x662D, 0x548C, 0x5927,
+	0x6B63, 0x660E, 0x6CBB, 0x682A, 0x5F0F, 0x4F1A, 0x793E, 0x0070, 0x0041, 0x006E, 0x0041, 0x03BC,
+	0x0041, 0x006D, 0x0041, 0x006B, 0x0041, 0x004B, 0x0042, 0x004D, 0x0042, 0x0047, 0x0042, 0x0063,
+	0x0061, 0x006C, 0x006B, 0x0063, 0x0061, 0x006C, 0x0070, 0x0046, 0x006E, 0x0046, 0x03BC, 0x0046,
+	0x03BC, 0x0067, 0x006D, 0x0067, 0x006B, 0x0067, 0x0048, 0x007A, 0x006B, 0x0048, 0x007A, 0x004D,
+	0x0048, 0x007A, 0x0047, 0x0048, 0x007A, 0x0054, 0x0048, 0x007A, 0x03BC, 0x006C, 0x006D, 0x006C,
+	0x0064, 0x006C, 0x006B, 0x006C, 0x0066, 0x006D, 0x006E, 0x006D, 0x03BC, 0x006D, 0x006D, 0x006D,
+	0x0063, 0x006D, 0x006B, 0x006D, 0x006D, 0x006D, 0x0032, 0x0063, 0x006D, 0x0032, 0x006D, 0x0032,
+	0x006B, 0x006D, 0x0032, 0x006D, 0x006D, 0x0033, 0x0063, 0x006D, 0x0033, 0x006D, 0x0033, 0x006B,
+	0x006D, 0x0033, 0x006D, 0x2215, 0x0073, 0x006D, 0x2215, 0x0073, 0x0032, 0x0050, 0x0061, 0x006B,
+	0x0050, 0x0061, 0x004D, 0x0050, 0x0061, 0x0047, 0x0050, 0x0061, 0x0072, 0x0061, 0x0064, 0x0072,
+	0x0061, 0x0064, 0x2215, 0x0073, 0x0072, 0x0061, 0x0064, 0x2215, 0x0073, 0x0032, 0x0070, 0x0073,
+	0x006E, 0x0073, 0x03BC, 0x0073, 0x006D, 0x0073, 0x0070, 0x0056, 0x006E, 0x0056, 0x03BC, 0x0056,
+	0x006D, 0x0056, 0x006B, 0x0056, 0x004D, 0x0056, 0x0070, 0x0057, 0x006E, 0x0057, 0x03BC, 0x0057,
+	0x006D, 0x0057, 0x006B, 0x0057, 0x004D, 0x0057, 0x006B, 0x03A9, 0x004D, 0x03A9, 0x0061, 0x002E,
+	0x006D, 0x002E, 0x0042, 0x0071, 0x0063, 0x0063, 0x0063, 0x0064, 0x0043, 0x2215, 0x006B, 0x0067,
+	0x0043, 0x006F, 0x002E, 0x0064, 0x0042, 0x0047, 0x0079, 0x0068, 0x0061, 0x0048, 0x0050, 0x0069,
+	0x006E, 0x004B, 0x004B, 0x004B, 0x004D, 0x006B, 0x0074, 0x006C, 0x006D, 0x006C, 0x006E, 0x006C,
+	0x006F, 0x0067, 0x006C, 0x0078, 0x006D, 0x0062, 0x006D, 0x0069, 0x006C, 0x006D, 0x006F, 0x006C,
+	0x0050, 0x0048, 0x0070, 0x002E, 0x006D, 0x002E, 0x0050, 0x0050, 0x004D, 0x0050, 0x0052, 0x0073,
+	0x0072, 0x0053, 0x0076, 0x0057, 0x0062, 0x0056, 0x2215, 0x006D, 0x0041, 0x2215, 0x006D, 0x0031,
+	0x65E5, 0x0032, 0x65E5, 0x0033, 0x65E5, 0x0034, 0x65E5, 0x0035, 0x65E5, 0x0036, 0x65E5, 0x0037,
+	0x65E5, 0x0038, 0x65E5, 0x0039, 0x65E5, 0x0031, 0x0030, 0x65E5, 0x0031, 0x0031, 0x65E5, 0x0031,
+	0x0032, 0x65E5, 0x0031, 0x0033, 0x65E5, 0x0031, 0x0034, 0x65E5, 0x0031, 0x0035, 0x65E5, 0x0031,
+	0x0036, 0x65E5, 0x0031, 0x0037, 0x65E5, 0x0031, 0x0038, 0x65E5, 0x0031, 0x0039, 0x65E5, 0x0032,
+	0x0030, 0x65E5, 0x0032, 0x0031, 0x65E5, 0x0032, 0x0032, 0x65E5, 0x0032, 0x0033, 0x65E5, 0x0032,
+	0x0034, 0x65E5, 0x0032, 0x0035, 0x65E5, 0x0032, 0x0036, 0x65E5, 0x0032, 0x0037, 0x65E5, 0x0032,
+	0x0038, 0x65E5, 0x0032, 0x0039, 0x65E5, 0x0033, 0x0030, 0x65E5, 0x0033, 0x0031, 0x65E5, 0x0067,
+	0x0061, 0x006C, 0x044A, 0x044C, 0xA76F, 0x0053, 0x0043, 0x0046, 0x0051, 0x0126, 0x0153, 0xA727,
+	0xAB37, 0x026B, 0xAB52, 0x028D, 0x8C48, 0x66F4, 0x8ECA, 0x8CC8, 0x6ED1, 0x4E32, 0x53E5, 0x9F9C,
+	0x9F9C, 0x5951, 0x91D1, 0x5587, 0x5948, 0x61F6, 0x7669, 0x7F85, 0x863F, 0x87BA, 0x88F8, 0x908F,
+	0x6A02, 0x6D1B, 0x70D9, 0x73DE, 0x843D, 0x916A, 0x99F1, 0x4E82, 0x5375, 0x6B04, 0x721B, 0x862D,
+	0x9E1E, 0x5D50, 0x6FEB, 0x85CD, 0x8964, 0x62C9, 0x81D8, 0x881F, 0x5ECA, 0x6717, 0x6D6A, 0x72FC,
+	0x90CE, 0x4F86, 0x51B7, 0x52DE, 0x64C4, 0x6AD3, 0x7210, 0x76E7, 0x8001, 0x8606, 0x865C, 0x8DEF,
+	0x9732, 0x9B6F, 0x9DFA, 0x788C, 0x797F, 0x7DA0, 0x83C9, 0x9304, 0x9E7F, 0x8AD6, 0x58DF, 0x5F04,
+	0x7C60, 0x807E, 0x7262, 0x78CA, 0x8CC2, 0x96F7, 0x58D8, 0x5C62, 0x6A13, 0x6DDA, 0x6F0F, 0x7D2F,
+	0x7E37, 0x964B, 0x52D2, 0x808B, 0x51DC, 0x51CC, 0x7A1C, 0x7DBE, 0x83F1, 0x9675, 0x8B80, 0x62CF,
+	0x6A02, 0x8AFE, 0x4E39, 0x5BE7, 0x6012, 0x7387, 0x7570, 0x5317, 0x78FB, 0x4FBF, 0x5FA9, 0x4E0D,
+	0x6CCC, 0x6578, 0x7D22, 0x53C3, 0x585E, 0x7701, 0x8449, 0x8AAA, 0x6BBA, 0x8FB0, 0x6C88, 0x62FE,
+	0x82E5, 0x63A0, 0x7565, 0x4EAE, 0x5169, 0x51C9, 0x6881, 0x7CE7, 0x826F, 0x8AD2, 0x91CF, 0x52F5,
+	0x5442, 0x5973, 0x5EEC, 0x65C5, 0x6FFE, 0x792A, 0x95AD, 0x9A6A, 0x9E97, 0x9ECE, 0x529B, 0x66C6,
+	0x6B77, 0x8F62, 0x5E74, 0x6190, 0x6200, 0x649A, 0x6F23, 0x7149, 0x7489, 0x79CA, 0x7DF4, 0x806F,
+	0x8F26, 0x84EE, 0x9023, 0x934A, 0x5217, 0x52A3, 0x54BD, 0x70C8, 0x88C2, 0x8AAA, 0x5EC9, 0x5FF5,
+	0x637B, 0x6BAE, 0x7C3E, 0x7375, 0x4EE4, 0x56F9, 0x5BE7, 0x5DBA, 0x601C, 0x73B2, 0x7469, 0x7F9A,
+	0x8046, 0x9234, 0x96F6, 0x9748, 0x9818, 0x4F8B, 0x79AE, 0x91B4, 0x96B8, 0x60E1, 0x4E86, 0x50DA,
+	0x5BEE, 0x5C3F, 0x6599, 0x6A02, 0x71CE, 0x7642, 0x84FC, 0x907C, 0x9F8D, 0x6688, 0x962E, 0x5289,
+	0x677B, 0x67F3, 0x6D41, 0x6E9C, 0x7409, 0x7559, 0x786B, 0x7D10, 0x985E, 0x516D, 0x622E, 0x9678,
+	0x502B, 0x5D19, 0x6DEA, 0x8F2A, 0x5F8B, 0x6144, 0x6817, 0x7387, 0x9686, 0x5229, 0x540F, 0x5C65,
+	0x6613, 0x674E, 0x68A8, 0x6CE5, 0x7406, 0x75E2, 0x7F79, 0x88CF, 0x88E1, 0x91CC, 0x96E2, 0x533F,
+	0x6EBA, 0x541D, 0x71D0, 0x7498, 0x85FA, 0x96A3, 0x9C57, 0x9E9F, 0x6797, 0x6DCB, 0x81E8, 0x7ACB,
+	0x7B20, 0x7C92, 0x72C0, 0x7099, 0x8B58, 0x4EC0, 0x8336, 0x523A, 0x5207, 0x5EA6, 0x62D3, 0x7CD6,
+	0x5B85, 0x6D1E, 0x66B4, 0x8F3B, 0x884C, 0x964D, 0x898B, 0x5ED3, 0x5140, 0x55C0, 0x585A, 0x6674,
+	0x51DE, 0x732A, 0x76CA, 0x793C, 0x795E, 0x7965, 0x798F, 0x9756, 0x7CBE, 0x7FBD, 0x8612, 0x8AF8,
+	0x9038, 0x90FD, 0x98EF, 0x98FC, 0x9928, 0x9DB4, 0x90DE, 0x96B7, 0x4FAE, 0x50E7, 0x514D, 0x52C9,
+	0x52E4, 0x5351, 0x559D, 0x5606, 0x5668, 0x5840, 0x58A8, 0x5C64, 0x5C6E, 0x6094, 0x6168, 0x618E,
+	0x61F2, 0x654F, 0x65E2, 0x6691, 0x6885, 0x6D77, 0x6E1A, 0x6F22, 0x716E, 0x722B, 0x7422, 0x7891,
+	0x793E, 0x7949, 0x7948, 0x7950, 0x7956, 0x795D, 0x798D, 0x798E, 0x7A40, 0x7A81, 0x7BC0, 0x7DF4,
+	0x7E09, 0x7E41, 0x7F72, 0x8005, 0x81ED, 0x8279, 0x8279, 0x8457, 0x8910, 0x8996, 0x8B01, 0x8B39,
+	0x8CD3, 0x8D08, 0x8FB6, 0x9038, 0x96E3, 0x97FF, 0x983B, 0x6075, 0x242EE, 0x8218, 0x4E26, 0x51B5,
+	0x5168, 0x4F80, 0x5145, 0x5180, 0x52C7, 0x52FA, 0x559D, 0x5555, 0x5599, 0x55E2, 0x585A, 0x58B3,
+	0x5944, 0x5954, 0x5A62, 0x5B28, 0x5ED2, 0x5ED9, 0x5F69, 0x5FAD, 0x60D8, 0x614E, 0x6108, 0x618E,
+	0x6160, 0x61F2, 0x6234, 0x63C4, 0x641C, 0x6452, 0x6556, 0x6674, 0x6717, 0x671B, 0x6756, 0x6B79,
+	0x6BBA, 0x6D41, 0x6EDB, 0x6ECB, 0x6F22, 0x701E, 0x716E, 0x77A7, 0x7235, 0x72AF, 0x732A, 0x7471,
+	0x7506, 0x753B, 0x761D, 0x761F, 0x76CA, 0x76DB, 0x76F4, 0x774A, 0x7740, 0x78CC, 0x7AB1, 0x7BC0,
+	0x7C7B, 0x7D5B, 0x7DF4, 0x7F3E, 0x8005, 0x8352, 0x83EF, 0x8779, 0x8941, 0x8986, 0x8996, 0x8ABF,
+	0x8AF8, 0x8ACB, 0x8B01, 0x8AFE, 0x8AED, 0x8B39, 0x8B8A, 0x8D08, 0x8F38, 0x9072, 0x9199, 0x9276,
+	0x967C, 0x96E3, 0x9756, 0x97DB, 0x97FF, 0x980B, 0x983B, 0x9B12, 0x9F9C, 0x2284A, 0x22844, 0x233D5,
+	0x3B9D, 0x4018, 0x4039, 0x25249, 0x25CD0, 0x27ED3, 0x9F43, 0x9F8E, 0x0066, 0x0066, 0x0066, 0x0069,
+	0x0066, 0x006C, 0x0066, 0x0066, 0x0069, 0x0066, 0x0066, 0x006C, 0x0073, 0x0074, 0x0073, 0x0074,
+	0x0574, 0x0576, 0x0574, 0x0565, 0x0574, 0x056B, 0x057E, 0x0576, 0x0574, 0x056D, 0x05D9, 0x05B4,
+	0x05F2, 0x05B7, 0x05E2, 0x05D0, 0x05D3, 0x05D4, 0x05DB, 0x05DC, 0x05DD, 0x05E8, 0x05EA, 0x002B,
+	0x05E9, 0x05C1, 0x05E9, 0x05C2, 0x05E9, 0x05BC, 0x05C1, 0x05E9, 0x05BC, 0x05C2, 0x05D0, 0x05B7,
+	0x05D0, 0x05B8, 0x05D0, 0x05BC, 0x05D1, 0x05BC, 0x05D2, 0x05BC, 0x05D3, 0x05BC, 0x05D4, 0x05BC,
+	0x05D5, 0x05BC, 0x05D6, 0x05BC, 0x05D8, 0x05BC, 0x05D9, 0x05BC, 0x05DA, 0x05BC, 0x05DB, 0x05BC,
+	0x05DC, 0x05BC, 0x05DE, 0x05BC, 0x05E0, 0x05BC, 0x05E1, 0x05BC, 0x05E3, 0x05BC, 0x05E4, 0x05BC,
+	0x05E6, 0x05BC, 0x05E7, 0x05BC, 0x05E8, 0x05BC, 0x05E9, 0x05BC, 0x05EA, 0x05BC, 0x05D5, 0x05B9,
+	0x05D1, 0x05BF, 0x05DB, 0x05BF, 0x05E4, 0x05BF, 0x05D0, 0x05DC, 0x0671, 0x0671, 0x067B, 0x067B,
+	0x067B, 0x067B, 0x067E, 0x067E, 0x067E, 0x067E, 0x0680, 0x0680, 0x0680, 0x0680, 0x067A, 0x067A,
+	0x067A, 0x067A, 0x067F, 0x067F, 0x067F, 0x067F, 0x0679, 0x0679, 0x0679, 0x0679, 0x06A4, 0x06A4,
+	0x06A4, 0x06A4, 0x06A6, 0x06A6, 0x06A6, 0x06A6, 0x0684, 0x0684, 0x0684, 0x0684, 0x0683, 0x0683,
+	0x0683, 0x0683, 0x0686, 0x0686, 0x0686, 0x0686, 0x0687, 0x0687, 0x0687, 0x0687, 0x068D, 0x068D,
+	0x068C, 0x068C, 0x068E, 0x068E, 0x0688, 0x0688, 0x0698, 0x0698, 0x0691, 0x0691, 0x06A9, 0x06A9,
+	0x06A9, 0x06A9, 0x06AF, 0x06AF, 0x06AF, 0x06AF, 0x06B3, 0x06B3, 0x06B3, 0x06B3, 0x06B1, 0x06B1,
+	0x06B1, 0x06B1, 0x06BA, 0x06BA, 0x06BB, 0x06BB, 0x06BB, 0x06BB, 0x06D5, 0x0654, 0x06D5, 0x0654,
+	0x06C1, 0x06C1, 0x06C1, 0x06C1, 0x06BE, 0x06BE, 0x06BE, 0x06BE, 0x06D2, 0x06D2, 0x06D2, 0x0654,
+	0x06D2, 0x0654, 0x06AD, 0x06AD, 0x06AD, 0x06AD, 0x06C7, 0x06C7, 0x06C6, 0x06C6, 0x06C8, 0x06C8,
+	0x06C7, 0x0674, 0x06CB, 0x06CB, 0x06C5, 0x06C5, 0x06C9, 0x06C9, 0x06D0, 0x06D0, 0x06D0, 0x06D0,
+	0x0649, 0x0649, 0x064A, 0x0654, 0x0627, 0x064A, 0x0654, 0x0627, 0x064A, 0x0654, 0x06D5, 0x064A,
+	0x0654, 0x06D5, 0x064A, 0x0654, 0x0648, 0x064A, 0x0654, 0x0648, 0x064A, 0x0654, 0x06C7, 0x064A,
+	0x0654, 0x06C7, 0x064A, 0x0654, 0x06C6, 0x064A, 0x0654, 0x06C6, 0x064A, 0x0654, 0x06C8, 0x064A,
+	0x0654, 0x06C8, 0x064A, 0x0654, 0x06D0, 0x064A, 0x0654, 0x06D0, 0x064A, 0x0654, 0x06D0, 0x064A,
+	0x0654, 0x0649, 0x064A, 0x0654, 0x0649, 0x064A, 0x0654, 0x0649, 0x06CC, 0x06CC, 0x06CC, 0x06CC,
+	0x064A, 0x0654, 0x062C, 0x064A, 0x0654, 0x062D, 0x064A, 0x0654, 0x0645, 0x064A, 0x0654, 0x0649,
+	0x064A, 0x0654, 0x064A, 0x0628, 0x062C, 0x0628, 0x062D, 0x0628, 0x062E, 0x0628, 0x0645, 0x0628,
+	0x0649, 0x0628, 0x064A, 0x062A, 0x062C, 0x062A, 0x062D, 0x062A, 0x062E, 0x062A, 0x0645, 0x062A,
+	0x0649, 0x062A, 0x064A, 0x062B, 0x062C, 0x062B, 0x0645, 0x062B, 0x0649, 0x062B, 0x064A, 0x062C,
+	0x062D, 0x062C, 0x0645, 0x062D, 0x062C, 0x062D, 0x0645, 0x062E, 0x062C, 0x062E, 0x062D, 0x062E,
+	0x0645, 0x0633, 0x062C, 0x0633, 0x062D, 0x0633, 0x062E, 0x0633, 0x0645, 0x0635, 0x062D, 0x0635,
+	0x0645, 0x0636, 0x062C, 0x0636, 0x062D, 0x0636, 0x062E, 0x0636, 0x0645, 0x0637, 0x062D, 0x0637,
+	0x0645, 0x0638, 0x0645, 0x0639, 0x062C, 0x0639, 0x0645, 0x063A, 0x062C, 0x063A, 0x0645, 0x0641,
+	0x062C, 0x0641, 0x062D, 0x0641, 0x062E, 0x0641, 0x0645, 0x0641, 0x0649, 0x0641, 0x064A, 0x0642,
+	0x062D, 0x0642, 0x0645, 0x0642, 0x0649, 0x0642, 0x064A, 0x0643, 0x0627, 0x0643, 0x062C, 0x0643,
+	0x062D, 0x0643, 0x062E, 0x0643, 0x0644, 0x0643, 0x0645, 0x0643, 0x0649, 0x0643, 0x064A, 0x0644,
+	0x062C, 0x0644, 0x062D, 0x0644, 0x062E, 0x0644, 0x0645, 0x0644, 0x0649, 0x0644, 0x064A, 0x0645,
+	0x062C, 0x0645, 0x062D, 0x0645, 0x062E, 0x0645, 0x0645, 0x0645, 0x0649, 0x0645, 0x064A, 0x0646,
+	0x062C, 0x0646, 0x062D, 0x0646, 0x062E, 0x0646, 0x0645, 0x0646, 0x0649, 0x0646, 0x064A, 0x0647,
+	0x062C, 0x0647, 0x0645, 0x0647, 0x0649, 0x0647, 0x064A, 0x064A, 0x062C, 0x064A, 0x062D, 0x064A,
+	0x062E, 0x064A, 0x0645, 0x064A, 0x0649, 0x064A, 0x064A, 0x0630, 0x0670, 0x0631, 0x0670, 0x0649,
+	0x0670, 0x0020, 0x064C, 0x0651, 0x0020, 0x064D, 0x0651, 0x0020, 0x064E, 0x0651, 0x0020, 0x064F,
+	0x0651, 0x0020, 0x0650, 0x0651, 0x0020, 0x0651, 0x0670, 0x064A, 0x0654, 0x0631, 0x064A, 0x0654,
+	0x0632, 0x064A, 0x0654, 0x0645, 0x064A, 0x0654, 0x0646, 0x064A, 0x0654, 0x0649, 0x064A, 0x0654,
+	0x064A, 0x0628, 0x0631, 0x0628, 0x0632, 0x0628, 0x0645, 0x0628, 0x0646, 0x0628, 0x0649, 0x0628,
+	0x064A, 0x062A, 0x0631, 0x062A, 0x0632, 0x062A, 0x0645, 0x062A, 0x0646, 0x062A, 0x0649, 0x062A,
+	0x064A, 0x062B, 0x0631, 0x062B, 0x0632, 0x062B, 0x0645, 0x062B, 0x0646, 0x062B, 0x0649, 0x062B,
+	0x064A, 0x0641, 0x0649, 0x0641, 0x064A, 0x0642, 0x0649, 0x0642, 0x064A, 0x0643, 0x0627, 0x0643,
+	0x0644, 0x0643, 0x0645, 0x0643, 0x0649, 0x0643, 0x064A, 0x0644, 0x0645, 0x0644, 0x0649, 0x0644,
+	0x064A, 0x0645, 0x0627, 0x0645, 0x0645, 0x0646, 0x0631, 0x0646, 0x0632, 0x0646, 0x0645, 0x0646,
+	0x0646, 0x0646, 0x0649, 0x0646, 0x064A, 0x0649, 0x0670, 0x064A, 0x0631, 0x064A, 0x0632, 0x064A,
+	0x0645, 0x064A, 0x0646, 0x064A, 0x0649, 0x064A, 0x064A, 0x064A, 0x0654, 0x062C, 0x064A, 0x0654,
+	0x062D, 0x064A, 0x0654, 0x062E, 0x064A, 0x0654, 0x0645, 0x064A, 0x0654, 0x0647, 0x0628, 0x062C,
+	0x0628, 0x062D, 0x0628, 0x062E, 0x0628, 0x0645, 0x0628, 0x0647, 0x062A, 0x062C, 0x062A, 0x062D,
+	0x062A, 0x062E, 0x062A, 0x0645, 0x062A, 0x0647, 0x062B, 0x0645, 0x062C, 0x062D, 0x062C, 0x0645,
+	0x062D, 0x062C, 0x062D, 0x0645, 0x062E, 0x062C, 0x062E, 0x0645, 0x0633, 0x062C, 0x0633, 0x062D,
+	0x0633, 0x062E, 0x0633, 0x0645, 0x0635, 0x062D, 0x0635, 0x062E, 0x0635, 0x0645, 0x0636, 0x062C,
+	0x0636, 0x062D, 0x0636, 0x062E, 0x0636, 0x0645, 0x0637, 0x062D, 0x0638, 0x0645, 0x0639, 0x062C,
+	0x0639, 0x0645, 0x063A, 0x062C, 0x063A, 0x0645, 0x0641, 0x062C, 0x0641, 0x062D, 0x0641, 0x062E,
+	0x0641, 0x0645, 0x0642, 0x062D, 0x0642, 0x0645, 0x0643, 0x062C, 0x0643, 0x062D, 0x0643, 0x062E,
+	0x0643, 0x0644, 0x0643, 0x0645, 0x0644, 0x062C, 0x0644, 0x062D, 0x0644, 0x062E, 0x0644, 0x0645,
+	0x0644, 0x0647, 0x0645, 0x062C, 0x0645, 0x062D, 0x0645, 0x062E, 0x0645, 0x0645, 0x0646, 0x062C,
+	0x0646, 0x062D, 0x0646, 0x062E, 0x0646, 0x0645, 0x0646, 0x0647, 0x0647, 0x062C, 0x0647, 0x0645,
+	0x0647, 0x0670, 0x064A, 0x062C, 0x064A, 0x062D, 0x064A, 0x062E, 0x064A, 0x0645, 0x064A, 0x0647,
+	0x064A, 0x0654, 0x0645, 0x064A, 0x0654, 0x0647, 0x0628, 0x0645, 0x0628, 0x0647, 0x062A, 0x0645,
+	0x062A, 0x0647, 0x062B, 0x0645, 0x062B, 0x0647, 0x0633, 0x0645, 0x0633, 0x0647, 0x0634, 0x0645,
+	0x0634, 0x0647, 0x0643, 0x0644, 0x0643, 0x0645, 0x0644, 0x0645, 0x0646, 0x0645, 0x0646, 0x0647,
+	0x064A, 0x0645, 0x064A, 0x0647, 0x0640, 0x064E, 0x0651, 0x0640, 0x064F, 0x0651, 0x0640, 0x0650,
+	0x0651, 0x0637, 0x0649, 0x0637, 0x064A, 0x0639, 0x0649, 0x0639, 0x064A, 0x063A, 0x0649, 0x063A,
+	0x064A, 0x0633, 0x0649, 0x0633, 0x064A, 0x0634, 0x0649, 0x0634, 0x064A, 0x062D, 0x0649, 0x062D,
+	0x064A, 0x062C, 0x0649, 0x062C, 0x064A, 0x062E, 0x0649, 0x062E, 0x064A, 0x0635, 0x0649, 0x0635,
+	0x064A, 0x0636, 0x0649, 0x0636, 0x064A, 0x0634, 0x062C, 0x0634, 0x062D, 0x0634, 0x062E, 0x0634,
+	0x0645, 0x0634, 0x0631, 0x0633, 0x0631, 0x0635, 0x0631, 0x0636, 0x0631, 0x0637, 0x0649, 0x0637,
+	0x064A, 0x0639, 0x0649, 0x0639, 0x064A, 0x063A, 0x0649, 0x063A, 0x064A, 0x0633, 0x0649, 0x0633,
+	0x064A, 0x0634, 0x0649, 0x0634, 0x064A, 0x062D, 0x0649, 0x062D, 0x064A, 0x062C, 0x0649, 0x062C,
+	0x064A, 0x062E, 0x0649, 0x062E, 0x064A, 0x0635, 0x0649, 0x0635, 0x064A, 0x0636, 0x0649, 0x0636,
+	0x064A, 0x0634, 0x062C, 0x0634, 0x062D, 0x0634, 0x062E, 0x0634, 0x0645, 0x0634, 0x0631, 0x0633,
+	0x0631, 0x0635, 0x0631, 0x0636, 0x0631, 0x0634, 0x062C, 0x0634, 0x062D, 0x0634, 0x062E, 0x0634,
+	0x0645, 0x0633, 0x0647, 0x0634, 0x0647, 0x0637, 0x0645, 0x0633, 0x062C, 0x0633, 0x062D, 0x0633,
+	0x062E, 0x0634, 0x062C, 0x0634, 0x062D, 0x0634, 0x062E, 0x0637, 0x0645, 0x0638, 0x0645, 0x0627,
+	0x064B, 0x0627, 0x064B, 0x062A, 0x062C, 0x0645, 0x062A, 0x062D, 0x062C, 0x062A, 0x062D, 0x062C,
+	0x062A, 0x062D, 0x0645, 0x062A, 0x062E, 0x0645, 0x062A, 0x0645, 0x062C, 0x062A, 0x0645, 0x062D,
+	0x062A, 0x0645, 0x062E, 0x062C, 0x0645, 0x062D, 0x062C, 0x0645, 0x062D, 0x062D, 0x0645, 0x064A,
+	0x062D, 0x0645, 0x0649, 0x0633, 0x062D, 0x062C, 0x0633, 0x062C, 0x062D, 0x0633, 0x062C, 0x0649,
+	0x0633, 0x0645, 0x062D, 0x0633, 0x0645, 0x062D, 0x0633, 0x0645, 0x062C, 0x0633, 0x0645, 0x0645,
+	0x0633, 0x0645, 0x0645, 0x0635, 0x062D, 0x062D, 0x0635, 0x062D, 0x062D, 0x0635, 0x0645, 0x0645,
+	0x0634, 0x062D, 0x0645, 0x0634, 0x062D, 0x0645, 0x0634, 0x062C, 0x064A, 0x0634, 0x0645, 0x062E,
+	0x0634, 0x0645, 0x062E, 0x0634, 0x0645, 0x0645, 0x0634, 0x0645, 0x0645, 0x0636, 0x062D, 0x0649,
+	0x0636, 0x062E, 0x0645, 0x0636, 0x062E, 0x0645, 0x0637, 0x0645, 0x062D, 0x0637, 0x0645, 0x062D,
+	0x0637, 0x0645, 0x0645, 0x0637, 0x0645, 0x064A, 0x0639, 0x062C, 0x0645, 0x0639, 0x0645, 0x0645,
+	0x0639, 0x0645, 0x0645, 0x0639, 0x0645, 0x0649, 0x063A, 0x0645, 0x0645, 0x063A, 0x0645, 0x064A,
+	0x063A, 0x0645, 0x0649, 0x0641, 0x062E, 0x0645, 0x0641, 0x062E, 0x0645, 0x0642, 0x0645, 0x062D,
+	0x0642, 0x0645, 0x0645, 0x0644, 0x062D, 0x0645, 0x0644, 0x062D, 0x064A, 0x0644, 0x062D, 0x0649,
+	0x0644, 0x062C, 0x062C, 0x0644, 0x062C, 0x062C, 0x0644, 0x062E, 0x0645, 0x0644, 0x062E, 0x0645,
+	0x0644, 0x0645, 0x062D, 0x0644, 0x0645, 0x062D, 0x0645, 0x062D, 0x062C, 0x0645, 0x062D, 0x0645,
+	0x0645, 0x062D, 0x064A, 0x0645, 0x062C, 0x062D, 0x0645, 0x062C, 0x0645, 0x0645, 0x062E, 0x062C,
+	0x0645, 0x062E, 0x0645, 0x0645, 0x062C, 0x062E, 0x0647, 0x0645, 0x062C, 0x0647, 0x0645, 0x0645,
+	0x0646, 0x062D, 0x0645, 0x0646, 0x062D, 0x0649, 0x0646, 0x062C, 0x0645, 0x0646, 0x062C, 0x0645,
+	0x0646, 0x062C, 0x0649, 0x0646, 0x0645, 0x064A, 0x0646, 0x0645, 0x0649, 0x064A, 0x0645, 0x0645,
+	0x064A, 0x0645, 0x0645, 0x0628, 0x062E, 0x064A, 0x062A, 0x062C, 0x064A, 0x062A, 0x062C, 0x0649,
+	0x062A, 0x062E, 0x064A, 0x062A, 0x062E, 0x0649, 0x062A, 0x0645, 0x064A, 0x062A, 0x0645, 0x0649,
+	0x062C, 0x0645, 0x064A, 0x062C, 0x062D, 0x0649, 0x062C, 0x0645, 0x0649, 0x0633, 0x062E, 0x0649,
+	0x0635, 0x062D, 0x064A, 0x0634, 0x062D, 0x064A, 0x0636, 0x062D, 0x064A, 0x0644, 0x062C, 0x064A,
+	0x0644, 0x0645, 0x064A, 0x064A, 0x062D, 0x064A, 0x064A, 0x062C, 0x064A, 0x064A, 0x0645, 0x064A,
+	0x0645, 0x0645, 0x064A, 0x0642, 0x0645, 0x064A, 0x0646, 0x062D, 0x064A, 0x0642, 0x0645, 0x062D,
+	0x0644, 0x062D, 0x0645, 0x0639, 0x0645, 0x064A, 0x0643, 0x0645, 0x064A, 0x0646, 0x062C, 0x062D,
+	0x0645, 0x062E, 0x064A, 0x0644, 0x062C, 0x0645, 0x0643, 0x0645, 0x0645, 0x0644, 0x062C, 0x0645,
+	0x0646, 0x062C, 0x062D, 0x062C, 0x062D, 0x064A, 0x062D, 0x062C, 0x064A, 0x0645, 0x062C, 0x064A,
+	0x0641, 0x0645, 0x064A, 0x0628, 0x062D, 0x064A, 0x0643, 0x0645, 0x0645, 0x0639, 0x062C, 0x0645,
+	0x0635, 0x0645, 0x0645, 0x0633, 0x062E, 0x064A, 0x0646, 0x062C, 0x064A, 0x0635, 0x0644, 0x06D2,
+	0x0642, 0x0644, 0x06D2, 0x0627, 0x0644, 0x0644, 0x0647, 0x0627, 0x0643, 0x0628, 0x0631, 0x0645,
+	0x062D, 0x0645, 0x062F, 0x0635, 0x0644, 0x0639, 0x0645, 0x0631, 0x0633, 0x0648, 0x0644, 0x0639,
+	0x0644, 0x064A, 0x0647, 0x0648, 0x0633, 0x0644, 0x0645, 0x0635, 0x0644, 0x0649, 0x0635, 0x0644,
+	0x0649, 0x0020, 0x0627, 0x0644, 0x0644, 0x0647, 0x0020, 0x0639, 0x0644, 0x064A, 0x0647, 0x0020,
+	0x0648, 0x0633, 0x0644, 0x0645, 0x062C, 0x0644, 0x0020, 0x062C, 0x0644, 0x0627, 0x0644, 0x0647,
+	0x0631, 0x06CC, 0x0627, 0x0644, 0x002C, 0x3001, 0x3002, 0x003A, 0x003B, 0x0021, 0x003F, 0x3016,
+	0x3017, 0x002E, 0x002E, 0x002E, 0x002E, 0x002E, 0x2014, 0x2013, 0x005F, 0x005F, 0x0028, 0x0029,
+	0x007B, 0x007D, 0x3014, 0x3015, 0x3010, 0x3011, 0x300A, 0x300B, 0x3008, 0x3009, 0x300C, 0x300D,
+	0x300E, 0x300F, 0x005B, 0x005D, 0x0020, 0x0305, 0x0020, 0x0305, 0x0020, 0x0305, 0x0020, 0x0305,
+	0x005F, 0x005F, 0x005F, 0x002C, 0x3001, 0x002E, 0x003B, 0x003A, 0x003F, 0x0021, 0x2014, 0x0028,
+	0x0029, 0x007B, 0x007D, 0x3014, 0x3015, 0x0023, 0x0026, 0x002A, 0x002B, 0x002D, 0x003C, 0x003E,
+	0x003D, 0x005C, 0x0024, 0x0025, 0x0040, 0x0020, 0x064B, 0x0640, 0x064B, 0x0020, 0x064C, 0x0020,
+	0x064D, 0x0020, 0x064E, 0x0640, 0x064E, 0x0020, 0x064F, 0x0640, 0x064F, 0x0020, 0x0650, 0x0640,
+	0x0650, 0x0020, 0x0651, 0x0640, 0x0651, 0x0020, 0x0652, 0x0640, 0x0652, 0x0621, 0x0627, 0x0653,
+	0x0627, 0x0653, 0x0627, 0x0654, 0x0627, 0x0654, 0x0648, 0x0654, 0x0648, 0x0654, 0x0627, 0x0655,
+	0x0627, 0x0655, 0x064A, 0x0654, 0x064A, 0x0654, 0x064A, 0x0654, 0x064A, 0x0654, 0x0627, 0x0627,
+	0x0628, 0x0628, 0x0628, 0x0628, 0x0629, 0x0629, 0x062A, 0x062A, 0x062A, 0x062A, 0x062B, 0x062B,
+	0x062B, 0x062B, 0x062C, 0x062C, 0x062C, 0x062C, 0x062D, 0x062D, 0x062D, 0x062D, 0x062E, 0x062E,
+	0x062E, 0x062E, 0x062F, 0x062F, 0x0630, 0x0630, 0x0631, 0x0631, 0x0632, 0x0632, 0x0633, 0x0633,
+	0x0633, 0x0633, 0x0634, 0x0634, 0x0634, 0x0634, 0x0635, 0x0635, 0x0635, 0x0635, 0x0636, 0x0636,
+	0x0636, 0x0636, 0x0637, 0x0637, 0x0637, 0x0637, 0x0638, 0x0638, 0x0638, 0x0638, 0x0639, 0x0639,
+	0x0639, 0x0639, 0x063A, 0x063A, 0x063A, 0x063A, 0x0641, 0x0641, 0x0641, 0x0641, 0x0642, 0x0642,
+	0x0642, 0x0642, 0x0643, 0x0643, 0x0643, 0x0643, 0x0644, 0x0644, 0x0644, 0x0644, 0x0645, 0x0645,
+	0x0645, 0x0645, 0x0646, 0x0646, 0x0646, 0x0646, 0x0647, 0x0647, 0x0647, 0x0647, 0x0648, 0x0648,
+	0x0649, 0x0649, 0x064A, 0x064A, 0x064A, 0x064A, 0x0644, 0x0627, 0x0653, 0x0644, 0x0627, 0x0653,
+	0x0644, 0x0627, 0x0654, 0x0644, 0x0627, 0x0654, 0x0644, 0x0627, 0x0655, 0x0644, 0x0627, 0x0655,
+	0x0644, 0x0627, 0x0644, 0x0627, 0x0021, 0x0022, 0x0023, 0x0024, 0x0025, 0x0026, 0x0027, 0x0028,
+	0x0029, 0x002A, 0x002B, 0x002C, 0x002D, 0x002E, 0x002F, 0x0030, 0x0031, 0x0032, 0x0033, 0x0034,
+	0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x003A, 0x003B, 0x003C, 0x003D, 0x003E, 0x003F, 0x0040,
+	0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C,
+	0x004D, 0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x005B, 0x005C, 0x005D, 0x005E, 0x005F, 0x0060, 0x0061, 0x0062, 0x0063, 0x0064,
+	0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070,
+	0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x007B, 0x007C,
+	0x007D, 0x007E, 0x2985, 0x2986, 0x3002, 0x300C, 0x300D, 0x3001, 0x30FB, 0x30F2, 0x30A1, 0x30A3,
+	0x30A5, 0x30A7, 0x30A9, 0x30E3, 0x30E5, 0x30E7, 0x30C3, 0x30FC, 0x30A2, 0x30A4, 0x30A6, 0x30A8,
+	0x30AA, 0x30AB, 0x30AD, 0x30AF, 0x30B1, 0x30B3, 0x30B5, 0x30B7, 0x30B9, 0x30BB, 0x30BD, 0x30BF,
+	0x30C1, 0x30C4, 0x30C6, 0x30C8, 0x30CA, 0x30CB, 0x30CC, 0x30CD, 0x30CE, 0x30CF, 0x30D2, 0x30D5,
+	0x30D8, 0x30DB, 0x30DE, 0x30DF, 0x30E0, 0x30E1, 0x30E2, 0x30E4, 0x30E6, 0x30E8, 0x30E9, 0x30EA,
+	0x30EB, 0x30EC, 0x30ED, 0x30EF, 0x30F3, 0x3099, 0x309A, 0x1160, 0x1100, 0x1101, 0x11AA, 0x1102,
+	0x11AC, 0x11AD, 0x1103, 0x1104, 0x1105, 0x11B0, 0x11B1, 0x11B2, 0x11B3, 0x11B4, 0x11B5, 0x111A,
+	0x1106, 0x1107, 0x1108, 0x1121, 0x1109, 0x110A, 0x110B, 0x110C, 0x110D, 0x110E, 0x110F, 0x1110,
+	0x1111, 0x1112, 0x1161, 0x1162, 0x1163, 0x1164, 0x1165, 0x1166, 0x1167, 0x1168, 0x1169, 0x116A,
+	0x116B, 0x116C, 0x116D, 0x116E, 0x116F, 0x1170, 0x1171, 0x1172, 0x1173, 0x1174, 0x1175, 0x00A2,
+	0x00A3, 0x00AC, 0x0020, 0x0304, 0x00A6, 0x00A5, 0x20A9, 0x2502, 0x2190, 0x2191, 0x2192, 0x2193,
+	0x25A0, 0x25CB, 0x105D2, 0x0307, 0x105DA, 0x0307, 0x02D0, 0x02D1, 0x00E6, 0x0299, 0x0253, 0x02A3,
+	0xAB66, 0x02A5, 0x02A4, 0x0256, 0x0257, 0x1D91, 0x0258, 0x025E, 0x02A9, 0x0264, 0x0262, 0x0260,
+	0x029B, 0x0127, 0x029C, 0x0267, 0x0284, 0x02AA, 0x02AB, 0x026C, 0x1DF04, 0xA78E, 0x026E, 0x1DF05,
+	0x028E, 0x1DF06, 0x00F8, 0x0276, 0x0277, 0x0071, 0x027A, 0x1DF08, 0x027D, 0x027E, 0x0280, 0x02A8,
+	0x02A6, 0xAB67, 0x02A7, 0x0288, 0x2C71, 0x028F, 0x02A1, 0x02A2, 0x0298, 0x01C0, 0x01C1, 0x01C2,
+	0x1DF0A, 0x1DF1E, 0x11099, 0x110BA, 0x1109B, 0x110BA, 0x110A5, 0x110BA, 0x11131, 0x11127, 0x11132, 0x11127,
+	0x11347, 0x1133E, 0x11347, 0x11357, 0x11382, 0x113C9, 0x11384, 0x113BB, 0x1138B, 0x113C2, 0x11390, 0x113C9,
+	0x113C2, 0x113C2, 0x113C2, 0x113B8, 0x113C2, 0x113C9, 0x114B9, 0x114BA, 0x114B9, 0x114B0, 0x114B9, 0x114BD,
+	0x115B8, 0x115AF, 0x115B9, 0x115AF, 0x11935, 0x11930, 0x1611E, 0x1611E, 0x1611E, 0x16129, 0x1611E, 0x1611F,
+	0x16129, 0x1611F, 0x1611E, 0x16120, 0x1611E, 0x1611E, 0x1611F, 0x1611E, 0x16129, 0x1611F, 0x1611E, 0x1611E,
+	0x16120, 0x16D67, 0x16D67, 0x16D63, 0x16D67, 0x16D63, 0x16D67, 0x16D67, 0x0041, 0x0042, 0x0043, 0x0044,
+	0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0030, 0x0031,
+	0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x1D157, 0x1D165, 0x1D158, 0x1D165,
+	0x1D158, 0x1D165, 0x1D16E, 0x1D158, 0x1D165, 0x1D16F, 0x1D158, 0x1D165, 0x1D170, 0x1D158, 0x1D165, 0x1D171,
+	0x1D158, 0x1D165, 0x1D172, 0x1D1B9, 0x1D165, 0x1D1BA, 0x1D165, 0x1D1B9, 0x1D165, 0x1D16E, 0x1D1BA, 0x1D165,
+	0x1D16E, 0x1D1B9, 0x1D165, 0x1D16F, 0x1D1BA, 0x1D165, 0x1D16F, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045,
+	0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051,
+	0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0061, 0x0062, 0x0063,
+	0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F,
+	0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041,
+	0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D,
+	0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059,
+	0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0069, 0x006A, 0x006B, 0x006C,
+	0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A,
+	0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056,
+	0x0057, 0x0058, 0x0059, 0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068,
+	0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074,
+	0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041, 0x0043, 0x0044, 0x0047, 0x004A, 0x004B,
+	0x004E, 0x004F, 0x0050, 0x0051, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A,
+	0x0061, 0x0062, 0x0063, 0x0064, 0x0066, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E,
+	0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041,
+	0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D,
+	0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059,
+	0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B,
+	0x006C, 0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077,
+	0x0078, 0x0079, 0x007A, 0x0041, 0x0042, 0x0044, 0x0045, 0x0046, 0x0047, 0x004A, 0x004B, 0x004C,
+	0x004D, 0x004E, 0x004F, 0x0050, 0x0051, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059,
+	0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C,
+	0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0x0041, 0x0042, 0x0044, 0x0045, 0x0046, 0x0047, 0x0049, 0x004A, 0x004B, 0x004C,
+	0x004D, 0x004F, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x0061, 0x0062, 0x0063,
+	0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F,
+	0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041,
+	0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D,
+	0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059,
+	0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B,
+	0x006C, 0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077,
+	0x0078, 0x0079, 0x007A, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049,
+	0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055,
+	0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073,
+	0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045,
+	0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051,
+	0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0061, 0x0062, 0x0063,
+	0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F,
+	0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041,
+	0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D,
+	0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059,
+	0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B,
+	0x006C, 0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077,
+	0x0078, 0x0079, 0x007A, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048, 0x0049,
+	0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055,
+	0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070, 0x0071, 0x0072, 0x0073,
+	0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045,
+	0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051,
+	0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0061, 0x0062, 0x0063,
+	0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F,
+	0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007A, 0x0131,
+	0x0237, 0x0391, 0x0392, 0x0393, 0x0394, 0x0395, 0x0396, 0x0397, 0x0398, 0x0399, 0x039A, 0x039B,
+	0x039C, 0x039D, 0x039E, 0x039F, 0x03A0, 0x03A1, 0x0398, 0x03A3, 0x03A4, 0x03A5, 0x03A6, 0x03A7,
+	0x03A8, 0x03A9, 0x2207, 0x03B1, 0x03B2, 0x03B3, 0x03B4, 0x03B5, 0x03B6, 0x03B7, 0x03B8, 0x03B9,
+	0x03BA, 0x03BB, 0x03BC, 0x03BD, 0x03BE, 0x03BF, 0x03C0, 0x03C1, 0x03C2, 0x03C3, 0x03C4, 0x03C5,
+	0x03C6, 0x03C7, 0x03C8, 0x03C9, 0x2202, 0x03B5, 0x03B8, 0x03BA, 0x03C6, 0x03C1, 0x03C0, 0x0391,
+	0x0392, 0x0393, 0x0394, 0x0395, 0x0396, 0x0397, 0x0398, 0x0399, 0x039A, 0x039B, 0x039C, 0x039D,
+	0x039E, 0x039F, 0x03A0, 0x03A1, 0x0398, 0x03A3, 0x03A4, 0x03A5, 0x03A6, 0x03A7, 0x03A8, 0x03A9,
+	0x2207, 0x03B1, 0x03B2, 0x03B3, 0x03B4, 0x03B5, 0x03B6, 0x03B7, 0x03B8, 0x03B9, 0x03BA, 0x03BB,
+	0x03BC, 0x03BD, 0x03BE, 0x03BF, 0x03C0, 0x03C1, 0x03C2, 0x03C3, 0x03C4, 0x03C5, 0x03C6, 0x03C7,
+	0x03C8, 0x03C9, 0x2202, 0x03B5, 0x03B8, 0x03BA, 0x03C6, 0x03C1, 0x03C0, 0x0391, 0x0392, 0x0393,
+	0x0394, 0x0395, 0x0396, 0x0397, 0x0398, 0x0399, 0x039A, 0x039B, 0x039C, 0x039D, 0x039E, 0x039F,
+	0x03A0, 0x03A1, 0x0398, 0x03A3, 0x03A4, 0x03A5, 0x03A6, 0x03A7, 0x03A8, 0x03A9, 0x2207, 0x03B1,
+	0x03B2, 0x03B3, 0x03B4, 0x03B5, 0x03B6, 0x03B7, 0x03B8, 0x03B9, 0x03BA, 0x03BB, 0x03BC, 0x03BD,
+	0x03BE, 0x03BF, 0x03C0, 0x03C1, 0x03C2, 0x03C3, 0x03C4, 0x03C5, 0x03C6, 0x03C7, 0x03C8, 0x03C9,
+	0x2202, 0x03B5, 0x03B8, 0x03BA, 0x03C6, 0x03C1, 0x03C0, 0x0391, 0x0392, 0x0393, 0x0394, 0x0395,
+	0x0396, 0x0397, 0x0398, 0x0399, 0x039A, 0x039B, 0x039C, 0x039D, 0x039E, 0x039F, 0x03A0, 0x03A1,
+	0x0398, 0x03A3, 0x03A4, 0x03A5, 0x03A6, 0x03A7, 0x03A8, 0x03A9, 0x2207, 0x03B1, 0x03B2, 0x03B3,
+	0x03B4, 0x03B5, 0x03B6, 0x03B7, 0x03B8, 0x03B9, 0x03BA, 0x03BB, 0x03BC, 0x03BD, 0x03BE, 0x03BF,
+	0x03C0, 0x03C1, 0x03C2, 0x03C3, 0x03C4, 0x03C5, 0x03C6, 0x03C7, 0x03C8, 0x03C9, 0x2202, 0x03B5,
+	0x03B8, 0x03BA, 0x03C6, 0x03C1, 0x03C0, 0x0391, 0x0392, 0x0393, 0x0394, 0x0395, 0x0396, 0x0397,
+	0x0398, 0x0399, 0x039A, 0x039B, 0x039C, 0x039D, 0x039E, 0x039F, 0x03A0, 0x03A1, 0x0398, 0x03A3,
+	0x03A4, 0x03A5, 0x03A6, 0x03A7, 0x03A8, 0x03A9, 0x2207, 0x03B1, 0x03B2, 0x03B3, 0x03B4, 0x03B5,
+	0x03B6, 0x03B7, 0x03B8, 0x03B9, 0x03BA, 0x03BB, 0x03BC, 0x03BD, 0x03BE, 0x03BF, 0x03C0, 0x03C1,
+	0x03C2, 0x03C3, 0x03C4, 0x03C5, 0x03C6, 0x03C7, 0x03C8, 0x03C9, 0x2202, 0x03B5, 0x03B8, 0x03BA,
+	0x03C6, 0x03C1, 0x03C0, 0x03DC, 0x03DD, 0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036,
+	0x0037, 0x0038, 0x0039, 0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038,
+	0x0039, 0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x0030,
+	0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x0030, 0x0031, 0x0032,
+	0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x0430, 0x0431, 0x0432, 0x0433, 0x0434,
+	0x0435, 0x0436, 0x0437, 0x0438, 0x043A, 0x043B, 0x043C, 0x043E, 0x043F, 0x0440, 0x0441, 0x0442,
+	0x0443, 0x0444, 0x0445, 0x0446, 0x0447, 0x0448, 0x044B, 0x044D, 0x044E, 0xA689, 0x04D9, 0x0456,
+	0x0458, 0x04E9, 0x04AF, 0x04CF, 0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437,
+	0x0438, 0x043A, 0x043B, 0x043E, 0x043F, 0x0441, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447, 0x0448,
+	0x044A, 0x044B, 0x0491, 0x0456, 0x0455, 0x045F, 0x04AB, 0xA651, 0x04B1, 0x0627, 0x0628, 0x062C,
+	0x062F, 0x0648, 0x0632, 0x062D, 0x0637, 0x064A, 0x0643, 0x0644, 0x0645, 0x0646, 0x0633, 0x0639,
+	0x0641, 0x0635, 0x0642, 0x0631, 0x0634, 0x062A, 0x062B, 0x062E, 0x0630, 0x0636, 0x0638, 0x063A,
+	0x066E, 0x06BA, 0x06A1, 0x066F, 0x0628, 0x062C, 0x0647, 0x062D, 0x064A, 0x0643, 0x0644, 0x0645,
+	0x0646, 0x0633, 0x0639, 0x0641, 0x0635, 0x0642, 0x0634, 0x062A, 0x062B, 0x062E, 0x0636, 0x063A,
+	0x062C, 0x062D, 0x064A, 0x0644, 0x0646, 0x0633, 0x0639, 0x0635, 0x0642, 0x0634, 0x062E, 0x0636,
+	0x063A, 0x06BA, 0x066F, 0x0628, 0x062C, 0x0647, 0x062D, 0x0637, 0x064A, 0x0643, 0x0645, 0x0646,
+	0x0633, 0x0639, 0x0641, 0x0635, 0x0642, 0x0634, 0x062A, 0x062B, 0x062E, 0x0636, 0x0638, 0x063A,
+	0x066E, 0x06A1, 0x0627, 0x0628, 0x062C, 0x062F, 0x0647, 0x0648, 0x0632, 0x062D, 0x0637, 0x064A,
+	0x0644, 0x0645, 0x0646, 0x0633, 0x0639, 0x0641, 0x0635, 0x0642, 0x0631, 0x0634, 0x062A, 0x062B,
+	0x062E, 0x0630, 0x0636, 0x0638, 0x063A, 0x0628, 0x062C, 0x062F, 0x0648, 0x0632, 0x062D, 0x0637,
+	0x064A, 0x0644, 0x0645, 0x0646, 0x0633, 0x0639, 0x0641, 0x0635, 0x0642, 0x0631, 0x0634, 0x062A,
+	0x062B, 0x062E, 0x0630, 0x0636, 0x0638, 0x063A, 0x0030, 0x002E, 0x0030, 0x002C, 0x0031, 0x002C,
+	0x0032, 0x002C, 0x0033, 0x002C, 0x0034, 0x002C, 0x0035, 0x002C, 0x0036, 0x002C, 0x0037, 0x002C,
+	0x0038, 0x002C, 0x0039, 0x002C, 0x0028, 0x0041, 0x0029, 0x0028, 0x0042, 0x0029, 0x0028, 0x0043,
+	0x0029, 0x0028, 0x0044, 0x0029, 0x0028, 0x0045, 0x0029, 0x0028, 0x0046, 0x0029, 0x0028, 0x0047,
+	0x0029, 0x0028, 0x0048, 0x0029, 0x0028, 0x0049, 0x0029, 0x0028, 0x004A, 0x0029, 0x0028, 0x004B,
+	0x0029, 0x0028, 0x004C, 0x0029, 0x0028, 0x004D, 0x0029, 0x0028, 0x004E, 0x0029, 0x0028, 0x004F,
+	0x0029, 0x0028, 0x0050, 0x0029, 0x0028, 0x0051, 0x0029, 0x0028, 0x0052, 0x0029, 0x0028, 0x0053,
+	0x0029, 0x0028, 0x0054, 0x0029, 0x0028, 0x0055, 0x0029, 0x0028, 0x0056, 0x0029, 0x0028, 0x0057,
+	0x0029, 0x0028, 0x0058, 0x0029, 0x0028, 0x0059, 0x0029, 0x0028, 0x005A, 0x0029, 0x3014, 0x0053,
+	0x3015, 0x0043, 0x0052, 0x0043, 0x0044, 0x0057, 0x005A, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045,
+	0x0046, 0x0047, 0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050, 0x0051,
+	0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059, 0x005A, 0x0048, 0x0056, 0x004D,
+	0x0056, 0x0053, 0x0044, 0x0053, 0x0053, 0x0050, 0x0050, 0x0056, 0x0057, 0x0043, 0x004D, 0x0043,
+	0x004D, 0x0044, 0x004D, 0x0052, 0x0044, 0x004A, 0x307B, 0x304B, 0x30B3, 0x30B3, 0x30B5, 0x624B,
+	0x5B57, 0x53CC, 0x30C6, 0x3099, 0x4E8C, 0x591A, 0x89E3, 0x5929, 0x4EA4, 0x6620, 0x7121, 0x6599,
+	0x524D, 0x5F8C, 0x518D, 0x65B0, 0x521D, 0x7D42, 0x751F, 0x8CA9, 0x58F0, 0x5439, 0x6F14, 0x6295,
+	0x6355, 0x4E00, 0x4E09, 0x904A, 0x5DE6, 0x4E2D, 0x53F3, 0x6307, 0x8D70, 0x6253, 0x7981, 0x7A7A,
+	0x5408, 0x6E80, 0x6709, 0x6708, 0x7533, 0x5272, 0x55B6, 0x914D, 0x3014, 0x672C, 0x3015, 0x3014,
+	0x4E09, 0x3015, 0x3014, 0x4E8C, 0x3015, 0x3014, 0x5B89, 0x3015, 0x3014, 0x70B9, 0x3015, 0x3014,
+	0x6253, 0x3015, 0x3014, 0x76D7, 0x3015, 0x3014, 0x52DD, 0x3015, 0x3014, 0x6557, 0x3015, 0x5F97,
+	0x53EF, 0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038, 0x0039, 0x4E3D,
+	0x4E38, 0x4E41, 0x20122, 0x4F60, 0x4FAE, 0x4FBB, 0x5002, 0x507A, 0x5099, 0x50E7, 0x50CF, 0x349E,
+	0x2063A, 0x514D, 0x5154, 0x5164, 0x5177, 0x2051C, 0x34B9, 0x5167, 0x518D, 0x2054B, 0x5197, 0x51A4,
+	0x4ECC, 0x51AC, 0x51B5, 0x291DF, 0x51F5, 0x5203, 0x34DF, 0x523B, 0x5246, 0x5272, 0x5277, 0x3515,
+	0x52C7, 0x52C9, 0x52E4, 0x52FA, 0x5305, 0x5306, 0x5317, 0x5349, 0x5351, 0x535A, 0x5373, 0x537D,
+	0x537F, 0x537F, 0x537F, 0x20A2C, 0x7070, 0x53CA, 0x53DF, 0x20B63, 0x53EB, 0x53F1, 0x5406, 0x549E,
+	0x5438, 0x5448, 0x5468, 0x54A2, 0x54F6, 0x5510, 0x5553, 0x5563, 0x5584, 0x5584, 0x5599, 0x55AB,
+	0x55B3, 0x55C2, 0x5716, 0x5606, 0x5717, 0x5651, 0x5674, 0x5207, 0x58EE, 0x57CE, 0x57F4, 0x580D,
+	0x578B, 0x5832, 0x5831, 0x58AC, 0x214E4, 0x58F2, 0x58F7, 0x5906, 0x591A, 0x5922, 0x5962, 0x216A8,
+	0x216EA, 0x59EC, 0x5A1B, 0x5A27, 0x59D8, 0x5A66, 0x36EE, 0x36FC, 0x5B08, 0x5B3E, 0x5B3E, 0x219C8,
+	0x5BC3, 0x5BD8, 0x5BE7, 0x5BF3, 0x21B18, 0x5BFF, 0x5C06, 0x5F53, 0x5C22, 0x3781, 0x5C60, 0x5C6E,
+	0x5CC0, 0x5C8D, 0x21DE4, 0x5D43, 0x21DE6, 0x5D6E, 0x5D6B, 0x5D7C, 0x5DE1, 0x5DE2, 0x382F, 0x5DFD,
+	0x5E28, 0x5E3D, 0x5E69, 0x3862, 0x22183, 0x387C, 0x5EB0, 0x5EB3, 0x5EB6, 0x5ECA, 0x2A392, 0x5EFE,
+	0x22331, 0x22331, 0x8201, 0x5F22, 0x5F22, 0x38C7, 0x232B8, 0x261DA, 0x5F62, 0x5F6B, 0x38E3, 0x5F9A,
+	0x5FCD, 0x5FD7, 0x5FF9, 0x6081, 0x393A, 0x391C, 0x6094, 0x226D4, 0x60C7, 0x6148, 0x614C, 0x614E,
+	0x614C, 0x617A, 0x618E, 0x61B2, 0x61A4, 0x61AF, 0x61DE, 0x61F2, 0x61F6, 0x6210, 0x621B, 0x625D,
+	0x62B1, 0x62D4, 0x6350, 0x22B0C, 0x633D, 0x62FC, 0x6368, 0x6383, 0x63E4, 0x22BF1, 0x6422, 0x63C5,
+	0x63A9, 0x3A2E, 0x6469, 0x647E, 0x649D, 0x6477, 0x3A6C, 0x654F, 0x656C, 0x2300A, 0x65E3, 0x66F8,
+	0x6649, 0x3B19, 0x6691, 0x3B08, 0x3AE4, 0x5192, 0x5195, 0x6700, 0x669C, 0x80AD, 0x43D9, 0x6717,
+	0x671B, 0x6721, 0x675E, 0x6753, 0x233C3, 0x3B49, 0x67FA, 0x6785, 0x6852, 0x6885, 0x2346D, 0x688E,
+	0x681F, 0x6914, 0x3B9D, 0x6942, 0x69A3, 0x69EA, 0x6AA8, 0x236A3, 0x6ADB, 0x3C18, 0x6B21, 0x238A7,
+	0x6B54, 0x3C4E, 0x6B72, 0x6B9F, 0x6BBA, 0x6BBB, 0x23A8D, 0x21D0B, 0x23AFA, 0x6C4E, 0x23CBC, 0x6CBF,
+	0x6CCD, 0x6C67, 0x6D16, 0x6D3E, 0x6D77, 0x6D41, 0x6D69, 0x6D78, 0x6D85, 0x23D1E, 0x6D34, 0x6E2F,
+	0x6E6E, 0x3D33, 0x6ECB, 0x6EC7, 0x23ED1, 0x6DF9, 0x6F6E, 0x23F5E, 0x23F8E, 0x6FC6, 0x7039, 0x701E,
+	0x701B, 0x3D96, 0x704A, 0x707D, 0x7077, 0x70AD, 0x20525, 0x7145, 0x24263, 0x719C, 0x243AB, 0x7228,
+	0x7235, 0x7250, 0x24608, 0x7280, 0x7295, 0x24735, 0x24814, 0x737A, 0x738B, 0x3EAC, 0x73A5, 0x3EB8,
+	0x3EB8, 0x7447, 0x745C, 0x7471, 0x7485, 0x74CA, 0x3F1B, 0x7524, 0x24C36, 0x753E, 0x24C92, 0x7570,
+	0x2219F, 0x7610, 0x24FA1, 0x24FB8, 0x25044, 0x3FFC, 0x4008, 0x76F4, 0x250F3, 0x250F2, 0x25119, 0x25133,
+	0x771E, 0x771F, 0x771F, 0x774A, 0x4039, 0x778B, 0x4046, 0x4096, 0x2541D, 0x784E, 0x788C, 0x78CC,
+	0x40E3, 0x25626, 0x7956, 0x2569A, 0x256C5, 0x798F, 0x79EB, 0x412F, 0x7A40, 0x7A4A, 0x7A4F, 0x2597C,
+	0x25AA7, 0x25AA7, 0x7AEE, 0x4202, 0x25BAB, 0x7BC6, 0x7BC9, 0x4227, 0x25C80, 0x7CD2, 0x42A0, 0x7CE8,
+	0x7CE3, 0x7D00, 0x25F86, 0x7D63, 0x4301, 0x7DC7, 0x7E02, 0x7E45, 0x4334, 0x26228, 0x26247, 0x4359,
+	0x262D9, 0x7F7A, 0x2633E, 0x7F95, 0x7FFA, 0x8005, 0x264DA, 0x26523, 0x8060, 0x265A8, 0x8070, 0x2335F,
+	0x43D5, 0x80B2, 0x8103, 0x440B, 0x813E, 0x5AB5, 0x267A7, 0x267B5, 0x23393, 0x2339C, 0x8201, 0x8204,
+	0x8F9E, 0x446B, 0x8291, 0x828B, 0x829D, 0x52B3, 0x82B1, 0x82B3, 0x82BD, 0x82E6, 0x26B3C, 0x82E5,
+	0x831D, 0x8363, 0x83AD, 0x8323, 0x83BD, 0x83E7, 0x8457, 0x8353, 0x83CA, 0x83CC, 0x83DC, 0x26C36,
+	0x26D6B, 0x26CD5, 0x452B, 0x84F1, 0x84F3, 0x8516, 0x273CA, 0x8564, 0x26F2C, 0x455D, 0x4561, 0x26FB1,
+	0x270D2, 0x456B, 0x8650, 0x865C, 0x8667, 0x8669, 0x86A9, 0x8688, 0x870E, 0x86E2, 0x8779, 0x8728,
+	0x876B, 0x8786, 0x45D7, 0x87E1, 0x8801, 0x45F9, 0x8860, 0x8863, 0x27667, 0x88D7, 0x88DE, 0x4635,
+	0x88FA, 0x34BB, 0x278AE, 0x27966, 0x46BE, 0x46C7, 0x8AA0, 0x8AED, 0x8B8A, 0x8C55, 0x27CA8, 0x8CAB,
+	0x8CC1, 0x8D1B, 0x8D77, 0x27F2F, 0x20804, 0x8DCB, 0x8DBC, 0x8DF0, 0x208DE, 0x8ED4, 0x8F38, 0x285D2,
+	0x285ED, 0x9094, 0x90F1, 0x9111, 0x2872E, 0x911B, 0x9238, 0x92D7, 0x92D8, 0x927C, 0x93F9, 0x9415,
+	0x28BFA, 0x958B, 0x4995, 0x95B7, 0x28D77, 0x49E6, 0x96C3, 0x5DB2, 0x9723, 0x29145, 0x2921A, 0x4A6E,
+	0x4A76, 0x97E0, 0x2940A, 0x4AB2, 0x29496, 0x980B, 0x980B, 0x9829, 0x295B6, 0x98E2, 0x4B33, 0x9929,
+	0x99A7, 0x99C2, 0x99FE, 0x4BCE, 0x29B30, 0x9B12, 0x9C40, 0x9CFD, 0x4CCE, 0x4CED, 0x9D67, 0x2A0CE,
+	0x4CF8, 0x2A105, 0x2A20E, 0x2A291, 0x9EBB, 0x4D56, 0x9EF9, 0x9EFE, 0x9F05, 0x9F0F, 0x9F16, 0x9F3B,
+	0x2A600,
+}
+
+var compositions = []composition{
+	{0x003C, 0x0338, 0x226E},
+	{0x003D, 0x0338, 0x2260},
+	{0x003E, 0x0338, 0x226F},
+	{0x0041, 0x0300, 0x00C0},
+	{0x0041, 0x0301, 0x00C1},
+	{0x0041, 0x0302, 0x00C2},
+	{0x0041, 0x0303, 0x00C3},
+	{0x0041, 0x0304, 0x0100},
+	{0x0041, 0x0306, 0x0102},
+	{0x0041, 0x0307, 0x0226},
+	{0x0041, 0x0308, 0x00C4},
+	{0x0041, 0x0309, 0x1EA2},
+	{0x0041, 0x030A, 0x00C5},
+	{0x0041, 0x030C, 0x01CD},
+	{0x0041, 0x030F, 0x0200},
+	{0x0041, 0x0311, 0x0202},
+	{0x0041, 0x0323, 0x1EA0},
+	{0x0041, 0x0325, 0x1E00},
+	{0x0041, 0x0328, 0x0104},
+	{0x0042, 0x0307, 0x1E02},
+	{0x0042, 0x0323, 0x1E04},
+	{0x0042, 0x0331, 0x1E06},
+	{0x0043, 0x0301, 0x0106},
+	{0x0043, 0x0302, 0x0108},
+	{0x0043, 0x0307, 0x010A},
+	{0x0043, 0x030C, 0x010C},
+	{0x0043, 0x0327, 0x00C7},
+	{0x0044, 0x0307, 0x1E0A},
+	{0x0044, 0x030C, 0x010E},
+	{0x0044, 0x0323, 0x1E0C},
+	{0x0044, 0x0327, 0x1E10},
+	{0x0044, 0x032D, 0x1E12},
+	{0x0044, 0x0331, 0x1E0E},
+	{0x0045, 0x0300, 0x00C8},
+	{0x0045, 0x0301, 0x00C9},
+	{0x0045, 0x0302, 0x00CA},
+	{0x0045, 0x0303, 0x1EBC},
+	{0x0045, 0x0304, 0x0112},
+	{0x0045, 0x0306, 0x0114},
+	{0x0045, 0x0307, 0x0116},
+	{0x0045, 0x0308, 0x00CB},
+	{0x0045, 0x0309, 0x1EBA},
+	{0x0045, 0x030C, 0x011A},
+	{0x0045, 0x030F, 0x0204},
+	{0x0045, 0x0311, 0x0206},
+	{0x0045, 0x0323, 0x1EB8},
+	{0x0045, 0x0327, 0x0228},
+	{0x0045, 0x0328, 0x0118},
+	{0x0045, 0x032D, 0x1E18},
+	{0x0045, 0x0330, 0x1E1A},
+	{0x0046, 0x0307, 0x1E1E},
+	{0x0047, 0x0301, 0x01F4},
+	{0x0047, 0x0302, 0x011C},
+	{0x0047, 0x0304, 0x1E20},
+	{0x0047, 0x0306, 0x011E},
+	{0x0047, 0x0307, 0x0120},
+	{0x0047, 0x030C, 0x01E6},
+	{0x0047, 0x0327, 0x0122},
+	{0x0048, 0x0302, 0x0124},
+	{0x0048, 0x0307, 0x1E22},
+	{0x0048, 0x0308, 0x1E26},
+	{0x0048, 0x030C, 0x021E},
+	{0x0048, 0x0323, 0x1E24},
+	{0x0048, 0x0327, 0x1E28},
+	{0x0048, 0x032E, 0x1E2A},
+	{0x0049, 0x0300, 0x00CC},
+	{0x0049, 0x0301, 0x00CD},
+	{0x0049, 0x0302, 0x00CE},
+	{0x0049, 0x0303, 0x0128},
+	{0x0049, 0x0304, 0x012A},
+	{0x0049, 0x0306, 0x012C},
+	{0x0049, 0x0307, 0x0130},
+	{0x0049, 0x0308, 0x00CF},
+	{0x0049, 0x0309, 0x1EC8},
+	{0x0049, 0x030C, 0x01CF},
+	{0x0049, 0x030F, 0x0208},
+	{0x0049, 0x0311, 0x020A},
+	{0x0049, 0x0323, 0x1ECA},
+	{0x0049, 0x0328, 0x012E},
+	{0x0049, 0x0330, 0x1E2C},
+	{0x004A, 0x0302, 0x0134},
+	{0x004B, 0x0301, 0x1E30},
+	{0x004B, 0x030C, 0x01E8},
+	{0x004B, 0x0323, 0x1E32},
+	{0x004B, 0x0327, 0x0136},
+	{0x004B, 0x0331, 0x1E34},
+	{0x004C, 0x0301, 0x0139},
+	{0x004C, 0x030C, 0x013D},
+	{0x004C, 0x0323, 0x1E36},
+	{0x004C, 0x0327, 0x013B},
+	{0x004C, 0x032D, 0x1E3C},
+	{0x004C, 0x0331, 0x1E3A},
+	{0x004D, 0x0301, 0x1E3E},
+	{0x004D, 0x0307, 0x1E40},
+	{0x004D, 0x0323, 0x1E42},
+	{0x004E, 0x0300, 0x01F8},
+	{0x004E, 0x0301, 0x0143},
+	{0x004E, 0x0303, 0x00D1},
+	{0x004E, 0x0307, 0x1E44},
+	{0x004E, 0x030C, 0x0147},
+	{0x004E, 0x0323, 0x1E46},
+	{0x004E, 0x0327, 0x0145},
+	{0x004E, 0x032D, 0x1E4A},
+	{0x004E, 0x0331, 0x1E48},
+	{0x004F, 0x0300, 0x00D2},
+	{0x004F, 0x0301, 0x00D3},
+	{0x004F, 0x0302, 0x00D4},
+	{0x004F, 0x0303, 0x00D5},
+	{0x004F, 0x0304, 0x014C},
+	{0x004F, 0x0306, 0x014E},
+	{0x004F, 0x0307, 0x022E},
+	{0x004F, 0x0308, 0x00D6},
+	{0x004F, 0x0309, 0x1ECE},
+	{0x004F, 0x030B, 0x0150},
+	{0x004F, 0x030C, 0x01D1},
+	{0x004F, 0x030F, 0x020C},
+	{0x004F, 0x0311, 0x020E},
+	{0x004F, 0x031B, 0x01A0},
+	{0x004F, 0x0323, 0x1ECC},
+	{0x004F, 0x0328, 0x01EA},
+	{0x0050, 0x0301, 0x1E54},
+	{0x0050, 0x0307, 0x1E56},
+	{0x0052, 0x0301, 0x0154},
+	{0x0052, 0x0307, 0x1E58},
+	{0x0052, 0x030C, 0x0158},
+	{0x0052, 0x030F, 0x0210},
+	{0x0052, 0x0311, 0x0212},
+	{0x0052, 0x0323, 0x1E5A},
+	{0x0052, 0x0327, 0x0156},
+	{0x0052, 0x0331, 0x1E5E},
+	{0x0053, 0x0301, 0x015A},
+	{0x0053, 0x0302, 0x015C},
+	{0x0053, 0x0307, 0x1E60},
+	{0x0053, 0x030C, 0x0160},
+	{0x0053, 0x0323, 0x1E62},
+	{0x0053, 0x0326, 0x0218},
+	{0x0053, 0x0327, 0x015E},
+	{0x0054, 0x0307, 0x1E6A},
+	{0x0054, 0x030C, 0x0164},
+	{0x0054, 0x0323, 0x1E6C},
+	{0x0054, 0x0326, 0x021A},
+	{0x0054, 0x0327, 0x0162},
+	{0x0054, 0x032D, 0x1E70},
+	{0x0054, 0x0331, 0x1E6E},
+	{0x0055, 0x0300, 0x00D9},
+	{0x0055, 0x0301, 0x00DA},
+	{0x0055, 0x0302, 0x00DB},
+	{0x0055, 0x0303, 0x0168},
+	{0x0055, 0x0304, 0x016A},
+	{0x0055, 0x0306, 0x016C},
+	{0x0055, 0x0308, 0x00DC},
+	{0x0055, 0x0309, 0x1EE6},
+	{0x0055, 0x030A, 0x016E},
+	{0x0055, 0x030B, 0x0170},
+	{0x0055, 0x030C, 0x01D3},
+	{0x0055, 0x030F, 0x0214},
+	{0x0055, 0x0311, 0x0216},
+	{0x0055, 0x031B, 0x01AF},
+	{0x0055, 0x0323, 0x1EE4},
+	{0x0055, 0x0324, 0x1E72},
+	{0x0055, 0x0328, 0x0172},
+	{0x0055, 0x032D, 0x1E76},
+	{0x0055, 0x0330, 0x1E74},
+	{0x0056, 0x0303, 0x1E7C},
+	{0x0056, 0x0323, 0x1E7E},
+	{0x0057, 0x0300, 0x1E80},
+	{0x0057, 0x0301, 0x1E82},
+	{0x0057, 0x0302, 0x0174},
+	{0x0057, 0x0307, 0x1E86},
+	{0x0057, 0x0308, 0x1E84},
+	{0x0057, 0x0323, 0x1E88},
+	{0x0058, 0x0307, 0x1E8A},
+	{0x0058, 0x0308, 0x1E8C},
+	{0x0059, 0x0300, 0x1EF2},
+	{0x0059, 0x0301, 0x00DD},
+	{0x0059, 0x0302, 0x0176},
+	{0x0059, 0x0303, 0x1EF8},
+	{0x0059, 0x0304, 0x0232},
+	{0x0059, 0x0307, 0x1E8E},
+	{0x0059, 0x0308, 0x0178},
+	{0x0059, 0x0309, 0x1EF6},
+	{0x0059, 0x0323, 0x1EF4},
+	{0x005A, 0x0301, 0x0179},
+	{0x005A, 0x0302, 0x1E90},
+	{0x005A, 0x0307, 0x017B},
+	{0x005A, 0x030C, 0x017D},
+	{0x005A, 0x0323, 0x1E92},
+	{0x005A, 0x0331, 0x1E94},
+	{0x0061, 0x0300, 0x00E0},
+	{0x0061, 0x0301, 0x00E1},
+	{0x0061, 0x0302, 0x00E2},
+	{0x0061, 0x0303, 0x00E3},
+	{0x0061, 0x0304, 0x0101},
+	{0x0061, 0x0306, 0x0103},
+	{0x0061, 0x0307, 0x0227},
+	{0x0061, 0x0308, 0x00E4},
+	{0x0061, 0x0309, 0x1EA3},
+	{0x0061, 0x030A, 0x00E5},
+	{0x0061, 0x030C, 0x01CE},
+	{0x0061, 0x030F, 0x0201},
+	{0x0061, 0x0311, 0x0203},
+	{0x0061, 0x0323, 0x1EA1},
+	{0x0061, 0x0325, 0x1E01},
+	{0x0061, 0x0328, 0x0105},
+	{0x0062, 0x0307, 0x1E03},
+	{0x0062, 0x0323, 0x1E05},
+	{0x0062, 0x0331, 0x1E07},
+	{0x0063, 0x0301, 0x0107},
+	{0x0063, 0x0302, 0x0109},
+	{0x0063, 0x0307, 0x010B},
+	{0x0063, 0x030C, 0x010D},
+	{0x0063, 0x0327, 0x00E7},
+	{0x0064, 0x0307, 0x1E0B},
+	{0x0064, 0x030C, 0x010F},
+	{0x0064, 0x0323, 0x1E0D},
+	{0x0064, 0x0327, 0x1E11},
+	{0x0064, 0x032D, 0x1E13},
+	{0x0064, 0x0331, 0x1E0F},
+	{0x0065, 0x0300, 0x00E8},
+	{0x0065, 0x0301, 0x00E9},
+	{0x0065, 0x0302, 0x00EA},
+	{0x0065, 0x0303, 0x1EBD},
+	{0x0065, 0x0304, 0x0113},
+	{0x0065, 0x0306, 0x0115},
+	{0x0065, 0x0307, 0x0117},
+	{0x0065, 0x0308, 0x00EB},
+	{0x0065, 0x0309, 0x1EBB},
+	{0x0065, 0x030C, 0x011B},
+	{0x0065, 0x030F, 0x0205},
+	{0x0065, 0x0311, 0x0207},
+	{0x0065, 0x0323, 0x1EB9},
+	{0x0065, 0x0327, 0x0229},
+	{0x0065, 0x0328, 0x0119},
+	{0x0065, 0x032D, 0x1E19},
+	{0x0065, 0x0330, 0x1E1B},
+	{0x0066, 0x0307, 0x1E1F},
+	{0x0067, 0x0301, 0x01F5},
+	{0x0067, 0x0302, 0x011D},
+	{0x0067, 0x0304, 0x1E21},
+	{0x0067, 0x0306, 0x011F},
+	{0x0067, 0x0307, 0x0121},
+	{0x0067, 0x030C, 0x01E7},
+	{0x0067, 0x0327, 0x0123},
+	{0x0068, 0x0302, 0x0125},
+	{0x0068, 0x0307, 0x1E23},
+	{0x0068, 0x0308, 0x1E27},
+	{0x0068, 0x030C, 0x021F},
+	{0x0068, 0x0323, 0x1E25},
+	{0x0068, 0x0327, 0x1E29},
+	{0x0068, 0x032E, 0x1E2B},
+	{0x0068, 0x0331, 0x1E96},
+	{0x0069, 0x0300, 0x00EC},
+	{0x0069, 0x0301, 0x00ED},
+	{0x0069, 0x0302, 0x00EE},
+	{0x0069, 0x0303, 0x0129},
+	{0x0069, 0x0304, 0x012B},
+	{0x0069, 0x0306, 0x012D},
+	{0x0069, 0x0308, 0x00EF},
+	{0x0069, 0x0309, 0x1EC9},
+	{0x0069, 0x030C, 0x01D0},
+	{0x0069, 0x030F, 0x0209},
+	{0x0069, 0x0311, 0x020B},
+	{0x0069, 0x0323, 0x1ECB},
+	{0x0069, 0x0328, 0x012F},
+	{0x0069, 0x0330, 0x1E2D},
+	{0x006A, 0x0302, 0x0135},
+	{0x006A, 0x030C, 0x01F0},
+	{0x006B, 0x0301, 0x1E31},
+	{0x006B, 0x030C, 0x01E9},
+	{0x006B, 0x0323, 0x1E33},
+	{0x006B, 0x0327, 0x0137},
+	{0x006B, 0x0331, 0x1E35},
+	{0x006C, 0x0301, 0x013A},
+	{0x006C, 0x030C, 0x013E},
+	{0x006C, 0x0323, 0x1E37},
+	{0x006C, 0x0327, 0x013C},
+	{0x006C, 0x032D, 0x1E3D},
+	{0x006C, 0x0331, 0x1E3B},
+	{0x006D, 0x0301, 0x1E3F},
+	{0x006D, 0x0307, 0x1E41},
+	{0x006D, 0x0323, 0x1E43},
+	{0x006E, 0x0300, 0x01F9},
+	{0x006E, 0x0301, 0x0144},
+	{0x006E, 0x0303, 0x00F1},
+	{0x006E, 0x0307, 0x1E45},
+	{0x006E, 0x030C, 0x0148},
+	{0x006E, 0x0323, 0x1E47},
+	{0x006E, 0x0327, 0x0146},
+	{0x006E, 0x032D, 0x1E4B},
+	{0x006E, 0x0331, 0x1E49},
+	{0x006F, 0x0300, 0x00F2},
+	{0x006F, 0x0301, 0x00F3},
+	{0x006F, 0x0302, 0x00F4},
+	{0x006F, 0x0303, 0x00F5},
+	{0x006F, 0x0304, 0x014D},
+	{0x006F, 0x0306, 0x014F},
+	{0x006F, 0x0307, 0x022F},
+	{0x006F, 0x0308, 0x00F6},
+	{0x006F, 0x0309, 0x1ECF},
+	{0x006F, 0x030B, 0x0151},
+	{0x006F, 0x030C, 0x01D2},
+	{0x006F, 0x030F, 0x020D},
+	{0x006F, 0x0311, 0x020F},
+	{0x006F, 0x031B, 0x01A1},
+	{0x006F, 0x0323, 0x1ECD},
+	{0x006F, 0x0328, 0x01EB},
+	{0x0070, 0x0301, 0x1E55},
+	{0x0070, 0x0307, 0x1E57},
+	{0x0072, 0x0301, 0x0155},
+	{0x0072, 0x0307, 0x1E59},
+	{0x0072, 0x030C, 0x0159},
+	{0x0072, 0x030F, 0x0211},
+	{0x0072, 0x0311, 0x0213},
+	{0x0072, 0x0323, 0x1E5B},
+	{0x0072, 0x0327, 0x0157},
+	{0x0072, 0x0331, 0x1E5F},
+	{0x0073, 0x0301, 0x015B},
+	{0x0073, 0x0302, 0x015D},
+	{0x0073, 0x0307, 0x1E61},
+	{0x0073, 0x030C, 0x0161},
+	{0x0073, 0x0323, 0x1E63},
+	{0x0073, 0x0326, 0x0219},
+	{0x0073, 0x0327, 0x015F},
+	{0x0074, 0x0307, 0x1E6B},
+	{0x0074, 0x0308, 0x1E97},
+	{0x0074, 0x030C, 0x0165},
+	{0x0074, 0x0323, 0x1E6D},
+	{0x0074, 0x0326, 0x021B},
+	{0x0074, 0x0327, 0x0163},
+	{0x0074, 0x032D, 0x1E71},
+	{0x0074, 0x0331, 0x1E6F},
+	{0x0075, 0x0300, 0x00F9},
+	{0x0075, 0x0301, 0x00FA},
+	{0x0075, 0x0302, 0x00FB},
+	{0x0075, 0x0303, 0x0169},
+	{0x0075, 0x0304, 0x016B},
+	{0x0075, 0x0306, 0x016D},
+	{0x0075, 0x0308, 0x00FC},
+	{0x0075, 0x0309, 0x1EE7},
+	{0x0075, 0x030A, 0x016F},
+	{0x0075, 0x030B, 0x0171},
+	{0x0075, 0x030C, 0x01D4},
+	{0x0075, 0x030F, 0x0215},
+	{0x0075, 0x0311, 0x0217},
+	{0x0075, 0x031B, 0x01B0},
+	{0x0075, 0x0323, 0x1EE5},
+	{0x0075, 0x0324, 0x1E73},
+	{0x0075, 0x0328, 0x0173},
+	{0x0075, 0x032D, 0x1E77},
+	{0x0075, 0x0330, 0x1E75},
+	{0x0076, 0x0303, 0x1E7D},
+	{0x0076, 0x0323, 0x1E7F},
+	{0x0077, 0x0300, 0x1E81},
+	{0x0077, 0x0301, 0x1E83},
+	{0x0077, 0x0302, 0x0175},
+	{0x0077, 0x0307, 0x1E87},
+	{0x0077, 0x0308, 0x1E85},
+	{0x0077, 0x030A, 0x1E98},
+	{0x0077, 0x0323, 0x1E89},
+	{0x0078, 0x0307, 0x1E8B},
+	{0x0078, 0x0308, 0x1E8D},
+	{0x0079, 0x0300, 0x1EF3},
+	{0x0079, 0x0301, 0x00FD},
+	{0x0079, 0x0302, 0x0177},
+	{0x0079, 0x0303, 0x1EF9},
+	{0x0079, 0x0304, 0x0233},
+	{0x0079, 0x0307, 0x1E8F},
+	{0x0079, 0x0308, 0x00FF},
+	{0x0079, 0x0309, 0x1EF7},
+	{0x0079, 0x030A, 0x1E99},
+	{0x0079, 0x0323, 0x1EF5},
+	{0x007A, 0x0301, 0x017A},
+	{0x007A, 0x0302, 0x1E91},
+	{0x007A, 0x0307, 0x017C},
+	{0x007A, 0x030C, 0x017E},
+	{0x007A, 0x0323, 0x1E93},
+	{0x007A, 0x0331, 0x1E95},
+	{0x00A8, 0x0300, 0x1FED},
+	{0x00A8, 0x0301, 0x0385},
+	{0x00A8, 0x0342, 0x1FC1},
+	{0x00C2, 0x0300, 0x1EA6},
+	{0x00C2, 0x0301, 0x1EA4},
+	{0x00C2, 0x0303, 0x1EAA},
+	{0x00C2, 0x0309, 0x1EA8},
+	{0x00C4, 0x0304, 0x01DE},
+	{0x00C5, 0x0301, 0x01FA},
+	{0x00C6, 0x0301, 0x01FC},
+	{0x00C6, 0x0304, 0x01E2},
+	{0x00C7, 0x0301, 0x1E08},
+	{0x00CA, 0x0300, 0x1EC0},
+	{0x00CA, 0x0301, 0x1EBE},
+	{0x00CA, 0x0303, 0x1EC4},
+	{0x00CA, 0x0309, 0x1EC2},
+	{0x00CF, 0x0301, 0x1E2E},
+	{0x00D4, 0x0300, 0x1ED2},
+	{0x00D4, 0x0301, 0x1ED0},
+	{0x00D4, 0x0303, 0x1ED6},
+	{0x00D4, 0x0309, 0x1ED4},
+	{0x00D5, 0x0301, 0x1E4C},
+	{0x00D5, 0x0304, 0x022C},
+	{0x00D5, 0x0308, 0x1E4E},
+	{0x00D6, 0x0304, 0x022A},
+	{0x00D8, 0x0301, 0x01FE},
+	{0x00DC, 0x0300, 0x01DB},
+	{0x00DC, 0x0301, 0x01D7},
+	{0x00DC, 0x0304, 0x01D5},
+	{0x00DC, 0x030C, 0x01D9},
+	{0x00E2, 0x0300, 0x1EA7},
+	{0x00E2, 0x0301, 0x1EA5},
+	{0x00E2, 0x0303, 0x1EAB},
+	{0x00E2, 0x0309, 0x1EA9},
+	{0x00E4, 0x0304, 0x01DF},
+	{0x00E5, 0x0301, 0x01FB},
+	{0x00E6, 0x0301, 0x01FD},
+	{0x00E6, 0x0304, 0x01E3},
+	{0x00E7, 0x0301, 0x1E09},
+	{0x00EA, 0x0300, 0x1EC1},
+	{0x00EA, 0x0301, 0x1EBF},
+	{0x00EA, 0x0303, 0x1EC5},
+	{0x00EA, 0x0309, 0x1EC3},
+	{0x00EF, 0x0301, 0x1E2F},
+	{0x00F4, 0x0300, 0x1ED3},
+	{0x00F4, 0x0301, 0x1ED1},
+	{0x00F4, 0x0303, 0x1ED7},
+	{0x00F4, 0x0309, 0x1ED5},
+	{0x00F5, 0x0301, 0x1E4D},
+	{0x00F5, 0x0304, 0x022D},
+	{0x00F5, 0x0308, 0x1E4F},
+	{0x00F6, 0x0304, 0x022B},
+	{0x00F8, 0x0301, 0x01FF},
+	{0x00FC, 0x0300, 0x01DC},
+	{0x00FC, 0x0301, 0x01D8},
+	{0x00FC, 0x0304, 0x01D6},
+	{0x00FC, 0x030C, 0x01DA},
+	{0x0102, 0x0300, 0x1EB0},
+	{0x0102, 0x0301, 0x1EAE},
+	{0x0102, 0x0303, 0x1EB4},
+	{0x0102, 0x0309, 0x1EB2},
+	{0x0103, 0x0300, 0x1EB1},
+	{0x0103, 0x0301, 0x1EAF},
+	{0x0103, 0x0303, 0x1EB5},
+	{0x0103, 0x0309, 0x1EB3},
+	{0x0112, 0x0300, 0x1E14},
+	{0x0112, 0x0301, 0x1E16},
+	{0x0113, 0x0300, 0x1E15},
+	{0x0113, 0x0301, 0x1E17},
+	{0x014C, 0x0300, 0x1E50},
+	{0x014C, 0x0301, 0x1E52},
+	{0x014D, 0x0300, 0x1E51},
+	{0x014D, 0x0301, 0x1E53},
+	{0x015A, 0x0307, 0x1E64},
+	{0x015B, 0x0307, 0x1E65},
+	{0x0160, 0x0307, 0x1E66},
+	{0x0161, 0x0307, 0x1E67},
+	{0x0168, 0x0301, 0x1E78},
+	{0x0169, 0x0301, 0x1E79},
+	{0x016A, 0x0308, 0x1E7A},
+	{0x016B, 0x0308, 0x1E7B},
+	{0x017F, 0x0307, 0x1E9B},
+	{0x01A0, 0x0300, 0x1EDC},
+	{0x01A0, 0x0301, 0x1EDA},
+	{0x01A0, 0x0303, 0x1EE0},
+	{0x01A0, 0x0309, 0x1EDE},
+	{0x01A0, 0x0323, 0x1EE2},
+	{0x01A1, 0x0300, 0x1EDD},
+	{0x01A1, 0x0301, 0x1EDB},
+	{0x01A1, 0x0303, 0x1EE1},
+	{0x01A1, 0x0309, 0x1EDF},
+	{0x01A1, 0x0323, 0x1EE3},
+	{0x01AF, 0x0300, 0x1EEA},
+	{0x01AF, 0x0301, 0x1EE8},
+	{0x01AF, 0x0303, 0x1EEE},
+	{0x01AF, 0x0309, 0x1EEC},
+	{0x01AF, 0x0323, 0x1EF0},
+	{0x01B0, 0x0300, 0x1EEB},
+	{0x01B0, 0x0301, 0x1EE9},
+	{0x01B0, 0x0303, 0x1EEF},
+	{0x01B0, 0x0309, 0x1EED},
+	{0x01B0, 0x0323, 0x1EF1},
+	{0x01B7, 0x030C, 0x01EE},
+	{0x01EA, 0x0304, 0x01EC},
+	{0x01EB, 0x0304, 0x01ED},
+	{0x0226, 0x0304, 0x01E0},
+	{0x0227, 0x0304, 0x01E1},
+	{0x0228, 0x0306, 0x1E1C},
+	{0x0229, 0x0306, 0x1E1D},
+	{0x022E, 0x0304, 0x0230},
+	{0x022F, 0x0304, 0x0231},
+	{0x0292, 0x030C, 0x01EF},
+	{0x0391, 0x0300, 0x1FBA},
+	{0x0391, 0x0301, 0x0386},
+	{0x0391, 0x0304, 0x1FB9},
+	{0x0391, 0x0306, 0x1FB8},
+	{0x0391, 0x0313, 0x1F08},
+	{0x0391, 0x0314, 0x1F09},
+	{0x0391, 0x0345, 0x1FBC},
+	{0x0395, 0x0300, 0x1FC8},
+	{0x0395, 0x0301, 0x0388},
+	{0x0395, 0x0313, 0x1F18},
+	{0x0395, 0x0314, 0x1F19},
+	{0x0397, 0x0300, 0x1FCA},
+	{0x0397, 0x0301, 0x0389},
+	{0x0397, 0x0313, 0x1F28},
+	{0x0397, 0x0314, 0x1F29},
+	{0x0397, 0x0345, 0x1FCC},
+	{0x0399, 0x0300, 0x1FDA},
+	{0x0399, 0x0301, 0x038A},
+	{0x0399, 0x0304, 0x1FD9},
+	{0x0399, 0x0306, 0x1FD8},
+	{0x0399, 0x0308, 0x03AA},
+	{0x0399, 0x0313, 0x1F38},
+	{0x0399, 0x0314, 0x1F39},
+	{0x039F, 0x0300, 0x1FF8},
+	{0x039F, 0x0301, 0x038C},
+	{0x039F, 0x0313, 0x1F48},
+	{0x039F, 0x0314, 0x1F49},
+	{0x03A1, 0x0314, 0x1FEC},
+	{0x03A5, 0x0300, 0x1FEA},
+	{0x03A5, 0x0301, 0x038E},
+	{0x03A5, 0x0304, 0x1FE9},
+	{0x03A5, 0x0306, 0x1FE8},
+	{0x03A5, 0x0308, 0x03AB},
+	{0x03A5, 0x0314, 0x1F59},
+	{0x03A9, 0x0300, 0x1FFA},
+	{0x03A9, 0x0301, 0x038F},
+	{0x03A9, 0x0313, 0x1F68},
+	{0x03A9, 0x0314, 0x1F69},
+	{0x03A9, 0x0345, 0x1FFC},
+	{0x03AC, 0x0345, 0x1FB4},
+	{0x03AE, 0x0345, 0x1FC4},
+	{0x03B1, 0x0300, 0x1F70},
+	{0x03B1, 0x0301, 0x03AC},
+	{0x03B1, 0x0304, 0x1FB1},
+	{0x03B1, 0x0306, 0x1FB0},
+	{0x03B1, 0x0313, 0x1F00},
+	{0x03B1, 0x0314, 0x1F01},
+	{0x03B1, 0x0342, 0x1FB6},
+	{0x03B1, 0x0345, 0x1FB3},
+	{0x03B5, 0x0300, 0x1F72},
+	{0x03B5, 0x0301, 0x03AD},
+	{0x03B5, 0x0313, 0x1F10},
+	{0x03B5, 0x0314, 0x1F11},
+	{0x03B7, 0x0300, 0x1F74},
+	{0x03B7, 0x0301, 0x03AE},
+	{0x03B7, 0x0313, 0x1F20},
+	{0x03B7, 0x0314, 0x1F21},
+	{0x03B7, 0x0342, 0x1FC6},
+	{0x03B7, 0x0345, 0x1FC3},
+	{0x03B9, 0x0300, 0x1F76},
+	{0x03B9, 0x0301, 0x03AF},
+	{0x03B9, 0x0304, 0x1FD1},
+	{0x03B9, 0x0306, 0x1FD0},
+	{0x03B9, 0x0308, 0x03CA},
+	{0x03B9, 0x0313, 0x1F30},
+	{0x03B9, 0x0314, 0x1F31},
+	{0x03B9, 0x0342, 0x1FD6},
+	{0x03BF, 0x0300, 0x1F78},
+	{0x03BF, 0x0301, 0x03CC},
+	{0x03BF, 0x0313, 0x1F40},
+	{0x03BF, 0x0314, 0x1F41},
+	{0x03C1, 0x0313, 0x1FE4},
+	{0x03C1, 0x0314, 0x1FE5},
+	{0x03C5, 0x0300, 0x1F7A},
+	{0x03C5, 0x0301, 0x03CD},
+	{0x03C5, 0x0304, 0x1FE1},
+	{0x03C5, 0x0306, 0x1FE0},
+	{0x03C5, 0x0308, 0x03CB},
+	{0x03C5, 0x0313, 0x1F50},
+	{0x03C5, 0x0314, 0x1F51},
+	{0x03C5, 0x0342, 0x1FE6},
+	{0x03C9, 0x0300, 0x1F7C},
+	{0x03C9, 0x0301, 0x03CE},
+	{0x03C9, 0x0313, 0x1F60},
+	{0x03C9, 0x0314, 0x1F61},
+	{0x03C9, 0x0342, 0x1FF6},
+	{0x03C9, 0x0345, 0x1FF3},
+	{0x03CA, 0x0300, 0x1FD2},
+	{0x03CA, 0x0301, 0x0390},
+	{0x03CA, 0x0342, 0x1FD7},
+	{0x03CB, 0x0300, 0x1FE2},
+	{0x03CB, 0x0301, 0x03B0},
+	{0x03CB, 0x0342, 0x1FE7},
+	{0x03CE, 0x0345, 0x1FF4},
+	{0x03D2, 0x0301, 0x03D3},
+	{0x03D2, 0x0308, 0x03D4},
+	{0x0406, 0x0308, 0x0407},
+	{0x0410, 0x0306, 0x04D0},
+	{0x0410, 0x0308, 0x04D2},
+	{0x0413, 0x0301, 0x0403},
+	{0x0415, 0x0300, 0x0400},
+	{0x0415, 0x0306, 0x04D6},
+	{0x0415, 0x0308, 0x0401},
+	{0x0416, 0x0306, 0x04C1},
+	{0x0416, 0x0308, 0x04DC},
+	{0x0417, 0x0308, 0x04DE},
+	{0x0418, 0x0300, 0x040D},
+	{0x0418, 0x0304, 0x04E2},
+	{0x0418, 0x0306, 0x0419},
+	{0x0418, 0x0308, 0x04E4},
+	{0x041A, 0x0301, 0x040C},
+	{0x041E, 0x0308, 0x04E6},
+	{0x0423, 0x0304, 0x04EE},
+	{0x0423, 0x0306, 0x040E},
+	{0x0423, 0x0308, 0x04F0},
+	{0x0423, 0x030B, 0x04F2},
+	{0x0427, 0x0308, 0x04F4},
+	{0x042B, 0x0308, 0x04F8},
+	{0x042D, 0x0308, 0x04EC},
+	{0x0430, 0x0306, 0x04D1},
+	{0x0430, 0x0308, 0x04D3},
+	{0x0433, 0x0301, 0x0453},
+	{0x0435, 0x0300, 0x0450},
+	{0x0435, 0x0306, 0x04D7},
+	{0x0435, 0x0308, 0x0451},
+	{0x0436, 0x0306, 0x04C2},
+	{0x0436, 0x0308, 0x04DD},
+	{0x0437, 0x0308, 0x04DF},
+	{0x0438, 0x0300, 0x045D},
+	{0x0438, 0x0304, 0x04E3},
+	{0x0438, 0x0306, 0x0439},
+	{0x0438, 0x0308, 0x04E5},
+	{0x043A, 0x0301, 0x045C},
+	{0x043E, 0x0308, 0x04E7},
+	{0x0443, 0x0304, 0x04EF},
+	{0x0443, 0x0306, 0x045E},
+	{0x0443, 0x0308, 0x04F1},
+	{0x0443, 0x030B, 0x04F3},
+	{0x0447, 0x0308, 0x04F5},
+	{0x044B, 0x0308, 0x04F9},
+	{0x044D, 0x0308, 0x04ED},
+	{0x0456, 0x0308, 0x0457},
+	{0x0474, 0x030F, 0x0476},
+	{0x0475, 0x030F, 0x0477},
+	{0x04D8, 0x0308, 0x04DA},
+	{0x04D9, 0x0308, 0x04DB},
+	{0x04E8, 0x0308, 0x04EA},
+	{0x04E9, 0x0308, 0x04EB},
+	{0x0627, 0x0653, 0x0622},
+	{0x0627, 0x0654, 0x0623},
+	{0x0627, 0x0655, 0x0625},
+	{0x0648, 0x0654, 0x0624},
+	{0x064A, 0x0654, 0x0626},
+	{0x06C1, 0x0654, 0x06C2},
+	{0x06D2, 0x0654, 0x06D3},
+	{0x06D5, 0x0654, 0x06C0},
+	{0x0928, 0x093C, 0x0929},
+	{0x0930, 0x093C, 0x0931},
+	{0x0933, 0x093C, 0x0934},
+	{0x09C7, 0x09BE, 0x09CB},
+	{0x09C7, 0x09D7, 0x09CC},
+	{0x0B47, 0x0B3E, 0x0B4B},
+	{0x0B47, 0x0B56, 0x0B48},
+	{0x0B47, 0x0B57, 0x0B4C},
+	{0x0B92, 0x0BD7, 0x0B94},
+	{0x0BC6, 0x0BBE, 0x0BCA},
+	{0x0BC6, 0x0BD7, 0x0BCC},
+	{0x0BC7, 0x0BBE, 0x0BCB},
+	{0x0C46, 0x0C56, 0x0C48},
+	{0x0CBF, 0x0CD5, 0x0CC0},
+	{0x0CC6, 0x0CC2, 0x0CCA},
+	{0x0CC6, 0x0CD5, 0x0CC7},
+	{0x0CC6, 0x0CD6, 0x0CC8},
+	{0x0CCA, 0x0CD5, 0x0CCB},
+	{0x0D46, 0x0D3E, 0x0D4A},
+	{0x0D46, 0x0D57, 0x0D4C},
+	{0x0D47, 0x0D3E, 0x0D4B},
+	{0x0DD9, 0x0DCA, 0x0DDA},
+	{0x0DD9, 0x0DCF, 0x0DDC},
+	{0x0DD9, 0x0DDF, 0x0DDE},
+	{0x0DDC, 0x0DCA, 0x0DDD},
+	{0x1025, 0x102E, 0x1026},
+	{0x1B05, 0x1B35, 0x1B06},
+	{0x1B07, 0x1B35, 0x1B08},
+	{0x1B09, 0x1B35, 0x1B0A},
+	{0x1B0B, 0x1B35, 0x1B0C},
+	{0x1B0D, 0x1B35, 0x1B0E},
+	{0x1B11, 0x1B35, 0x1B12},
+	{0x1B3A, 0x1B35, 0x1B3B},
+	{0x1B3C, 0x1B35, 0x1B3D},
+	{0x1B3E, 0x1B35, 0x1B40},
+	{0x1B3F, 0x1B35, 0x1B41},
+	{0x1B42, 0x1B35, 0x1B43},
+	{0x1E36, 0x0304, 0x1E38},
+	{0x1E37, 0x0304, 0x1E39},
+	{0x1E5A, 0x0304, 0x1E5C},
+	{0x1E5B, 0x0304, 0x1E5D},
+	{0x1E62, 0x0307, 0x1E68},
+	{0x1E63, 0x0307, 0x1E69},
+	{0x1EA0, 0x0302, 0x1EAC},
+	{0x1EA0, 0x0306, 0x1EB6},
+	{0x1EA1, 0x0302, 0x1EAD},
+	{0x1EA1, 0x0306, 0x1EB7},
+	{0x1EB8, 0x0302, 0x1EC6},
+	{0x1EB9, 0x0302, 0x1EC7},
+	{0x1ECC, 0x0302, 0x1ED8},
+	{0x1ECD, 0x0302, 0x1ED9},
+	{0x1F00, 0x0300, 0x1F02},
+	{0x1F00, 0x0301, 0x1F04},
+	{0x1F00, 0x0342, 0x1F06},
+	{0x1F00, 0x0345, 0x1F80},
+	{0x1F01, 0x0300, 0x1F03},
+	{0x1F01, 0x0301, 0x1F05},
+	{0x1F01, 0x0342, 0x1F07},
+	{0x1F01, 0x0345, 0x1F81},
+	{0x1F02, 0x0345, 0x1F82},
+	{0x1F03, 0x0345, 0x1F83},
+	{0x1F04, 0x0345, 0x1F84},
+	{0x1F05, 0x0345, 0x1F85},
+	{0x1F06, 0x0345, 0x1F86},
+	{0x1F07, 0x0345, 0x1F87},
+	{0x1F08, 0x0300, 0x1F0A},
+	{0x1F08, 0x0301, 0x1F0C},
+	{0x1F08, 0x0342, 0x1F0E},
+	{0x1F08, 0x0345, 0x1F88},
+	{0x1F09, 0x0300, 0x1F0B},
+	{0x1F09, 0x0301, 0x1F0D},
+	{0x1F09, 0x0342, 0x1F0F},
+	{0x1F09, 0x0345, 0x1F89},
+	{0x1F0A, 0x0345, 0x1F8A},
+	{0x1F0B, 0x0345, 0x1F8B},
+	{0x1F0C, 0x0345, 0x1F8C},
+	{0x1F0D, 0x0345, 0x1F8D},
+	{0x1F0E, 0x0345, 0x1F8E},
+	{0x1F0F, 0x0345, 0x1F8F},
+	{0x1F10, 0x0300, 0x1F12},
+	{0x1F10, 0x0301, 0x1F14},
+	{0x1F11, 0x0300, 0x1F13},
+	{0x1F11, 0x0301, 0x1F15},
+	{0x1F18, 0x0300, 0x1F1A},
+	{0x1F18, 0x0301, 0x1F1C},
+	{0x1F19, 0x0300, 0x1F1B},
+	{0x1F19, 0x0301, 0x1F1D},
+	{0x1F20, 0x0300, 0x1F22},
+	{0x1F20, 0x0301, 0x1F24},
+	{0x1F20, 0x0342, 0x1F26},
+	{0x1F20, 0x0345, 0x1F90},
+	{0x1F21, 0x0300, 0x1F23},
+	{0x1F21, 0x0301, 0x1F25},
+	{0x1F21, 0x0342, 0x1F27},
+	{0x1F21, 0x0345, 0x1F91},
+	{0x1F22, 0x0345, 0x1F92},
+	{0x1F23, 0x0345, 0x1F93},
+	{0x1F24, 0x0345, 0x1F94},
+	{0x1F25, 0x0345, 0x1F95},
+	{0x1F26, 0x0345, 0x1F96},
+	{0x1F27, 0x0345, 0x1F97},
+	{0x1F28, 0x0300, 0x1F2A},
+	{0x1F28, 0x0301, 0x1F2C},
+	{0x1F28, 0x0342, 0x1F2E},
+	{0x1F28, 0x0345, 0x1F98},
+	{0x1F29, 0x0300, 0x1F2B},
+	{0x1F29, 0x0301, 0x1F2D},
+	{0x1F29, 0x0342, 0x1F2F},
+	{0x1F29, 0x0345, 0x1F99},
+	{0x1F2A, 0x0345, 0x1F9A},
+	{0x1F2B, 0x0345, 0x1F9B},
+	{0x1F2C, 0x0345, 0x1F9C},
+	{0x1F2D, 0x0345, 0x1F9D},
+	{0x1F2E, 0x0345, 0x1F9E},
+	{0x1F2F, 0x0345, 0x1F9F},
+	{0x1F30, 0x0300, 0x1F32},
+	{0x1F30, 0x0301, 0x1F34},
+	{0x1F30, 0x0342, 0x1F36},
+	{0x1F31, 0x0300, 0x1F33},
+	{0x1F31, 0x0301, 0x1F35},
+	{0x1F31, 0x0342, 0x1F37},
+	{0x1F38, 0x0300, 0x1F3A},
+	{0x1F38, 0x0301, 0x1F3C},
+	{0x1F38, 0x0342, 0x1F3E},
+	{0x1F39, 0x0300, 0x1F3B},
+	{0x1F39, 0x0301, 0x1F3D},
+	{0x1F39, 0x0342, 0x1F3F},
+	{0x1F40, 0x0300, 0x1F42},
+	{0x1F40, 0x0301, 0x1F44},
+	{0x1F41, 0x0300, 0x1F43},
+	{0x1F41, 0x0301, 0x1F45},
+	{0x1F48, 0x0300, 0x1F4A},
+	{0x1F48, 0x0301, 0x1F4C},
+	{0x1F49, 0x0300, 0x1F4B},
+	{0x1F49, 0x0301, 0x1F4D},
+	{0x1F50, 0x0300, 0x1F52},
+	{0x1F50, 0x0301, 0x1F54},
+	{0x1F50, 0x0342, 0x1F56},
+	{0x1F51, 0x0300, 0x1F53},
+	{0x1F51, 0x0301, 0x1F55},
+	{0x1F51, 0x0342, 0x1F57},
+	{0x1F59, 0x0300, 0x1F5B},
+	{0x1F59, 0x0301, 0x1F5D},
+	{0x1F59, 0x0342, 0x1F5F},
+	{0x1F60, 0x0300, 0x1F62},
+	{0x1F60, 0x0301, 0x1F64},
+	{0x1F60, 0x0342, 0x1F66},
+	{0x1F60, 0x0345, 0x1FA0},
+	{0x1F61, 0x0300, 0x1F63},
+	{0x1F61, 0x0301, 0x1F65},
+	{0x1F61, 0x0342, 0x1F67},
+	{0x1F61, 0x0345, 0x1FA1},
+	{0x1F62, 0x0345, 0x1FA2},
+	{0x1F63, 0x0345, 0x1FA3},
+	{0x1F64, 0x0345, 0x1FA4},
+	{0x1F65, 0x0345, 0x1FA5},
+	{0x1F66, 0x0345, 0x1FA6},
+	{0x1F67, 0x0345, 0x1FA7},
+	{0x1F68, 0x0300, 0x1F6A},
+	{0x1F68, 0x0301, 0x1F6C},
+	{0x1F68, 0x0342, 0x1F6E},
+	{0x1F68, 0x0345, 0x1FA8},
+	{0x1F69, 0x0300, 0x1F6B},
+	{0x1F69, 0x0301, 0x1F6D},
+	{0x1F69, 0x0342, 0x1F6F},
+	{0x1F69, 0x0345, 0x1FA9},
+	{0x1F6A, 0x0345, 0x1FAA},
+	{0x1F6B, 0x0345, 0x1FAB},
+	{0x1F6C, 0x0345, 0x1FAC},
+	{0x1F6D, 0x0345, 0x1FAD},
+	{0x1F6E, 0x0345, 0x1FAE},
+	{0x1F6F, 0x0345, 0x1FAF},
+	{0x1F70, 0x0345, 0x1FB2},
+	{0x1F74, 0x0345, 0x1FC2},
+	{0x1F7C, 0x0345, 0x1FF2},
+	{0x1FB6, 0x0345, 0x1FB7},
+	{0x1FBF, 0x0300, 0x1FCD},
+	{0x1FBF, 0x0301, 0x1FCE},
+	{0x1FBF, 0x0342, 0x1FCF},
+	{0x1FC6, 0x0345, 0x1FC7},
+	{0x1FF6, 0x0345, 0x1FF7},
+	{0x1FFE, 0x0300, 0x1FDD},
+	{0x1FFE, 0x0301, 0x1FDE},
+	{0x1FFE, 0x0342, 0x1FDF},
+	{0x2190, 0x0338, 0x219A},
+	{0x2192, 0x0338, 0x219B},
+	{0x2194, 0x0338, 0x21AE},
+	{0x21D0, 0x0338, 0x21CD},
+	{0x21D2, 0x0338, 0x21CF},
+	{0x21D4, 0x0338, 0x21CE},
+	{0x2203, 0x0338, 0x2204},
+	{0x2208, 0x0338, 0x2209},
+	{0x220B, 0x0338, 0x220C},
+	{0x2223, 0x0338, 0x2224},
+	{0x2225, 0x0338, 0x2226},
+	{0x223C, 0x0338, 0x2241},
+	{0x2243, 0x0338, 0x2244},
+	{0x2245, 0x0338, 0x2247},
+	{0x2248, 0x0338, 0x2249},
+	{0x224D, 0x0338, 0x226D},
+	{0x2261, 0x0338, 0x2262},
+	{0x2264, 0x0338, 0x2270},
+	{0x2265, 0x0338, 0x2271},
+	{0x2272, 0x0338, 0x2274},
+	{0x2273, 0x0338, 0x2275},
+	{0x2276, 0x0338, 0x2278},
+	{0x2277, 0x0338, 0x2279},
+	{0x227A, 0x0338, 0x2280},
+	{0x227B, 0x0338, 0x2281},
+	{0x227C, 0x0338, 0x22E0},
+	{0x227D, 0x0338, 0x22E1},
+	{0x2282, 0x0338, 0x2284},
+	{0x2283, 0x0338, 0x2285},
+	{0x2286, 0x0338, 0x2288},
+	{0x2287, 0x0338, 0x2289},
+	{0x2291, 0x0338, 0x22E2},
+	{0x2292, 0x0338, 0x22E3},
+	{0x22A2, 0x0338, 0x22AC},
+	{0x22A8, 0x0338, 0x22AD},
+	{0x22A9, 0x0338, 0x22AE},
+	{0x22AB, 0x0338, 0x22AF},
+	{0x22B2, 0x0338, 0x22EA},
+	{0x22B3, 0x0338, 0x22EB},
+	{0x22B4, 0x0338, 0x22EC},
+	{0x22B5, 0x0338, 0x22ED},
+	{0x3046, 0x3099, 0x3094},
+	{0x304B, 0x3099, 0x304C},
+	{0x304D, 0x3099, 0x304E},
+	{0x304F, 0x3099, 0x3050},
+	{0x3051, 0x3099, 0x3052},
+	{0x3053, 0x3099, 0x3054},
+	{0x3055, 0x3099, 0x3056},
+	{0x3057, 0x3099, 0x3058},
+	{0x3059, 0x3099, 0x305A},
+	{0x305B, 0x3099, 0x305C},
+	{0x305D, 0x3099, 0x305E},
+	{0x305F, 0x3099, 0x3060},
+	{0x3061, 0x3099, 0x3062},
+	{0x3064, 0x3099, 0x3065},
+	{0x3066, 0x3099, 0x3067},
+	{0x3068, 0x3099, 0x3069},
+	{0x306F, 0x3099, 0x3070},
+	{0x306F, 0x309A, 0x3071},
+	{0x3072, 0x3099, 0x3073},
+	{0x3072, 0x309A, 0x3074},
+	{0x3075, 0x3099, 0x3076},
+	{0x3075, 0x309A, 0x3077},
+	{0x3078, 0x3099, 0x3079},
+	{0x3078, 0x309A, 0x307A},
+	{0x307B, 0x3099, 0x307C},
+	{0x307B, 0x309A, 0x307D},
+	{0x309D, 0x3099, 0x309E},
+	{0x30A6, 0x3099, 0x30F4},
+	{0x30AB, 0x3099, 0x30AC},
+	{0x30AD, 0x3099, 0x30AE},
+	{0x30AF, 0x3099, 0x30B0},
+	{0x30B1, 0x3099, 0x30B2},
+	{0x30B3, 0x3099, 0x30B4},
+	{0x30B5, 0x3099, 0x30B6},
+	{0x30B7, 0x3099, 0x30B8},
+	{0x30B9, 0x3099, 0x30BA},
+	{0x30BB, 0x3099, 0x30BC},
+	{0x30BD, 0x3099, 0x30BE},
+	{0x30BF, 0x3099, 0x30C0},
+	{0x30C1, 0x3099, 0x30C2},
+	{0x30C4, 0x3099, 0x30C5},
+	{0x30C6, 0x3099, 0x30C7},
+	{0x30C8, 0x3099, 0x30C9},
+	{0x30CF, 0x3099, 0x30D0},
+	{0x30CF, 0x309A, 0x30D1},
+	{0x30D2, 0x3099, 0x30D3},
+	{0x30D2, 0x309A, 0x30D4},
+	{0x30D5, 0x3099, 0x30D6},
+	{0x30D5, 0x309A, 0x30D7},
+	{0x30D8, 0x3099, 0x30D9},
+	{0x30D8, 0x309A, 0x30DA},
+	{0x30DB, 0x3099, 0x30DC},
+	{0x30DB, 0x309A, 0x30DD},
+	{0x30EF, 0x3099, 0x30F7},
+	{0x30F0, 0x3099, 0x30F8},
+	{0x30F1, 0x3099, 0x30F9},
+	{0x30F2, 0x3099, 0x30FA},
+	{0x30FD, 0x3099, 0x30FE},
+	{0x105D2, 0x0307, 0x105C9},
+	{0x105DA, 0x0307, 0x105E4},
+	{0x11099, 0x110BA, 0x1109A},
+	{0x1109B, 0x110BA, 0x1109C},
+	{0x110A5, 0x110BA, 0x110AB},
+	{0x11131, 0x11127, 0x1112E},
+	{0x11132, 0x11127, 0x1112F},
+	{0x11347, 0x1133E, 0x1134B},
+	{0x11347, 0x11357, 0x1134C},
+	{0x11382, 0x113C9, 0x11383},
+	{0x11384, 0x113BB, 0x11385},
+	{0x1138B, 0x113C2, 0x1138E},
+	{0x11390, 0x113C9, 0x11391},
+	{0x113C2, 0x113B8, 0x113C7},
+	{0x113C2, 0x113C2, 0x113C5},
+	{0x113C2, 0x113C9, 0x113C8},
+	{0x114B9, 0x114B0, 0x114BC},
+	{0x114B9, 0x114BA, 0x114BB},
+	{0x114B9, 0x114BD, 0x114BE},
+	{0x115B8, 0x115AF, 0x115BA},
+	{0x115B9, 0x115AF, 0x115BB},
+	{0x11935, 0x11930, 0x11938},
+	{0x1611E, 0x1611E, 0x16121},
+	{0x1611E, 0x1611F, 0x16123},
+	{0x1611E, 0x16120, 0x16125},
+	{0x1611E, 0x16129, 0x16122},
+	{0x16121, 0x1611F, 0x16126},
+	{0x16121, 0x16120, 0x16128},
+	{0x16122, 0x1611F, 0x16127},
+	{0x16129, 0x1611F, 0x16124},
+	{0x16D63, 0x16D67, 0x16D69},
+	{0x16D67, 0x16D67, 0x16D68},
+	{0x16D69, 0x16D67, 0x16D6A},
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"testing"
+)
+
+// TestNormalize validates the NFC and NFKC forms
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		s    string
+		nfc  string
+		nfkc string
+	}{
+		{"", "", ""},
+		{"abc", "abc", "abc"},
+		{"e\u0301", "\u00e9", "\u00e9"},
+		{"\u00e9", "\u00e9", "\u00e9"},
+		{"A\u030a", "\u00c5", "\u00c5"},
+		{"\u212b", "\u00c5", "\u00c5"},
+		{"q\u0307\u0323", "q\u0323\u0307", "q\u0323\u0307"},
+		{"\u1e0b\u0323", "\u1e0d\u0307", "\u1e0d\u0307"},
+		{"\u1100\u1161\u11a8", "\uac01", "\uac01"},
+		{"가\u11a8", "\uac01", "\uac01"},
+		{"\u0958", "\u0915\u093c", "\u0915\u093c"},
+		{"\ufb01", "\ufb01", "fi"},
+		{"x\u00b2", "x\u00b2", "x2"},
+		{"\u1e9b\u0323", "\u1e9b\u0323", "\u1e69"},
+		{"\u0301e", "\u0301e", "\u0301e"},
+		{"\ua7f1", "\ua7f1", "S"},
+		{"\U0001ccd6\U0001cce8", "\U0001ccd6\U0001cce8", "AS"},
+		{"a\u0301\u0897\u0323", "\u1ea1\u0301\u0897", "\u1ea1\u0301\u0897"},
+	}
+
+	for i, c := range cases {
+		if result := string(NFC.normalize([]rune(c.s), false)); result != c.nfc {
+			t.Errorf("Test %d: NFC expected `%+q`, found `%+q`; With String: `%+q`", i+1, c.nfc, result, c.s)
+		}
+		if result := string(NFKC.normalize([]rune(c.s), false)); result != c.nfkc {
+			t.Errorf("Test %d: NFKC expected `%+q`, found `%+q`; With String: `%+q`", i+1, c.nfkc, result, c.s)
+		}
+		if result := string(NoNormalization.normalize([]rune(c.s), false)); result != c.s {
+			t.Errorf("Test %d: NoNormalization expected `%+q`, found `%+q`", i+1, c.s, result)
+		}
+	}
+}
+
+// TestMatchOptionsNormalization validates the matching of equivalent strings
+func TestMatchOptionsNormalization(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		form    Normalization
+		result  bool
+	}{
+		{"caf\u00e9.txt", "cafe\u0301.txt", NoNormalization, false},
+		{"caf\u00e9.txt", "cafe\u0301.txt", NFC, true},
+		{"cafe\u0301.txt", "caf\u00e9.txt", NFC, true},
+		{"cafe\u0301.txt", `caf?\.txt`, NoNormalization, false},
+		{"cafe\u0301.txt", `caf?\.txt`, NFC, true},
+		{"cafe\u0301.txt", "caf..txt", NFC, true},
+		{"cafe\u0301.txt", "caf[\u00e9].txt", NFC, true},
+		{"cafe\u0301.txt", "caf[[:alpha:]].txt", NFC, true},
+		{"cafe\u0301.txt", "*\u00e9*", NFC, true},
+		{"caf\u00e9.txt", "caf\\e\u0301.txt", NFC, true},
+		{"\ufb01le", "file", NFC, false},
+		{"\ufb01le", "file", NFKC, true},
+		{"file", "\ufb01le", NFKC, true},
+		{"x\u00b2", "x[0-9]", NFKC, true},
+		{"a*b", "a\uff0ab", NFKC, true},
+		{"axb", "a\uff0ab", NFKC, false},
+		{"a\\*b", "a\\\\\uff0ab", NFKC, true},
+		{"ab", "a[a\ufe63z]", NFKC, false},
+		{"a-", "a[a\ufe63z]", NFKC, true},
+	}
+
+	for i, c := range cases {
+		o := MatchOptions{Normalization: c.form}
+		if result := o.MatchByRune(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+
+		p, err := o.Compile(c.pattern)
+		if err != nil {
+			t.Fatalf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+		}
+		if result := p.MatchRunes([]rune(c.s)); c.result != result {
+			t.Errorf("Test %d: MatchRunes expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+// MatchOptions changes the way a pattern is matched.
+// The zero value matches like the package level functions.
+type MatchOptions struct {
+	// PathSeparator, if not zero, can only be matched by itself written
+	// literally in the pattern: '*', '?', '.' and bracket expressions never
+	// match it, like FNM_PATHNAME and FNM_FILE_NAME of GNU libc.
+	// A "**" path segment is then a globstar matching zero or more segments,
+	// so "src/**/*.go" matches "src/main.go" and "src/a/b/main.go".
+	// It needs to be an ASCII character to be used with byte comparison.
+	PathSeparator rune
+
+	// Fold makes the matching case-insensitive, under Unicode simple case folding
+	// with rune comparison, and ASCII only case folding with byte comparison.
+	// Like strings.EqualFold, no lowered copy of the pattern or the string is made.
+	Fold bool
+
+	// Normalization, if not NoNormalization, normalizes the pattern and the string
+	// to NFC or NFKC before matching them, so canonically equivalent strings match,
+	// like a precomposed "é" and an "e" followed by a combining accent.
+	// It only applies to rune comparison.
+	Normalization Normalization
+}
+
+// MatchPath returns true if the pattern matches the path s,
+// where the wildcards never match the '/' separator but a "**" segment.
+// It uses byte comparison, like Match.
+func MatchPath(pattern, s string) bool {
+	return MatchOptions{PathSeparator: '/'}.Match(pattern, s)
+}
+
+// MatchFold returns true if the pattern matches the string s, ignoring the case
+// of ASCII letters. It uses byte comparison, like Match.
+func MatchFold(pattern, s string) bool {
+	return MatchOptions{Fold: true}.Match(pattern, s)
+}
+
+// MatchByRuneFold returns true if the pattern matches the string s, under Unicode
+// simple case folding. It uses rune comparison, like MatchByRune.
+func MatchByRuneFold(pattern, s string) bool {
+	return MatchOptions{Fold: true}.MatchByRune(pattern, s)
+}
+
+// Match returns true if the pattern matches the string s, using byte comparison.
+func (o MatchOptions) Match(pattern, s string) bool {
+	if pattern == "" {
+		return s == pattern
+	}
+
+	return matchByString(pattern, s, o.separator(), o.Fold)
+}
+
+// MatchByRune returns true if the pattern matches the string s, using rune comparison.
+func (o MatchOptions) MatchByRune(pattern, s string) bool {
+	if pattern == "" {
+		return s == pattern
+	}
+
+	return matchByRunes(
+		o.Normalization.normalize([]rune(pattern), true),
+		o.Normalization.normalize([]rune(s), false),
+		o.separator(), o.Fold,
+	)
+}
+
+// MatchFromByte returns true if the pattern matches the byte slice s, using byte comparison.
+func (o MatchOptions) MatchFromByte(pattern, s []byte) bool {
+	if len(pattern) == 0 {
+		return len(s) == 0
+	}
+
+	return matchByByte(pattern, s, o.separator(), o.Fold)
+}
+
+// Compile parses a wildcard pattern like the package level Compile,
+// the returned Pattern matches with the options.
+func (o MatchOptions) Compile(pattern string) (*Pattern, error) {
+	return compile(pattern, o)
+}
+
+// separator returns the separator as expected by the matchers,
+// which is -1 when there is none.
+func (o MatchOptions) separator() int {
+	if o.PathSeparator == 0 {
+		return -1
+	}
+
+	return int(o.PathSeparator)
+}
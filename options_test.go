@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"testing"
+)
+
+// TestMatchOptions validates that every matching mode
+// and compiled patterns honor the options
+func TestMatchOptions(t *testing.T) {
+	cases := []struct {
+		options MatchOptions
+		s       string
+		pattern string
+		result  bool
+	}{
+		{MatchOptions{}, "a/b", "*", true},
+		{MatchOptions{}, "a/b", "a.b", true},
+		{MatchOptions{PathSeparator: '/'}, "", "", true},
+		{MatchOptions{PathSeparator: '/'}, "a/b", "", false},
+		{MatchOptions{PathSeparator: '/'}, "a/b", "*", false},
+		{MatchOptions{PathSeparator: '/'}, "a/b", "*/*", true},
+		{MatchOptions{PathSeparator: '/'}, "a/b", "a.b", false},
+		{MatchOptions{PathSeparator: '/'}, "a/b", "a*", false},
+		{MatchOptions{PathSeparator: '/'}, "ab/b", "a*/b", true},
+		{MatchOptions{PathSeparator: '/'}, "abc", "a*c", true},
+		{MatchOptions{PathSeparator: '/'}, "abc", "a*", true},
+		{MatchOptions{PathSeparator: ':'}, "a:b", "*:*", true},
+		{MatchOptions{PathSeparator: ':'}, "a:b", "*", false},
+		{MatchOptions{PathSeparator: '\\'}, `C:\Windows`, `C:\\*`, true},
+		{MatchOptions{PathSeparator: '\\'}, `C:\Windows\System32`, `C:\\*`, false},
+		{MatchOptions{Fold: true}, "Content-Type", "content-type", true},
+		{MatchOptions{Fold: true}, "Content-Type", "CONTENT-*", true},
+		{MatchOptions{Fold: true}, "Content-Type", "content", false},
+		{MatchOptions{Fold: true}, "Content-Type", "*-[t]ype", true},
+		{MatchOptions{Fold: true, PathSeparator: '/'}, "SRC/Main.GO", "src/*.go", true},
+		{MatchOptions{Fold: true, PathSeparator: '/'}, "SRC/A/Main.GO", "src/*.go", false},
+	}
+
+	for i, c := range cases {
+		p, err := c.options.Compile(c.pattern)
+		if err != nil {
+			t.Fatalf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+		}
+
+		if result := c.options.Match(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: Match expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := c.options.MatchByRune(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: MatchByRune expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := c.options.MatchFromByte([]byte(c.pattern), []byte(c.s)); c.result != result {
+			t.Errorf("Test %d: MatchFromByte expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := p.Match(c.s); c.result != result {
+			t.Errorf("Test %d: Pattern.Match expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := p.MatchRunes([]rune(c.s)); c.result != result {
+			t.Errorf("Test %d: Pattern.MatchRunes expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	if !MatchPath("src/*/*.go", "src/wildcard/match.go") {
+		t.Errorf("Expected `src/*/*.go` to match `src/wildcard/match.go`")
+	}
+	if MatchPath("src/*.go", "src/wildcard/match.go") {
+		t.Errorf("Expected `src/*.go` to not match `src/wildcard/match.go`")
+	}
+	if !MatchPath("src/**/*.go", "src/wildcard/match.go") || !MatchPath("src/**/*.go", "src/match.go") {
+		t.Errorf("Expected `src/**/*.go` to match at any depth")
+	}
+}
+
+// TestMatchByRuneFold validates the Unicode simple
+// case folding with rune comparison
+func TestMatchByRuneFold(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		result  bool
+	}{
+		{"É", "é", true},
+		{"ÉCOLE", "éc?le", true},
+		{"Straße", "STRAẞE", true},
+		{"ΣΊΣΥΦΟΣ", "σίσυφος", true},
+		{"σίσυφος", "ΣΊΣΥΦΟ[Σ]", true},
+		{"ς", "Σ", true},
+		{"\u212a", "k", true},
+		{"ÉCOLE", "[à-ÿ]cole", true},
+		{"école", "[!À-Þ]cole", false},
+		{"Ö", "o", false},
+	}
+
+	for i, c := range cases {
+		if result := MatchByRuneFold(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+
+	if MatchFold("é", "É") || !MatchFold("é", "é") {
+		t.Errorf("Expected MatchFold to only fold ASCII letters")
+	}
+}
+
+// TestMatchOptionsRune validates that a non ASCII
+// separator is honored with rune comparison
+func TestMatchOptionsRune(t *testing.T) {
+	o := MatchOptions{PathSeparator: '→'}
+	if !o.MatchByRune("*→*", "a→b") || o.MatchByRune("*", "a→b") || o.MatchByRune("a.b", "a→b") {
+		t.Errorf("Expected `→` to be a separator with rune comparison")
+	}
+}
@@ -0,0 +1,580 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"bytes"
+	"strings"
+)
+
+type tokenKind uint8
+
+const (
+	tokenLiteral tokenKind = iota
+	tokenStar
+	tokenDot
+	tokenEroteme
+	tokenClass
+	tokenEngine // a group, left to the matching engine
+)
+
+// token is one instruction of a compiled pattern.
+// A literal token holds a run of unescaped bytes as offsets into the text,
+// other tokens hold their own offsets in the pattern.
+type token struct {
+	kind       tokenKind
+	start, end int
+
+	// class is the index of the bytes of a bracket expression in Pattern.classes.
+	class int
+}
+
+// chunk is a run of tokens between two stars of a direct program.
+// It matches from min to max bytes, the same number unless it holds a '?'.
+type chunk struct {
+	tokens   []token
+	min, max int
+
+	// A chunk holding a '?' is matched by simulating all its units at once,
+	// bit i of a state set meaning that the first i units have matched.
+	// next holds for every byte the units matching it, erotemes the units
+	// which can also match nothing, and lead the literal bytes it starts with.
+	next      *[256]uint64
+	erotemes  uint64
+	units     int
+	lead      string
+	leadBytes []byte
+}
+
+// maxUnits is the number of bytes and wildcards a chunk holding a '?'
+// can have to be matched with a state set in an uint64.
+const maxUnits = 63
+
+// Pattern is a compiled wildcard pattern.
+// A Pattern is safe for concurrent use by multiple goroutines.
+type Pattern struct {
+	pattern string
+	bytes   []byte
+	runes   []rune
+	program []token
+	chunks  []chunk
+	classes [][4]uint64
+
+	// text holds the unescaped literal runs of the program.
+	text      string
+	textBytes []byte
+
+	// prefix and suffix are the literal runs before the first and after
+	// the last wildcard, any match must start and end with them.
+	prefix, suffix string
+
+	separator     int
+	fold          bool
+	normalization Normalization
+	literal       bool
+
+	// globstar is set when a "**" path segment can match nothing, separators included,
+	// so the literals around it are not required as is.
+	globstar bool
+
+	// direct is set when the program has no group, so Match and MatchBytes
+	// run its chunks without the matching engine.
+	direct bool
+
+	// star is set when the program is a single star without separator,
+	// matching any string.
+	star bool
+
+	// textRunes holds the runes of a literal pattern for MatchRunes.
+	textRunes []rune
+}
+
+// Compile parses a wildcard pattern and returns a Pattern that can be
+// used to match against many strings. A pattern without group is run from its
+// compiled program without scanning the pattern again, the others are checked
+// against their literal prefix and suffix, then matched by the engine of the
+// package level functions.
+// It returns ErrBadPattern if the pattern ends with a lone escape,
+// contains a malformed bracket expression or an unclosed brace group.
+func Compile(pattern string) (*Pattern, error) {
+	return compile(pattern, MatchOptions{})
+}
+
+func compile(pattern string, o MatchOptions) (*Pattern, error) {
+	if err := checkPattern(pattern); err != nil {
+		return nil, err
+	}
+
+	p := &Pattern{
+		pattern:       pattern,
+		bytes:         []byte(pattern),
+		runes:         o.Normalization.normalize([]rune(pattern), true),
+		separator:     o.separator(),
+		fold:          o.Fold,
+		normalization: o.Normalization,
+		direct:        o.PathSeparator == 0 && !o.Fold,
+	}
+
+	var text strings.Builder
+	start := 0
+	for i := 0; i < len(pattern); i++ {
+		t := token{start: i, end: i + 1}
+		switch pattern[i] {
+		case '\\':
+			i++
+			text.WriteByte(pattern[i])
+			continue
+		case '*':
+			t.kind = tokenStar
+			if matchByStringGlobstar(pattern, i, p.separator) != -1 {
+				p.globstar = true
+			}
+		case '.':
+			t.kind = tokenDot
+		case '?':
+			t.kind = tokenEroteme
+		case '[':
+			end, _ := matchByStringClass(pattern, i, 0, false)
+			t.kind = tokenClass
+			t.end = end + 1
+			t.class = len(p.classes)
+			p.classes = append(p.classes, classBytes(pattern, i))
+			i = end
+		case '{':
+			end := matchByStringClose(pattern, i)
+			t.kind = tokenEngine
+			t.end = end + 1
+			p.direct = false
+			i = end
+		default:
+			text.WriteByte(pattern[i])
+			continue
+		}
+
+		if start < text.Len() {
+			p.program = append(p.program, token{kind: tokenLiteral, start: start, end: text.Len()})
+			start = text.Len()
+		}
+		// Consecutive stars are equivalent to a single one.
+		if t.kind != tokenStar || len(p.program) == 0 || p.program[len(p.program)-1].kind != tokenStar {
+			p.program = append(p.program, t)
+		}
+	}
+	if start < text.Len() {
+		p.program = append(p.program, token{kind: tokenLiteral, start: start, end: text.Len()})
+	}
+	p.text = text.String()
+	p.textBytes = []byte(p.text)
+	if p.direct {
+		p.compileChunks()
+		p.star = len(p.program) == 1 && p.program[0].kind == tokenStar
+	}
+
+	// The literal shortcuts compare bytes as is, so they are not used with folding,
+	// nor with a globstar which can absorb the separators around it, like "a/**/b" matching "a/b".
+	switch {
+	case o.Fold, p.globstar:
+	case len(p.program) == 0, len(p.program) == 1 && p.program[0].kind == tokenLiteral:
+		p.literal = true
+		p.textRunes = []rune(p.text)
+	default:
+		if first := p.program[0]; first.kind == tokenLiteral {
+			p.prefix = p.text[first.start:first.end]
+		}
+		if last := p.program[len(p.program)-1]; last.kind == tokenLiteral {
+			p.suffix = p.text[last.start:last.end]
+		}
+	}
+
+	return p, nil
+}
+
+// checkPattern returns ErrBadPattern if the pattern cannot be matched.
+func checkPattern(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) {
+				return ErrBadPattern
+			}
+			i++
+		case '[':
+			end, _ := matchByStringClass(pattern, i, 0, false)
+			if end == -1 {
+				return ErrBadPattern
+			}
+			i = end
+		case '{':
+			if matchByStringClose(pattern, i) == -1 {
+				return ErrBadPattern
+			}
+		}
+	}
+
+	return nil
+}
+
+// MustCompile is like Compile but panics if the pattern cannot be parsed.
+// It simplifies safe initialization of global variables holding patterns.
+func MustCompile(pattern string) *Pattern {
+	p, err := Compile(pattern)
+	if err != nil {
+		panic("wildcard: Compile(" + pattern + "): " + err.Error())
+	}
+
+	return p
+}
+
+// String returns the source text used to compile the pattern.
+func (p *Pattern) String() string {
+	return p.pattern
+}
+
+// Match reports whether the pattern matches the string s.
+// It behaves like the package level Match function, with the options
+// used to compile the pattern.
+func (p *Pattern) Match(s string) bool {
+	switch {
+	case p.literal:
+		return s == p.text
+	case p.star:
+		return true
+	case p.direct:
+		return matchProgram(p, s)
+	}
+	if len(s) < len(p.prefix)+len(p.suffix) ||
+		s[:len(p.prefix)] != p.prefix ||
+		s[len(s)-len(p.suffix):] != p.suffix {
+		return false
+	}
+
+	return matchByString(p.pattern, s, p.separator, p.fold)
+}
+
+// MatchBytes reports whether the pattern matches the byte slice s.
+// It uses byte comparison, like the package level Match function.
+func (p *Pattern) MatchBytes(s []byte) bool {
+	switch {
+	case p.literal:
+		return string(s) == p.text
+	case p.star:
+		return true
+	case p.direct:
+		return matchProgram(p, s)
+	}
+	if len(s) < len(p.prefix)+len(p.suffix) ||
+		string(s[:len(p.prefix)]) != p.prefix ||
+		string(s[len(s)-len(p.suffix):]) != p.suffix {
+		return false
+	}
+
+	return matchByByte(p.bytes, s, p.separator, p.fold)
+}
+
+// MatchRunes reports whether the pattern matches the rune slice s.
+// It behaves like the package level MatchByRune function,
+// without converting the pattern on every call.
+func (p *Pattern) MatchRunes(s []rune) bool {
+	if p.normalization != NoNormalization {
+		return matchByRunes(p.runes, p.normalization.normalize(s, false), p.separator, p.fold)
+	}
+	switch {
+	case p.literal:
+		return runesEqual(s, p.textRunes)
+	case p.star:
+		return true
+	}
+
+	return matchByRunes(p.runes, s, p.separator, p.fold)
+}
+
+// middle returns the program without its literal prefix and suffix.
+func (p *Pattern) middle() []token {
+	program := p.program
+	if p.prefix != "" {
+		program = program[1:]
+	}
+	if p.suffix != "" {
+		program = program[:len(program)-1]
+	}
+
+	return program
+}
+
+// compileChunks splits the program at its stars. A chunk holding a '?' gets
+// the state set tables, or the program is left to the engine if it is too long.
+func (p *Pattern) compileChunks() {
+	first := 0
+	for i := 0; i <= len(p.program); i++ {
+		if i < len(p.program) && p.program[i].kind != tokenStar {
+			continue
+		}
+
+		c := chunk{tokens: p.program[first:i]}
+		for _, t := range c.tokens {
+			switch t.kind {
+			case tokenLiteral:
+				c.min += t.end - t.start
+				c.max += t.end - t.start
+			case tokenEroteme:
+				c.max++
+			default:
+				c.min++
+				c.max++
+			}
+		}
+		if c.max != c.min && !p.compileUnits(&c) {
+			p.direct = false
+			p.chunks = nil
+			return
+		}
+
+		p.chunks = append(p.chunks, c)
+		first = i + 1
+	}
+}
+
+// compileUnits fills the state set tables of c, one unit for each byte
+// of a literal run and for each wildcard. It returns false if c has too many units.
+func (p *Pattern) compileUnits(c *chunk) bool {
+	c.next = new([256]uint64)
+	n := 0
+	for _, t := range c.tokens {
+		if t.kind == tokenLiteral {
+			if n+t.end-t.start > maxUnits {
+				return false
+			}
+			if n == 0 {
+				c.lead = p.text[t.start:t.end]
+				c.leadBytes = p.textBytes[t.start:t.end]
+			}
+			for j := t.start; j < t.end; j++ {
+				c.next[p.text[j]] |= 1 << n
+				n++
+			}
+			continue
+		}
+
+		if n == maxUnits {
+			return false
+		}
+		for b := range c.next {
+			if t.kind != tokenClass || p.classes[t.class][b/64]&(1<<(b%64)) != 0 {
+				c.next[b] |= 1 << n
+			}
+		}
+		if t.kind == tokenEroteme {
+			c.erotemes |= 1 << n
+		}
+		n++
+	}
+	c.units = n
+
+	return true
+}
+
+// classBytes returns the bytes matched by the bracket expression at pattern[start].
+func classBytes(pattern string, start int) [4]uint64 {
+	var set [4]uint64
+	for b := 0; b < 256; b++ {
+		if _, ok := matchByStringClass(pattern, start, byte(b), false); ok {
+			set[b/64] |= 1 << (b % 64)
+		}
+	}
+
+	return set
+}
+
+// matchProgram reports whether the chunks of p match s. Between two stars,
+// a chunk is matched where it ends first, the first one at the start of s
+// and the last one at its end, so a star never has to give bytes back.
+func matchProgram[T string | []byte](p *Pattern, s T) bool {
+	chunks := p.chunks
+	if len(chunks) == 1 {
+		return matchChunk(p, &chunks[0], s, true, true) != -1
+	}
+
+	// The chunks before the last one have to leave it enough bytes,
+	// and when it has a fixed width it is checked first.
+	tail := &chunks[len(chunks)-1]
+	limit := len(s) - tail.min
+	if limit < 0 || tail.next == nil && matchChunk(p, tail, s, false, true) == -1 {
+		return false
+	}
+
+	i := matchChunk(p, &chunks[0], s[:limit], true, false)
+	if i == -1 {
+		return false
+	}
+	for c := 1; c < len(chunks)-1; c++ {
+		j := matchChunk(p, &chunks[c], s[i:limit], false, false)
+		if j == -1 {
+			return false
+		}
+		i += j
+	}
+
+	return tail.next == nil || matchChunk(p, tail, s[i:], false, true) != -1
+}
+
+// matchChunk returns the end of the first match of c in s, or -1.
+// The match starts at s[0] when anchored, and ends at len(s) with end.
+func matchChunk[T string | []byte](p *Pattern, c *chunk, s T, anchored, end bool) int {
+	if c.next != nil {
+		// A match ending at len(s) starts at most max bytes before.
+		if end && !anchored && len(s) > c.max {
+			if matchUnits(c, s[len(s)-c.max:], false, true) == -1 {
+				return -1
+			}
+			return len(s)
+		}
+		return matchUnits(c, s, anchored, end)
+	}
+
+	switch {
+	case len(s) < c.min, end && anchored && len(s) != c.min:
+		return -1
+	case end:
+		if !matchTokens(p, s[len(s)-c.min:], c.tokens) {
+			return -1
+		}
+		return len(s)
+	case anchored:
+		if !matchTokens(p, s, c.tokens) {
+			return -1
+		}
+		return c.min
+	}
+
+	if i := indexTokens(p, s, c.tokens, c.min); i != -1 {
+		return i + c.min
+	}
+
+	return -1
+}
+
+// matchUnits is matchChunk for a chunk holding a '?'. It simulates its units at once,
+// starting them again at every byte of s unless anchored.
+func matchUnits[T string | []byte](c *chunk, s T, anchored, end bool) int {
+	accept := uint64(1) << c.units
+	start := closeUnits(c, 1)
+
+	states := start
+	for i := 0; ; i++ {
+		if states&accept != 0 && (!end || i == len(s)) {
+			return i
+		}
+		if i == len(s) || anchored && states == 0 {
+			return -1
+		}
+
+		// Only the units started at this byte are alive,
+		// so skip to the next place where the chunk can start.
+		if !anchored && states == start && c.lead != "" {
+			var j int
+			switch s := any(s[i:]).(type) {
+			case string:
+				j = strings.Index(s, c.lead)
+			case []byte:
+				j = bytes.Index(s, c.leadBytes)
+			}
+			if j == -1 {
+				return -1
+			}
+			i += j
+		}
+
+		states = closeUnits(c, (states&c.next[s[i]])<<1)
+		if !anchored {
+			states |= start
+		}
+	}
+}
+
+// closeUnits adds to states the units following the erotemes matching nothing.
+func closeUnits(c *chunk, states uint64) uint64 {
+	for e := states & c.erotemes; e != 0; e &= c.erotemes {
+		e = e << 1 &^ states
+		states |= e
+	}
+
+	return states
+}
+
+// matchTokens reports whether the literal runs, dots and bracket expressions
+// of tokens match the start of s, which holds at least their width in bytes.
+func matchTokens[T string | []byte](p *Pattern, s T, tokens []token) bool {
+	i := 0
+	for _, t := range tokens {
+		switch t.kind {
+		case tokenDot:
+			i++
+			continue
+		case tokenClass:
+			if p.classes[t.class][s[i]/64]&(1<<(s[i]%64)) == 0 {
+				return false
+			}
+			i++
+			continue
+		}
+		for j := t.start; j < t.end; j++ {
+			if s[i] != p.text[j] {
+				return false
+			}
+			i++
+		}
+	}
+
+	return true
+}
+
+// indexTokens returns the index of the first position where tokens, of n bytes, match s, or -1.
+// It looks for their first literal run with strings.Index or bytes.Index.
+func indexTokens[T string | []byte](p *Pattern, s T, tokens []token, n int) int {
+	lead := 0
+	for lead < len(tokens) && tokens[lead].kind != tokenLiteral {
+		lead++
+	}
+
+	for i := 0; i+n <= len(s); i++ {
+		if lead < len(tokens) {
+			t := tokens[lead]
+			var j int
+			switch s := any(s[i+lead:]).(type) {
+			case string:
+				j = strings.Index(s, p.text[t.start:t.end])
+			case []byte:
+				j = bytes.Index(s, p.textBytes[t.start:t.end])
+			}
+			if j < 0 || i+j+n > len(s) {
+				return -1
+			}
+			i += j
+		}
+		if matchTokens(p, s[i:], tokens) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// runesEqual reports whether the runes of s are exactly the runes of text.
+func runesEqual(s, text []rune) bool {
+	if len(s) != len(text) {
+		return false
+	}
+	for i := range s {
+		if s[i] != text[i] {
+			return false
+		}
+	}
+
+	return true
+}
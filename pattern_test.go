@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestPattern validates that a compiled pattern gives the same result
+// as the package level functions, for every matching mode
+func TestPattern(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		result  bool
+	}{
+		{"", "", true},
+		{"", "*", true},
+		{"", "?", true},
+		{"", ".", false},
+		{"a", "", false},
+		{"a", "a", true},
+		{"a", "**", true},
+		{"ab", "a*b", true},
+		{"ab", "a**b", true},
+		{"ab", "ab*", true},
+		{"ab", "*ab", true},
+		{"aba", "ab*ba", false},
+		{"abba", "ab*ba", true},
+		{"abcba", "ab*ba", true},
+		{"abcba", "a*c*a", true},
+		{"abcba", "a*d*a", false},
+		{"abcba", "a*c*c*a", false},
+		{"abcba", "a?c?a", true},
+		{"abcba", "a.c.a", true},
+		{"abcba", "a.c.", false},
+		{"abcba", ".b*", true},
+		{"abcba", ".c*", false},
+		{"abcabd", "*a.d", true},
+		{"abcabd", "*a.c", false},
+		{"xaybzaybc", "*a.b*a.bc", true},
+		{"xaybzaybd", "*a.b*a.bc", false},
+		{"abc", "*.*.*.*", true},
+		{"ab", "*.*.*.*", false},
+		{"aab", "*..b*", true},
+		{"match a string with a * at the beginning", "* at the beginning", true},
+		{"do not match a string with extra and a *", "do not match a string * with more", false},
+		{"A big brown fox jumps over the lazy dog, with all there wildcards friends", ". big?brown fox jumps over * wildcard. friend??", true},
+		{"A big brown fox fails to jump over the lazy dog, with all there wildcards friends", ". big?brown fox jumps over * wildcard. friend??", false},
+		{"a*b", `a\*b`, true},
+		{"axb", `a\*b`, false},
+		{"a.b.c", `*\.*\.*`, true},
+		{"a.bxc", `*\.*\.*`, false},
+		{`a\b`, `*\\*`, true},
+		{"a1b", "a[0-9]b", true},
+		{"a1b", "*[[:digit:]]*", true},
+		{"axb", "*[[:digit:]]*", false},
+		{"[a]", `\[a]`, true},
+		{"logs/app-1.log", "logs/{app,worker}-*.log", true},
+		{"logs/web-1.log", "logs/{app,worker}-*.log", false},
+		{"{b}", "[{]*", true},
+		{"a,b}", "a,b}", true},
+		{"T🥵🤷🏾‍♂️🥓", "*🤷🏾‍♂️*", true},
+		{"T🥵🤷🏾‍♂️🥓", "T.🤷🏾‍♂️.", false},
+		{"ab", "a??b", true},
+		{"abc", "*a?c", true},
+		{"ac", "*a?c", true},
+		{"abbc", "*a?c", false},
+		{"xaybzaybc", "*a?b*a?bc", true},
+		{"xaybzaybd", "*a?b*a?bc", false},
+		{"abcabd", "*a?[cd]", true},
+		{"a1b2", "a[0-9]?[0-9]*", true},
+		{"a1bb2", "a[0-9]?[0-9]*", false},
+		{strings.Repeat("ab", 40), "*" + strings.Repeat("a?", 40), true},
+		{strings.Repeat("ab", 39) + "a", "*" + strings.Repeat("a?", 40) + "b", false},
+	}
+
+	for i, c := range cases {
+		p, err := Compile(c.pattern)
+		if err != nil {
+			t.Fatalf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+		}
+
+		if result := p.Match(c.s); c.result != result {
+			t.Errorf("Test %d: Match expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := p.MatchBytes([]byte(c.s)); c.result != result {
+			t.Errorf("Test %d: MatchBytes expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result, expected := p.MatchRunes([]rune(c.s)), MatchByRune(c.pattern, c.s); expected != result {
+			t.Errorf("Test %d: MatchRunes expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, expected, result, c.pattern, c.s)
+		}
+		if result := Match(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: package Match expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+// TestPatternOptions validates that a pattern compiled with options gives the same result
+// as the same options on the package level functions
+func TestPatternOptions(t *testing.T) {
+	path := MatchOptions{PathSeparator: '/'}
+	fold := MatchOptions{Fold: true}
+
+	cases := []struct {
+		options MatchOptions
+		s       string
+		pattern string
+		result  bool
+	}{
+		{path, "a/b", "a/**/b", true},
+		{path, "a/x/y/b", "a/**/b", true},
+		{path, "a/xb", "a/**/b", false},
+		{path, "a", "**/a", true},
+		{path, "x/y/a", "**/a", true},
+		{path, "xa", "**/a", false},
+		{path, "", "**/", true},
+		{path, "a/", "**/", true},
+		{path, "a", "a/**", false},
+		{path, "a/", "a/**", true},
+		{path, "a/b/c", "a/**", true},
+		{path, "a/b", "a/*", true},
+		{path, "a/b/c", "a/*", false},
+		{path, "a/b", "a/*/b", false},
+		{path, "src/x/test_a.go", "src/**/test_*.go", true},
+		{path, "src/test_a.go", "src/**/test_*.go", true},
+		{fold, "ABC", "a*c", true},
+		{fold, "ABD", "a*c", false},
+		{fold, "AB", "a.", true},
+	}
+
+	for i, c := range cases {
+		p, err := c.options.Compile(c.pattern)
+		if err != nil {
+			t.Fatalf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+		}
+
+		if result := p.Match(c.s); c.result != result {
+			t.Errorf("Test %d: Match expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result := p.MatchBytes([]byte(c.s)); c.result != result {
+			t.Errorf("Test %d: MatchBytes expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+		if result, expected := p.MatchRunes([]rune(c.s)), c.options.MatchByRune(c.pattern, c.s); expected != result {
+			t.Errorf("Test %d: MatchRunes expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, expected, result, c.pattern, c.s)
+		}
+		if result := c.options.Match(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: options Match expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	for _, pattern := range []string{`\`, `a*\`, "[", "[a", "[]", "[!]", `[a\]`, "[z-a]", "[[:alfa:]]", "[[:alpha:]",
+		"{", "{a,b", "a{b,{c}", "{a,[b}", `{a,\}`} {
+		if _, err := Compile(pattern); err != ErrBadPattern {
+			t.Errorf("Expected `%v` for Pattern: `%s`, found `%v`", ErrBadPattern, pattern, err)
+		}
+	}
+}
+
+func TestMustCompile(t *testing.T) {
+	p := MustCompile("a*b")
+	if p.String() != "a*b" {
+		t.Errorf("Expected `a*b`, found `%s`", p.String())
+	}
+}
+
+func FuzzPattern(f *testing.F) {
+	f.Add("a*b?c.", "aXbc!")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		p, err := Compile(pattern)
+		if err != nil {
+			return
+		}
+		if p.Match(s) != Match(pattern, s) {
+			t.Fatalf("Pattern(%q).Match(%q) differs from Match", pattern, s)
+		}
+		if p.MatchBytes([]byte(s)) != Match(pattern, s) {
+			t.Fatalf("Pattern(%q).MatchBytes(%q) differs from Match", pattern, s)
+		}
+
+		path := MatchOptions{PathSeparator: '/'}
+		p, err = path.Compile(pattern)
+		if err != nil {
+			t.Fatalf("MatchOptions.Compile(%q) failed where Compile succeeded: %v", pattern, err)
+		}
+		if p.Match(s) != MatchPath(pattern, s) {
+			t.Fatalf("Pattern(%q).Match(%q) with a separator differs from MatchPath", pattern, s)
+		}
+		if p.MatchBytes([]byte(s)) != MatchPath(pattern, s) {
+			t.Fatalf("Pattern(%q).MatchBytes(%q) with a separator differs from MatchPath", pattern, s)
+		}
+	})
+}
@@ -0,0 +1,462 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+type instOp uint8
+
+const (
+	instFail  instOp = iota // never matches
+	instByte                // matches the byte arg
+	instAny                 // matches any byte but the separator
+	instAll                 // matches any byte
+	instClass               // matches a byte of the bracket expression at pattern[arg]
+	instSplit               // continues at x, then at y if it failed
+	instJump                // continues at x
+	instSave                // records the position in the capture slot arg
+	instMatch               // matches the end of the string
+)
+
+// inst is one instruction of a program,
+// all of them but instSplit and instJump continue at the next one.
+type inst struct {
+	op   instOp
+	arg  int
+	x, y int
+}
+
+// program is a pattern compiled to instructions, where every wildcard records
+// the start and the end of what it matched in a pair of capture slots.
+type program struct {
+	pattern   string
+	insts     []inst
+	captures  int
+	separator int
+	fold      bool
+}
+
+// smallProgram is the number of instructions a program can have
+// before it needs to allocate.
+const smallProgram = 128
+
+// compileProgram compiles the pattern with the same semantic as matchByString.
+// A malformed bracket expression or an unclosed group never matches.
+func compileProgram(pattern string, separator int, fold bool) *program {
+	p := &program{pattern: pattern, separator: separator, fold: fold}
+	p.insts = p.compile(nil)
+
+	return p
+}
+
+// compile appends the instructions of p.pattern to insts and returns them.
+// They are not stored in p, so the caller can keep their storage on its stack.
+func (p *program) compile(insts []inst) []inst {
+	// For every open group, split is the instruction trying the current alternative
+	// before the next one, and the jumps ending the alternatives are chained by
+	// their target until the end of the group is known.
+	type group struct{ split, jumps int }
+	var buf [8]group
+	groups := buf[:0]
+
+	pattern := p.pattern
+	p.captures = 0
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case len(groups) > 0 && (c == ',' || c == '}'):
+			g := &groups[len(groups)-1]
+			insts = append(insts, inst{op: instJump, x: g.jumps})
+			g.jumps = len(insts) - 1
+			if g.split != -1 {
+				insts[g.split].y = len(insts)
+			}
+			if c == ',' {
+				insts, g.split = p.alternative(insts, i)
+				break
+			}
+
+			for j := g.jumps; j != -1; {
+				next := insts[j].x
+				insts[j].x = len(insts)
+				j = next
+			}
+			groups = groups[:len(groups)-1]
+		case c == '\\':
+			// A lone escape at the end never matches.
+			if i+1 == len(pattern) {
+				insts = append(insts, inst{op: instFail})
+				break
+			}
+			i++
+			insts = append(insts, inst{op: instByte, arg: int(pattern[i])})
+		case c == '*':
+			if next := matchByStringGlobstar(pattern, i, p.separator); next != -1 {
+				insts = p.globstar(insts, next == i+2)
+				i = next - 1
+				break
+			}
+			insts = p.repeat(insts, instAny)
+		case c == '?':
+			var slot int
+			insts, slot = p.capture(insts)
+			insts = append(insts,
+				inst{op: instSplit, x: len(insts) + 2, y: len(insts) + 1},
+				inst{op: instAny},
+				inst{op: instSave, arg: 2*slot + 1},
+			)
+		case c == '.':
+			var slot int
+			insts, slot = p.capture(insts)
+			insts = append(insts, inst{op: instAny}, inst{op: instSave, arg: 2*slot + 1})
+		case c == '[':
+			end, _ := matchByStringClass(pattern, i, 0, false)
+			if end == -1 {
+				insts = append(insts, inst{op: instFail})
+				break
+			}
+			insts = append(insts, inst{op: instClass, arg: i})
+			i = end
+		case c == '{':
+			if matchByStringClose(pattern, i) == -1 {
+				insts = append(insts, inst{op: instFail})
+				i = len(pattern)
+				break
+			}
+			g := group{jumps: -1}
+			insts, g.split = p.alternative(insts, i)
+			groups = append(groups, g)
+		default:
+			insts = append(insts, inst{op: instByte, arg: int(c)})
+		}
+	}
+
+	return append(insts, inst{op: instMatch})
+}
+
+// alternative starts the group alternative following the '{' or ',' at pattern[i].
+// It returns the instruction trying it before the next one, or -1 if it is the last one.
+func (p *program) alternative(insts []inst, i int) ([]inst, int) {
+	if p.pattern[matchByStringNext(p.pattern, i+1)] != ',' {
+		return insts, -1
+	}
+
+	return append(insts, inst{op: instSplit, x: len(insts) + 1}), len(insts)
+}
+
+// capture records the start of a new wildcard and returns its number.
+func (p *program) capture(insts []inst) ([]inst, int) {
+	p.captures++
+	return append(insts, inst{op: instSave, arg: 2 * (p.captures - 1)}), p.captures - 1
+}
+
+// repeat compiles a wildcard matching zero or more times op,
+// trying the shortest match first.
+func (p *program) repeat(insts []inst, op instOp) []inst {
+	insts, slot := p.capture(insts)
+	split := len(insts)
+
+	return append(insts,
+		inst{op: instSplit, x: split + 3, y: split + 1},
+		inst{op: op},
+		inst{op: instJump, x: split},
+		inst{op: instSave, arg: 2*slot + 1},
+	)
+}
+
+// globstar compiles a "**" path segment, matching everything when it ends
+// the pattern, or else zero or more whole segments with their separator.
+func (p *program) globstar(insts []inst, trailing bool) []inst {
+	if trailing {
+		return p.repeat(insts, instAll)
+	}
+
+	insts, slot := p.capture(insts)
+	segments := len(insts)
+
+	return append(insts,
+		inst{op: instSplit, x: segments + 6, y: segments + 1},
+		inst{op: instSplit, x: segments + 4, y: segments + 2},
+		inst{op: instAny},
+		inst{op: instJump, x: segments + 1},
+		inst{op: instByte, arg: p.separator},
+		inst{op: instJump, x: segments},
+		inst{op: instSave, arg: 2*slot + 1},
+	)
+}
+
+// maxVisited is the largest number of (instruction, position) states
+// match tracks in its visited bitmap, 256K bits like the backtracker of regexp.
+const maxVisited = 256 * 1024
+
+// match reports whether the program matches s, and fills captures with
+// the offsets recorded by the wildcards of the first match found.
+// The instructions are tried in order, with a backtracking bounded by the
+// visited (instruction, position) states, so it runs in O(len(insts) × len(s)).
+// Above maxVisited states, it simulates the threads instead.
+func (p *program) match(s string, captures []int) bool {
+	if len(p.insts)*(len(s)+1) > maxVisited {
+		return p.simulate(s, captures)
+	}
+
+	// A job with a slot restores the capture when the path pushed after it failed.
+	type job struct {
+		pc, pos   int
+		slot, old int
+	}
+
+	width := len(s) + 1
+	visited := make([]uint32, (len(p.insts)*width+31)/32)
+	stack := []job{{slot: -1}}
+	for len(stack) > 0 {
+		j := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if j.slot >= 0 {
+			captures[j.slot] = j.old
+			continue
+		}
+
+		pc, pos := j.pc, j.pos
+	Loop:
+		for {
+			state := pc*width + pos
+			if visited[state/32]&(1<<(state%32)) != 0 {
+				break
+			}
+			visited[state/32] |= 1 << (state % 32)
+
+			switch in := p.insts[pc]; in.op {
+			case instFail:
+				break Loop
+			case instByte:
+				if pos == len(s) || !p.equal(byte(in.arg), s[pos]) {
+					break Loop
+				}
+				pos++
+			case instAny:
+				if pos == len(s) || int(s[pos]) == p.separator {
+					break Loop
+				}
+				pos++
+			case instAll:
+				if pos == len(s) {
+					break Loop
+				}
+				pos++
+			case instClass:
+				if pos == len(s) || int(s[pos]) == p.separator {
+					break Loop
+				}
+				if _, ok := matchByStringClass(p.pattern, in.arg, s[pos], p.fold); !ok {
+					break Loop
+				}
+				pos++
+			case instSplit:
+				stack = append(stack, job{pc: in.y, pos: pos, slot: -1})
+				pc = in.x
+				continue
+			case instJump:
+				pc = in.x
+				continue
+			case instSave:
+				stack = append(stack, job{slot: in.arg, old: captures[in.arg]})
+				captures[in.arg] = pos
+			case instMatch:
+				if pos == len(s) {
+					return true
+				}
+				break Loop
+			}
+			pc++
+		}
+	}
+
+	return false
+}
+
+// thread is a thread of simulate, at the instruction pc with its own captures.
+type thread struct {
+	pc       int
+	captures []int
+}
+
+// simulate is like match, but runs all the threads at once, in the order the
+// backtracking tries them, and keeps only the first one reaching an instruction.
+// So it finds the same match in O(len(insts) × len(s)) time,
+// with only O(len(insts) × len(captures)) memory.
+func (p *program) simulate(s string, captures []int) bool {
+	on := make([]bool, 2*len(p.insts))
+	cur, next := make([]thread, 0, len(p.insts)), make([]thread, 0, len(p.insts))
+	var free [][]int
+
+	// add adds a thread at pc in list, following the instructions which do not
+	// consume a byte. The captures are only copied for the threads added.
+	var add func(list []thread, on []bool, pc, pos int, caps []int) []thread
+	add = func(list []thread, on []bool, pc, pos int, caps []int) []thread {
+		if on[pc] {
+			return list
+		}
+		on[pc] = true
+
+		switch in := p.insts[pc]; in.op {
+		case instFail:
+		case instSplit:
+			list = add(list, on, in.x, pos, caps)
+			list = add(list, on, in.y, pos, caps)
+		case instJump:
+			list = add(list, on, in.x, pos, caps)
+		case instSave:
+			old := caps[in.arg]
+			caps[in.arg] = pos
+			list = add(list, on, pc+1, pos, caps)
+			caps[in.arg] = old
+		default:
+			var c []int
+			if n := len(free); n > 0 {
+				c, free = free[n-1], free[:n-1]
+			} else {
+				c = make([]int, len(caps))
+			}
+			copy(c, caps)
+			list = append(list, thread{pc: pc, captures: c})
+		}
+
+		return list
+	}
+
+	curOn, nextOn := on[:len(p.insts)], on[len(p.insts):]
+	cur = add(cur, curOn, 0, 0, captures)
+	for pos := 0; len(cur) > 0; pos++ {
+		for _, t := range cur {
+			in := p.insts[t.pc]
+			if in.op == instMatch {
+				if pos == len(s) {
+					copy(captures, t.captures)
+					return true
+				}
+			} else if pos < len(s) && p.consume(in, s[pos]) {
+				next = add(next, nextOn, t.pc+1, pos+1, t.captures)
+			}
+			free = append(free, t.captures)
+		}
+
+		for i := range curOn {
+			curOn[i] = false
+		}
+		cur, next = next, cur[:0]
+		curOn, nextOn = nextOn, curOn
+	}
+
+	return false
+}
+
+// find returns the offsets of the leftmost match of the program p in s,
+// the shortest one if there are many starting there, or -1, -1 if there is none.
+// It simulates all the threads at once, keeping for each instruction the leftmost
+// start reaching it, so it runs in O(len(insts) × len(s)).
+func find[T string | []byte](p *program, s T) (int, int) {
+	var buf [2 * smallProgram]int
+	cur, next := p.lists(buf[:])
+
+	start, end := -1, -1
+	for pos := 0; ; pos++ {
+		if start == -1 {
+			p.add(cur, 0, pos)
+		}
+		if a := cur[len(cur)-1]; a != -1 && (start == -1 || a < start) {
+			start, end = a, pos
+		}
+		if pos == len(s) || start != -1 && !p.alive(cur, start) {
+			return start, end
+		}
+
+		p.step(cur, next, s[pos])
+		cur, next = next, cur
+	}
+}
+
+// lists returns two empty thread lists, using buf when it is large enough.
+// A thread list holds for each instruction the start of the thread at it, or -1.
+func (p *program) lists(buf []int) ([]int, []int) {
+	n := len(p.insts)
+	if 2*n > len(buf) {
+		buf = make([]int, 2*n)
+	}
+	for i := range buf[:2*n] {
+		buf[i] = -1
+	}
+
+	return buf[:n], buf[n : 2*n]
+}
+
+// add adds to list a thread at pc started at start, following the instructions
+// which do not consume a byte. A thread already there with a lower start wins.
+func (p *program) add(list []int, pc, start int) {
+	if list[pc] != -1 && list[pc] <= start {
+		return
+	}
+	list[pc] = start
+
+	switch in := p.insts[pc]; in.op {
+	case instSplit:
+		p.add(list, in.x, start)
+		p.add(list, in.y, start)
+	case instJump:
+		p.add(list, in.x, start)
+	case instSave:
+		p.add(list, pc+1, start)
+	}
+}
+
+// step advances the threads of cur consuming c into next, and clears cur.
+func (p *program) step(cur, next []int, c byte) {
+	for pc, start := range cur {
+		if start == -1 {
+			continue
+		}
+		cur[pc] = -1
+
+		if p.consume(p.insts[pc], c) {
+			p.add(next, pc+1, start)
+		}
+	}
+}
+
+// consume reports whether the instruction in consumes the byte c.
+func (p *program) consume(in inst, c byte) bool {
+	switch in.op {
+	case instByte:
+		return p.equal(byte(in.arg), c)
+	case instAny:
+		return int(c) != p.separator
+	case instAll:
+		return true
+	case instClass:
+		if int(c) == p.separator {
+			return false
+		}
+		_, ok := matchByStringClass(p.pattern, in.arg, c, p.fold)
+		return ok
+	}
+
+	return false
+}
+
+// alive reports whether a thread of list can still find a match on the left of start.
+func (p *program) alive(list []int, start int) bool {
+	for _, a := range list {
+		if a != -1 && a < start {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equal reports whether the byte a of the pattern matches the byte b.
+func (p *program) equal(a, b byte) bool {
+	return a == b || p.fold && matchByStringFold(a, b)
+}
@@ -0,0 +1,448 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The surrogate halves of UTF-16, which are not valid runes.
+const (
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+)
+
+// ErrUnsupportedRegexp indicates a regular expression has no equivalent pattern.
+var ErrUnsupportedRegexp = errors.New("regular expression not expressible as a pattern")
+
+// regexpNamed holds the RE2 class items equivalent to the POSIX classes
+// of MatchByRune, which are defined with the unicode package predicates.
+var regexpNamed = map[string]string{
+	"alnum":  `\pL\p{Nd}`,
+	"alpha":  `\pL`,
+	"blank":  `\t\p{Zs}`,
+	"cntrl":  `\p{Cc}`,
+	"digit":  `\p{Nd}`,
+	"graph":  `\pL\pM\pN\pP\pS`,
+	"lower":  `\p{Ll}`,
+	"print":  `\pL\pM\pN\pP\pS\x20`,
+	"punct":  `\pP\pS`,
+	"space":  `\t-\r\x20\x{85}\x{A0}\x{1680}\x{2000}-\x{200A}\x{2028}\x{2029}\x{202F}\x{205F}\x{3000}`,
+	"upper":  `\p{Lu}`,
+	"xdigit": `0-9A-Fa-f`,
+}
+
+// ToRegexp returns an anchored RE2 regular expression matching the same strings
+// as the pattern with MatchByRune, like "(?s)^logs/(?:app|worker)-.*\.log$" for
+// "logs/{app,worker}-*.log". Every '*' becomes ".*", '?' becomes ".?" and '.'
+// stays ".", the "(?s)" flag making them match a newline too.
+// It returns ErrBadPattern if the pattern ends with a lone escape,
+// contains a malformed bracket expression or an unclosed brace group.
+func ToRegexp(pattern string) (string, error) {
+	return toRegexp([]rune(pattern), unicode.MaxRune)
+}
+
+// ToRegexpByByte is like ToRegexp, for the byte comparison of Match.
+// A wildcard matching a byte, the expression is meant for the Latin-1 encoding
+// of RE2, where every byte is a character, and escapes the bytes above 0x7F.
+func ToRegexpByByte(pattern string) (string, error) {
+	runes := make([]rune, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		runes[i] = rune(pattern[i])
+	}
+
+	return toRegexp(runes, unicode.MaxASCII)
+}
+
+// ToRegexpCompiled returns the regexp.Regexp compiled from the expression of ToRegexp,
+// matching like MatchByRune.
+func ToRegexpCompiled(pattern string) (*regexp.Regexp, error) {
+	expr, err := ToRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return regexp.Compile(expr)
+}
+
+// toRegexp translates the pattern, where the characters above max are
+// never part of a POSIX class and written as escapes.
+func toRegexp(pattern []rune, max rune) (string, error) {
+	var b strings.Builder
+	b.WriteString(`(?s)^`)
+	if err := regexpWrite(&b, pattern, 0, len(pattern), max); err != nil {
+		return "", err
+	}
+	b.WriteByte('$')
+
+	return b.String(), nil
+}
+
+// regexpWrite writes the translation of pattern[start:end].
+func regexpWrite(b *strings.Builder, pattern []rune, start, end int, max rune) error {
+	star := false
+	for i := start; i < end; i++ {
+		switch pattern[i] {
+		case '*':
+			// Consecutive stars are equivalent to a single one.
+			if !star {
+				b.WriteString(".*")
+			}
+			star = true
+			continue
+		case '?':
+			b.WriteString(".?")
+		case '.':
+			b.WriteByte('.')
+		case '\\':
+			i++
+			if i == end {
+				return ErrBadPattern
+			}
+			regexpChar(b, pattern[i], max)
+		case '[':
+			close, _, _ := matchByRunesSet(pattern, i, 0)
+			if close == -1 {
+				return ErrBadPattern
+			}
+			regexpClass(b, pattern, i, close, max)
+			i = close
+		case '{':
+			close := matchByRunesClose(pattern, i)
+			if close == -1 {
+				return ErrBadPattern
+			}
+
+			b.WriteString("(?:")
+			for j := i; j < close; {
+				next := matchByRunesNext(pattern, j+1)
+				if j > i {
+					b.WriteByte('|')
+				}
+				if err := regexpWrite(b, pattern, j+1, next, max); err != nil {
+					return err
+				}
+				j = next
+			}
+			b.WriteByte(')')
+			i = close
+		default:
+			regexpChar(b, pattern[i], max)
+		}
+		star = false
+	}
+
+	return nil
+}
+
+// regexpClass writes the bracket expression pattern[start:close+1],
+// following the parsing of matchByRunesSet.
+func regexpClass(b *strings.Builder, pattern []rune, start, close int, max rune) {
+	i := start + 1
+	b.WriteByte('[')
+	if pattern[i] == '!' || pattern[i] == '^' {
+		b.WriteByte('^')
+		i++
+	}
+
+	for i < close {
+		if pattern[i] == '[' && pattern[i+1] == ':' {
+			end := i + 2
+			for pattern[end] != ':' || pattern[end+1] != ']' {
+				end++
+			}
+			regexpNamedClass(b, string(pattern[i+2:end]), max)
+			i = end + 2
+			continue
+		}
+
+		lo, next := matchByRunesClassChar(pattern, i)
+		regexpChar(b, lo, max)
+		if pattern[next] == '-' && next+1 < close {
+			var hi rune
+			hi, next = matchByRunesClassChar(pattern, next+1)
+			b.WriteByte('-')
+			regexpChar(b, hi, max)
+		}
+		i = next
+	}
+	b.WriteByte(']')
+}
+
+// regexpNamedClass writes the class items of the POSIX class called name.
+// Below unicode.MaxRune, they are listed from matchByRunesNamed.
+func regexpNamedClass(b *strings.Builder, name string, max rune) {
+	if max == unicode.MaxRune {
+		b.WriteString(regexpNamed[name])
+		return
+	}
+
+	for r := rune(0); r <= max; r++ {
+		if in, _ := matchByRunesNamed([]rune(name), r); !in {
+			continue
+		}
+		lo := r
+		for r+1 <= max {
+			if in, _ := matchByRunesNamed([]rune(name), r+1); !in {
+				break
+			}
+			r++
+		}
+
+		regexpChar(b, lo, max)
+		if r > lo {
+			b.WriteByte('-')
+			regexpChar(b, r, max)
+		}
+	}
+}
+
+// regexpChar writes the character r matching itself, inside or outside a class.
+// The metacharacters are escaped with '\', and the characters not printable
+// or above max are written as hexadecimal escapes.
+func regexpChar(b *strings.Builder, r rune, max rune) {
+	switch {
+	case strings.ContainsRune(`\.+*?()|[]{}^$-`, r):
+		b.WriteByte('\\')
+		b.WriteRune(r)
+	case r < 0x80 && unicode.IsPrint(r):
+		b.WriteRune(r)
+	case r <= max && unicode.IsPrint(r) && r != unicode.ReplacementChar:
+		b.WriteRune(r)
+	default:
+		fmt.Fprintf(b, `\x{%X}`, r)
+	}
+}
+
+// FromRegexp returns a pattern matching with MatchByRune the same strings as
+// the RE2 regular expression expr with regexp.MatchString, like "foo*bar" for "(?s)^foo.*bar$".
+// A missing '^' or '$' anchor becomes a leading or trailing '*'.
+//
+// Without the "s" flag, '.' doesn't match a newline and becomes "[!\n]". A pattern
+// has no repetition of it, so an unbounded repetition like ".*" or ".+" returns
+// an error unless the "s" flag lets it match a newline.
+//
+// With the "s" flag, the repetitions of '.' become wildcards, like ".+" to ".*" or
+// ".{2,3}" to "..?", and the other optional expressions become groups with an empty alternative,
+// like "(ab)?" to "{ab,}". The alternations become groups, the character classes
+// bracket expressions, and the case-insensitive letters the class of their cases.
+// The other constructs, like "(ab)*" or "\b", have no equivalent pattern
+// and return an error wrapping ErrUnsupportedRegexp and naming them.
+func FromRegexp(expr string) (string, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+
+	var b strings.Builder
+	if len(subs) == 0 || subs[0].Op != syntax.OpBeginText {
+		b.WriteByte('*')
+	}
+	for len(subs) > 0 && subs[0].Op == syntax.OpBeginText {
+		subs = subs[1:]
+	}
+	end := len(subs)
+	for end > 0 && subs[end-1].Op == syntax.OpEndText {
+		end--
+	}
+
+	for _, sub := range subs[:end] {
+		if err := fromRegexp(&b, sub); err != nil {
+			return "", err
+		}
+	}
+	if end == len(subs) {
+		b.WriteByte('*')
+	}
+
+	return Simplify(b.String()), nil
+}
+
+// fromRegexp writes the pattern equivalent to re.
+func fromRegexp(b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if !utf8.ValidRune(r) {
+				return fmt.Errorf("%w: the surrogate `%s` never matches", ErrUnsupportedRegexp, re)
+			}
+			if re.Flags&syntax.FoldCase != 0 && unicode.SimpleFold(r) != r {
+				cases := []rune{r}
+				for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+					cases = append(cases, f)
+				}
+				sort.Slice(cases, func(i, j int) bool { return cases[i] < cases[j] })
+
+				b.WriteByte('[')
+				for _, c := range cases {
+					fromRegexpClassChar(b, c)
+				}
+				b.WriteByte(']')
+				continue
+			}
+			b.WriteString(Escape(string(r)))
+		}
+	case syntax.OpAnyChar:
+		b.WriteByte('.')
+	case syntax.OpAnyCharNotNL:
+		fromRegexpClass(b, []rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune})
+	case syntax.OpCharClass:
+		ranges := withoutSurrogates(re.Rune)
+		if len(ranges) == 0 {
+			return fmt.Errorf("%w: the empty class `%s` never matches", ErrUnsupportedRegexp, re)
+		}
+		fromRegexpClass(b, ranges)
+	case syntax.OpCapture:
+		return fromRegexp(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := fromRegexp(b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		b.WriteByte('{')
+		for i, sub := range re.Sub {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := fromRegexp(b, sub); err != nil {
+				return err
+			}
+		}
+		b.WriteByte('}')
+	case syntax.OpStar:
+		return fromRegexpRepeat(b, re, re.Sub[0], 0, -1)
+	case syntax.OpPlus:
+		return fromRegexpRepeat(b, re, re.Sub[0], 1, -1)
+	case syntax.OpQuest:
+		return fromRegexpRepeat(b, re, re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		return fromRegexpRepeat(b, re, re.Sub[0], re.Min, re.Max)
+	case syntax.OpNoMatch:
+		return fmt.Errorf("%w: the empty class `%s` never matches", ErrUnsupportedRegexp, re)
+	case syntax.OpBeginText:
+		return fmt.Errorf("%w: the '^' of `%s` is not at the start", ErrUnsupportedRegexp, re)
+	case syntax.OpEndText:
+		return fmt.Errorf("%w: the '$' of `%s` is not at the end", ErrUnsupportedRegexp, re)
+	case syntax.OpBeginLine, syntax.OpEndLine:
+		return fmt.Errorf("%w: the line anchor of `%s`", ErrUnsupportedRegexp, re)
+	case syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return fmt.Errorf("%w: the word boundary of `%s`", ErrUnsupportedRegexp, re)
+	default:
+		return fmt.Errorf("%w: the %v `%s`", ErrUnsupportedRegexp, re.Op, re)
+	}
+
+	return nil
+}
+
+// fromRegexpRepeat writes the pattern matching from min to max sub,
+// or more than min if max is -1, only possible if sub is '.' with the "s" flag.
+func fromRegexpRepeat(b *strings.Builder, re, sub *syntax.Regexp, min, max int) error {
+	if sub.Op == syntax.OpAnyChar {
+		b.WriteString(strings.Repeat(".", min))
+		if max == -1 {
+			b.WriteByte('*')
+		} else {
+			b.WriteString(strings.Repeat("?", max-min))
+		}
+		return nil
+	}
+	if sub.Op == syntax.OpAnyCharNotNL && max == -1 {
+		return fmt.Errorf("%w: the unbounded repetition `%s` of '.' without the s flag", ErrUnsupportedRegexp, re)
+	}
+	if max == -1 {
+		return fmt.Errorf("%w: the unbounded repetition `%s` of something else than '.'", ErrUnsupportedRegexp, re)
+	}
+
+	var s strings.Builder
+	if err := fromRegexp(&s, sub); err != nil {
+		return err
+	}
+	b.WriteString(strings.Repeat(s.String(), min))
+	b.WriteString(strings.Repeat("{"+s.String()+",}", max-min))
+
+	return nil
+}
+
+// fromRegexpClass writes the bracket expression of the sorted ranges,
+// negated if it is shorter.
+func fromRegexpClass(b *strings.Builder, ranges []rune) {
+	negate := len(ranges) > 0 && ranges[0] == 0 && ranges[len(ranges)-1] == unicode.MaxRune
+	if negate {
+		complement := make([]rune, 0, len(ranges))
+		for i := 1; i+1 < len(ranges); i += 2 {
+			complement = append(complement, ranges[i]+1, ranges[i+1]-1)
+		}
+		complement = withoutSurrogates(complement)
+		if len(complement) == 0 {
+			b.WriteByte('.')
+			return
+		}
+		ranges = complement
+	}
+
+	b.WriteByte('[')
+	if negate {
+		b.WriteByte('!')
+	}
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		fromRegexpClassChar(b, lo)
+		switch {
+		case hi == lo+1:
+			fromRegexpClassChar(b, hi)
+		case hi > lo:
+			b.WriteByte('-')
+			fromRegexpClassChar(b, hi)
+		}
+	}
+	b.WriteByte(']')
+}
+
+// withoutSurrogates returns the sorted ranges without the surrogate halves,
+// which are never decoded from a string.
+func withoutSurrogates(ranges []rune) []rune {
+	valid := make([]rune, 0, len(ranges)+2)
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < surrogateMin && hi >= surrogateMin {
+			valid = append(valid, lo, surrogateMin-1)
+		}
+		if lo <= surrogateMax && hi > surrogateMax {
+			valid = append(valid, surrogateMax+1, hi)
+		}
+		if hi < surrogateMin || lo > surrogateMax {
+			valid = append(valid, lo, hi)
+		}
+	}
+
+	return valid
+}
+
+// fromRegexpClassChar writes the character r in a bracket expression.
+func fromRegexpClassChar(b *strings.Builder, r rune) {
+	if strings.ContainsRune(`\]-[!^`, r) {
+		b.WriteByte('\\')
+	}
+	b.WriteRune(r)
+}
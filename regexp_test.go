@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	gotoken "go/token"
+	"regexp"
+	"strconv"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TestToRegexp validates the translation of each element of the patterns
+func TestToRegexp(t *testing.T) {
+	cases := []struct {
+		pattern string
+		rune    string
+		byte    string
+	}{
+		{"", `(?s)^$`, `(?s)^$`},
+		{"a*b?c.", `(?s)^a.*b.?c.$`, `(?s)^a.*b.?c.$`},
+		{"a***b", `(?s)^a.*b$`, `(?s)^a.*b$`},
+		{`1+1=2 \* $x`, `(?s)^1\+1=2 \* \$x$`, `(?s)^1\+1=2 \* \$x$`},
+		{"日本*", `(?s)^日本.*$`, `(?s)^\x{E6}\x{97}\x{A5}\x{E6}\x{9C}\x{AC}.*$`},
+		{"[!a-z]", `(?s)^[^a-z]$`, `(?s)^[^a-z]$`},
+		{`[]\-^]`, `(?s)^[\]\-\^]$`, `(?s)^[\]\-\^]$`},
+		{"[[:digit:]_]", `(?s)^[\p{Nd}_]$`, `(?s)^[0-9_]$`},
+		{"logs/{app,worker}-*.log", `(?s)^logs/(?:app|worker)\-.*.log$`, `(?s)^logs/(?:app|worker)\-.*.log$`},
+		{"{a,{b,},c*}", `(?s)^(?:a|(?:b|)|c.*)$`, `(?s)^(?:a|(?:b|)|c.*)$`},
+		{"a,}", `(?s)^a,\}$`, `(?s)^a,\}$`},
+	}
+
+	for i, c := range cases {
+		if expr, err := ToRegexp(c.pattern); err != nil || expr != c.rune {
+			t.Errorf("Test %d: Expected `%s`, found `%s` (%v); With Pattern: `%s`", i+1, c.rune, expr, err, c.pattern)
+		}
+		if expr, err := ToRegexpByByte(c.pattern); err != nil || expr != c.byte {
+			t.Errorf("Test %d: Expected `%s` by byte, found `%s` (%v); With Pattern: `%s`", i+1, c.byte, expr, err, c.pattern)
+		}
+	}
+
+	for _, pattern := range []string{`a\`, "[a", "[z-a]", "[[:alfa:]]", "{a,b", "[é-à]"} {
+		if expr, err := ToRegexp(pattern); err != ErrBadPattern {
+			t.Errorf("Expected `%v`, found `%s` (%v); With Pattern: `%s`", ErrBadPattern, expr, err, pattern)
+		}
+	}
+}
+
+// TestToRegexpMatchTable compares the expressions with the matchers on the test table
+// of source/wildcard_match_test.go, by rune and by byte.
+func TestToRegexpMatchTable(t *testing.T) {
+	cases := matchTable(t)
+	if len(cases) < 100 {
+		t.Fatalf("Expected the test table of TestMatch, found %d cases", len(cases))
+	}
+
+	for i, c := range cases {
+		if Match(c.pattern, c.s) != c.result {
+			t.Fatalf("Test %d: the table of TestMatch is not read as expected", i+1)
+		}
+		checkRegexp(t, i+1, c.pattern, c.s)
+	}
+}
+
+// TestToRegexpNamed compares every POSIX class with its translation, on every character.
+func TestToRegexpNamed(t *testing.T) {
+	for name := range regexpNamed {
+		pattern := "[[:" + name + ":]]"
+		byRune := regexp.MustCompile(mustRegexp(t, ToRegexp, pattern))
+		byByte := regexp.MustCompile(mustRegexp(t, ToRegexpByByte, pattern))
+
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if !utf8.ValidRune(r) {
+				continue
+			}
+			if s := string(r); byRune.MatchString(s) != MatchByRune(pattern, s) {
+				t.Errorf("Expected %t for %U with `%s`", MatchByRune(pattern, s), r, byRune)
+			}
+			if r < 0x100 && byByte.MatchString(string(r)) != Match(pattern, string([]byte{byte(r)})) {
+				t.Errorf("Expected %t for the byte %#x with `%s`", !byByte.MatchString(string(r)), r, byByte)
+			}
+		}
+	}
+}
+
+func FuzzToRegexp(f *testing.F) {
+	f.Add("a*b?c.[!x-z]{d,e*}", "abbcdefgh")
+	f.Add("[[:alpha:]]?日*", "é日本")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		checkRegexp(t, 0, pattern, s)
+	})
+}
+
+// checkRegexp compares the expressions of the pattern with MatchByRune and Match.
+func checkRegexp(t *testing.T, test int, pattern, s string) {
+	t.Helper()
+
+	if _, err := Compile(pattern); err != nil {
+		return
+	}
+
+	// Compile parses the bracket expressions by byte, not by rune.
+	result := MatchByRune(pattern, s)
+	if re, err := ToRegexpCompiled(pattern); err == nil {
+		if re.MatchString(s) != result {
+			t.Errorf("Test %d: Expected `%v` with `%s`; With Pattern: `%s` and String: `%s`", test, result, re, pattern, s)
+		}
+	} else if result {
+		t.Errorf("Test %d: Unexpected error `%v`; With Pattern: `%s` and String: `%s`", test, err, pattern, s)
+	}
+
+	// Matching the Latin-1 decoding of the string, each byte is a character.
+	latin1 := make([]rune, len(s))
+	for i := 0; i < len(s); i++ {
+		latin1[i] = rune(s[i])
+	}
+	re := regexp.MustCompile(mustRegexp(t, ToRegexpByByte, pattern))
+	if expected := Match(pattern, s); re.MatchString(string(latin1)) != expected {
+		t.Errorf("Test %d: Expected `%v` by byte with `%s`; With Pattern: `%s` and String: `%s`", test, expected, re, pattern, s)
+	}
+}
+
+func mustRegexp(t *testing.T, toRegexp func(string) (string, error), pattern string) string {
+	t.Helper()
+
+	expr, err := toRegexp(pattern)
+	if err != nil {
+		t.Fatalf("Unexpected error `%v` with Pattern: `%s`", err, pattern)
+	}
+
+	return expr
+}
+
+// matchTable returns the cases of TestMatch, read from the template test file.
+func matchTable(t *testing.T) []struct {
+	s, pattern string
+	result     bool
+} {
+	file, err := parser.ParseFile(gotoken.NewFileSet(), "source/wildcard_match_test.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases []struct {
+		s, pattern string
+		result     bool
+	}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); !ok || fn.Name.Name != "TestMatch" {
+			continue
+		}
+
+		ast.Inspect(decl, func(n ast.Node) bool {
+			row, ok := n.(*ast.CompositeLit)
+			if !ok || row.Type != nil || len(row.Elts) != 3 {
+				return true
+			}
+
+			s, err := strconv.Unquote(row.Elts[0].(*ast.BasicLit).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pattern, err := strconv.Unquote(row.Elts[1].(*ast.BasicLit).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cases = append(cases, struct {
+				s, pattern string
+				result     bool
+			}{s, pattern, row.Elts[2].(*ast.Ident).Name == "true"})
+
+			return false
+		})
+	}
+
+	return cases
+}
+
+// TestFromRegexp validates the translation of the supported regular expressions
+func TestFromRegexp(t *testing.T) {
+	cases := []struct {
+		expr    string
+		pattern string
+	}{
+		{"", "*"},
+		{"^$", ""},
+		{"(?s)^foo.*bar$", "foo*bar"},
+		{"^a.b?$", "a[!\n]{,b}"},
+		{"^(?s)a.b?$", "a.{,b}"},
+		{"foo", "*foo*"},
+		{"^foo", "foo*"},
+		{`\.log$`, `*\.log`},
+		{"^(?s).+$", ".*"},
+		{"^(?s).{2,4}x.{3}$", "..??x..."},
+		{"^.{1,2}$", "[!\n]{,[!\n]}"},
+		{"^[^\n]$", "[!\n]"},
+		{"^(?:ab){1,2}$", "ab{,ab}"},
+		{"^(app|worker)-[0-9]$", "{app,worker}-[0-9]"},
+		{"^[^a-z]$", "[!a-z]"},
+		{`^[^\x{D800}-\x{DFFF}]$`, "."},
+		{`^[\x{D000}-\x{E000}]$`, "[\uD000-\uD7FF\uE000]"},
+		{`^[\]\-]$`, `[\-\]]`},
+		{"^(?i)ab$", "[Aa][Bb]"},
+		{`^a,b\{\}$`, `a,b\{}`},
+	}
+
+	for i, c := range cases {
+		if pattern, err := FromRegexp(c.expr); err != nil || pattern != c.pattern {
+			t.Errorf("Test %d: Expected `%s`, found `%s` (%v); With Expression: `%s`", i+1, c.pattern, pattern, err, c.expr)
+		}
+	}
+
+	for _, expr := range []string{"^foo.*bar$", "^.+$", "^[0-9]+$", "^(ab)*$", `\bfoo`, "(?m)^a$", "a^b", "a$b", `[^\x00-\x{10FFFF}]`, `\x{D800}`} {
+		if pattern, err := FromRegexp(expr); !errors.Is(err, ErrUnsupportedRegexp) {
+			t.Errorf("Expected `%v`, found `%s` (%v); With Expression: `%s`", ErrUnsupportedRegexp, pattern, err, expr)
+		}
+	}
+	if _, err := FromRegexp("(a"); err == nil || errors.Is(err, ErrUnsupportedRegexp) {
+		t.Errorf("Expected the syntax error of regexp, found `%v`", err)
+	}
+}
+
+func FuzzFromRegexp(f *testing.F) {
+	f.Add("(?s)^foo.*bar$", "foo and bar")
+	f.Add("(?i)a[^b-d]?(x|yz){1,3}", "zA\nyzx")
+	f.Fuzz(func(t *testing.T, expr, s string) {
+		pattern, err := FromRegexp(expr)
+		if err != nil {
+			return
+		}
+
+		re := regexp.MustCompile(expr)
+		if re.MatchString(s) != MatchByRune(pattern, s) {
+			t.Fatalf("Expected `%v` with Pattern: `%s` from Expression: `%s` and String: `%q`", re.MatchString(s), pattern, expr, s)
+		}
+	})
+}
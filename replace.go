@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"strings"
+)
+
+// Replace returns a copy of s where the first substring matched by the pattern,
+// as found by Find, is replaced by the template.
+//
+// In the template, "$n" or "${n}" is replaced by the substring matched by the n-th
+// wildcard of the pattern, counting from 1 like MatchSubmatch, "$0" by the whole
+// match and "$$" by a '$'. Use "${1}0" to follow a reference with a digit.
+// A reference to a wildcard which does not exist is replaced by an empty string,
+// and any other '$' is kept as is.
+// So "old/*/file-?.txt" with the template "new/$1/archive-$2.txt"
+// turns "old/2024/file-1.txt" into "new/2024/archive-1.txt".
+func Replace(pattern, template, s string) string {
+	return replace(pattern, template, s, 1)
+}
+
+// ReplaceAll is like Replace, but replaces all the successive non-overlapping
+// substrings matched by the pattern, as found by FindAll.
+func ReplaceAll(pattern, template, s string) string {
+	return replace(pattern, template, s, -1)
+}
+
+func replace(pattern, template, s string, n int) string {
+	p := compileProgram(pattern, -1, false)
+	matches := findAll(p, s, n)
+	if matches == nil {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + len(template))
+	captures := make([]int, 2*p.captures)
+	last := 0
+	for _, m := range matches {
+		b.WriteString(s[last:m[0]])
+		last = m[1]
+
+		for i := range captures {
+			captures[i] = -1
+		}
+		p.match(s[m[0]:m[1]], captures)
+		expand(&b, template, s[m[0]:m[1]], captures)
+	}
+	b.WriteString(s[last:])
+
+	return b.String()
+}
+
+// expand writes the template to b, replacing the references
+// by the captures of the match.
+func expand(b *strings.Builder, template, match string, captures []int) {
+	for i := 0; i < len(template); i++ {
+		if template[i] != '$' || i+1 == len(template) {
+			b.WriteByte(template[i])
+			continue
+		}
+
+		n, next := reference(template, i+1)
+		switch {
+		case template[i+1] == '$':
+			b.WriteByte('$')
+			i++
+			continue
+		case next == -1:
+			b.WriteByte('$')
+			continue
+		case n == 0:
+			b.WriteString(match)
+		case n <= len(captures)/2 && captures[2*n-2] >= 0:
+			b.WriteString(match[captures[2*n-2]:captures[2*n-1]])
+		}
+		i = next - 1
+	}
+}
+
+// reference parses the wildcard number at template[i], as "n" or "{n}".
+// It returns the number and the index following it, or -1 if there is none.
+func reference(template string, i int) (int, int) {
+	braced := template[i] == '{'
+	if braced {
+		i++
+	}
+
+	n, start := 0, i
+	for ; i < len(template) && '0' <= template[i] && template[i] <= '9'; i++ {
+		if n < 1<<20 {
+			n = n*10 + int(template[i]-'0')
+		}
+	}
+	if i == start {
+		return 0, -1
+	}
+
+	if braced {
+		if i == len(template) || template[i] != '}' {
+			return 0, -1
+		}
+		i++
+	}
+
+	return n, i
+}
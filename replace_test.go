@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"testing"
+)
+
+// TestReplace validates the substitution of the first match
+// and the template references
+func TestReplace(t *testing.T) {
+	cases := []struct {
+		s        string
+		pattern  string
+		template string
+		result   string
+	}{
+		{"", "", "x", "x"},
+		{"abc", "x", "y", "abc"},
+		{"abc", "b", "x", "axc"},
+		{"abcb", "b", "x", "axcb"},
+		{"old/2024/file-1.txt", "old/*/file-?.txt", "new/$1/archive-$2.txt", "new/2024/archive-1.txt"},
+		{"key: old/a/file-1.txt;", "old/*/file-?.txt", "new/$1/archive-$2.txt", "key: new/a/archive-1.txt;"},
+		{"abc", "a*", "X", "Xbc"},
+		{"abc", "a?c", "[$0]", "[abc]"},
+		{"abc", "a?c", "$1$1", "bb"},
+		{"abc", "a?c", "${1}0", "b0"},
+		{"abc", "a?c", "$10", ""},
+		{"abc", "a?c", "$2", ""},
+		{"abc", "a?c", "$$1", "$1"},
+		{"abc", "a?c", "$x$", "$x$"},
+		{"abc", "a?c", "${1", "${1"},
+		{"abc", "a?c", "${}", "${}"},
+		{"bx", "{a*,b?}", "[$1|$2]", "[|]x"},
+		{"bx", "{a*,b.}", "[$1|$2]", "[|x]"},
+	}
+
+	for i, c := range cases {
+		if result := Replace(c.pattern, c.template, c.s); c.result != result {
+			t.Errorf("Test %d: Expected `%s`, found `%s`; With Pattern: `%s`, Template: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.template, c.s)
+		}
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	cases := []struct {
+		s        string
+		pattern  string
+		template string
+		result   string
+	}{
+		{"abc", "x", "y", "abc"},
+		{"abcb", "b", "x", "axcx"},
+		{"ab", "*", "-", "-a-b-"},
+		{"user=bob user=eve", "user=?{o,v}?", "u=$1$2", "u=bb u=ee"},
+		{"a/1.txt b/2.txt", "*/?.txt", "$2-$1", "1-a2- b"},
+		{"a/1.txt b/2.txt", "[a-z]/?.txt", "$1", "1 2"},
+	}
+
+	for i, c := range cases {
+		if result := ReplaceAll(c.pattern, c.template, c.s); c.result != result {
+			t.Errorf("Test %d: Expected `%s`, found `%s`; With Pattern: `%s`, Template: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.template, c.s)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import "strings"
+
+// MatchSegments returns true if the pattern segments match the segments segs,
+// like the labels of a DNS name or the parts of a dotted metric name.
+// A "**" segment matches zero or more whole segments, a "?" segment zero or one,
+// and any other pattern segment matches exactly one segment with Match, so "*"
+// matches a whole segment. Inside a longer pattern segment, '?' and '.' match
+// a byte of the segment, like with Match.
+// It is MatchSlice over segments instead of bytes.
+func MatchSegments(patternSegs, segs []string) bool {
+	return matchSliceFunc(patternSegs, segs, segmentKind, matchSegment)
+}
+
+// segmentKind returns the kind of token of a pattern segment,
+// TokenLiteral standing for any segment matched with Match.
+func segmentKind(seg *string) TokenKind {
+	switch *seg {
+	case "**":
+		return TokenStar
+	case "?":
+		return TokenEroteme
+	}
+
+	return TokenLiteral
+}
+
+func matchSegment(seg *string, s string) bool {
+	return Match(*seg, s)
+}
+
+// MatchDelimited returns true if the pattern matches s, both split into segments
+// at every sep byte, see MatchSegments. For example "*.example.com" matches
+// "api.example.com" but not "a.b.example.com", the '.' being the separator
+// instead of a wildcard. The groups and bracket expressions must not contain sep.
+func MatchDelimited(pattern, s string, sep byte) bool {
+	return MatchSegments(strings.Split(pattern, string(sep)), strings.Split(s, string(sep)))
+}
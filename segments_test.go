@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestMatchDelimited validates that the wildcards match whole segments
+func TestMatchDelimited(t *testing.T) {
+	cases := []struct {
+		pattern string
+		s       string
+		sep     byte
+		result  bool
+	}{
+		{"*.example.com", "api.example.com", '.', true},
+		{"*.example.com", "a.b.example.com", '.', false},
+		{"*.example.com", "example.com", '.', false},
+		{"**.example.com", "a.b.example.com", '.', true},
+		{"**.example.com", "example.com", '.', true},
+		{"api-?.example.com", "api-1.example.com", '.', true},
+		{"api-?.example.com", "api-.example.com", '.', true},
+		{"api-?.example.com", "api-12.example.com", '.', false},
+		{"a.?.b", "a.b", '.', true},
+		{"a.?.b", "a..b", '.', true},
+		{"a.?.b", "a.x.b", '.', true},
+		{"a.?.b", "a.x.y.b", '.', false},
+		{"a.?.b", "a.xy.b", '.', true},
+		{"a.?x.b", "a.b", '.', false},
+		{"servers.*.cpu.{user,system}", "servers.web1.cpu.user", '.', true},
+		{"servers.*.cpu.{user,system}", "servers.web1.cpu.idle", '.', false},
+		{"servers.**.[0-9]", "servers.eu.web1.cpu.7", '.', true},
+		{"servers.**", "servers", '.', true},
+		{"**", "", '.', true},
+		{"", "", '.', true},
+		{"*", "", '.', true},
+		{"*", "a.b", '.', false},
+		{"a.**.b.**.c", "a.x.b.y.b.c", '.', true},
+		{"a.**.b.**.c", "a.x.c.b", '.', false},
+		{"usr/*/bin", "usr/local/bin", '/', true},
+	}
+
+	for i, c := range cases {
+		if result := MatchDelimited(c.pattern, c.s, c.sep); result != c.result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+func TestMatchSegments(t *testing.T) {
+	if !MatchSegments([]string{"logs", "**", "*.log"}, []string{"logs", "2025", "01", "app.log"}) {
+		t.Error("Expected `**` to match many segments")
+	}
+	if MatchSegments([]string{"logs", "*"}, []string{"logs", "2025", "app.log"}) {
+		t.Error("Expected `*` to match a single segment")
+	}
+}
+
+// FuzzMatchDelimited compares with MatchPath, which agrees without globstar,
+// "?" segment, groups, bracket expressions and escapes.
+func FuzzMatchDelimited(f *testing.F) {
+	f.Add("a/*/b?/c.", "a/x/b/cd")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		if strings.Contains(pattern, "**") || strings.ContainsAny(pattern, `\[{`) {
+			return
+		}
+		for _, seg := range strings.Split(pattern, "/") {
+			if seg == "?" {
+				return
+			}
+		}
+
+		if result := MatchDelimited(pattern, s, '/'); result != MatchPath(pattern, s) {
+			t.Fatalf("Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", !result, result, pattern, s)
+		}
+	})
+}
@@ -0,0 +1,339 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// PatternSet is a set of compiled patterns matched together against a string.
+// Every pattern is indexed by the longest literal it requires: its literal prefix
+// or suffix in a trie, or a literal fragment in an Aho-Corasick automaton.
+// A single scan of the string then selects the few patterns which can match,
+// and only those are matched.
+// A PatternSet is safe for concurrent use by multiple goroutines.
+type PatternSet struct {
+	patterns []*Pattern
+
+	literals  map[string][]int
+	prefixes  trie
+	suffixes  trie
+	fragments automaton
+
+	// always holds as a bitset the patterns without any literal to index.
+	always []uint64
+
+	// ranks holds the position of each pattern sorted by specificity.
+	ranks []int
+}
+
+// CompileSet compiles the patterns into a PatternSet, where the ID of a pattern
+// is its index in patterns. It returns an error wrapping ErrBadPattern
+// with the ID of the first pattern which cannot be compiled.
+func CompileSet(patterns ...string) (*PatternSet, error) {
+	s := &PatternSet{
+		patterns: make([]*Pattern, len(patterns)),
+		literals: make(map[string][]int),
+		always:   make([]uint64, (len(patterns)+63)/64),
+	}
+	s.prefixes.init()
+	s.suffixes.init()
+	s.fragments.init()
+
+	for id, pattern := range patterns {
+		p, err := Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("wildcard: pattern %d: %w", id, err)
+		}
+		s.patterns[id] = p
+
+		// The longest literal is the most selective one.
+		switch fragment := p.fragment(); {
+		case p.literal:
+			s.literals[p.text] = append(s.literals[p.text], id)
+		case p.prefix != "" && len(p.prefix) >= len(p.suffix) && len(p.prefix) >= len(fragment):
+			s.prefixes.insert(p.prefix, false, id)
+		case p.suffix != "" && len(p.suffix) >= len(fragment):
+			s.suffixes.insert(p.suffix, true, id)
+		case fragment != "":
+			s.fragments.insert(fragment, id)
+		default:
+			s.always[id/64] |= 1 << (id % 64)
+		}
+	}
+	s.fragments.build()
+
+	// The IDs of the patterns equally specific stay in increasing order.
+	measures := make([]specificity, len(patterns))
+	order := make([]int, len(patterns))
+	for id, pattern := range patterns {
+		measures[id] = measure(pattern)
+		order[id] = id
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if c := measures[a].compare(measures[b]); c != 0 {
+			return c < 0
+		}
+		return patterns[a] < patterns[b]
+	})
+	s.ranks = make([]int, len(patterns))
+	for rank, id := range order {
+		s.ranks[id] = rank
+	}
+
+	return s, nil
+}
+
+// MustCompileSet is like CompileSet but panics if a pattern cannot be parsed.
+func MustCompileSet(patterns ...string) *PatternSet {
+	s, err := CompileSet(patterns...)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return s
+}
+
+// Len returns the number of patterns in the set.
+func (s *PatternSet) Len() int {
+	return len(s.patterns)
+}
+
+// Match returns the IDs of all the patterns matching str, in increasing order,
+// or nil if there is none.
+func (s *PatternSet) Match(str string) []int {
+	var ids []int
+	s.candidates(str, func(id int) bool {
+		if s.patterns[id].Match(str) {
+			ids = append(ids, id)
+		}
+		return true
+	})
+
+	return ids
+}
+
+// MatchFirst returns the lowest ID of the patterns matching str, or -1 if there is none.
+func (s *PatternSet) MatchFirst(str string) int {
+	first := -1
+	s.candidates(str, func(id int) bool {
+		if s.patterns[id].Match(str) {
+			first = id
+			return false
+		}
+		return true
+	})
+
+	return first
+}
+
+// Best returns the ID of the most specific pattern matching str, as ordered by Compare,
+// or -1 if there is none. Between equal patterns, the lowest ID is returned.
+func (s *PatternSet) Best(str string) int {
+	best := -1
+	s.candidates(str, func(id int) bool {
+		if (best == -1 || s.ranks[id] < s.ranks[best]) && s.patterns[id].Match(str) {
+			best = id
+		}
+		return true
+	})
+
+	return best
+}
+
+// candidates calls fn with the IDs of the patterns which can match str,
+// in increasing order, until it returns false.
+func (s *PatternSet) candidates(str string, fn func(id int) bool) {
+	set := make([]uint64, len(s.always))
+	copy(set, s.always)
+	add := func(ids []int) {
+		for _, id := range ids {
+			set[id/64] |= 1 << (id % 64)
+		}
+	}
+
+	add(s.literals[str])
+	s.prefixes.walk(str, false, add)
+	s.suffixes.walk(str, true, add)
+	s.fragments.scan(str, add)
+
+	for i, word := range set {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			if !fn(i*64 + bit) {
+				return
+			}
+			word &^= 1 << bit
+		}
+	}
+}
+
+// fragment returns the longest literal run between the wildcards of the pattern,
+// which any matching string contains. It is empty if there is none, with folding
+// or with a globstar.
+func (p *Pattern) fragment() string {
+	var fragment string
+	if p.fold || p.globstar {
+		return fragment
+	}
+
+	for _, t := range p.middle() {
+		if t.kind == tokenLiteral && t.end-t.start > len(fragment) {
+			fragment = p.text[t.start:t.end]
+		}
+	}
+
+	return fragment
+}
+
+// trie indexes keys by their bytes, from the end when reversed.
+type trie struct {
+	nodes []trieNode
+}
+
+type trieNode struct {
+	edges map[byte]int
+	ids   []int
+}
+
+func (t *trie) init() {
+	t.nodes = []trieNode{{}}
+}
+
+func (t *trie) insert(key string, reverse bool, id int) {
+	n := 0
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if reverse {
+			c = key[len(key)-1-i]
+		}
+
+		next, ok := t.nodes[n].edges[c]
+		if !ok {
+			if t.nodes[n].edges == nil {
+				t.nodes[n].edges = make(map[byte]int)
+			}
+			next = len(t.nodes)
+			t.nodes[n].edges[c] = next
+			t.nodes = append(t.nodes, trieNode{})
+		}
+		n = next
+	}
+
+	t.nodes[n].ids = append(t.nodes[n].ids, id)
+}
+
+// walk calls fn with the IDs of every key which is a prefix of s,
+// or a suffix when reversed.
+func (t *trie) walk(s string, reverse bool, fn func(ids []int)) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if reverse {
+			c = s[len(s)-1-i]
+		}
+
+		next, ok := t.nodes[n].edges[c]
+		if !ok {
+			return
+		}
+		n = next
+		fn(t.nodes[n].ids)
+	}
+}
+
+// automaton is an Aho-Corasick automaton finding all the keys contained in a string.
+type automaton struct {
+	nodes []automatonNode
+}
+
+type automatonNode struct {
+	edges map[byte]int
+	ids   []int
+
+	// fail is the node of the longest proper suffix of this node in the trie,
+	// output the nearest one of the fail chain which ends a key.
+	fail, output int
+}
+
+func (a *automaton) init() {
+	a.nodes = []automatonNode{{output: -1}}
+}
+
+func (a *automaton) insert(key string, id int) {
+	n := 0
+	for i := 0; i < len(key); i++ {
+		next, ok := a.nodes[n].edges[key[i]]
+		if !ok {
+			if a.nodes[n].edges == nil {
+				a.nodes[n].edges = make(map[byte]int)
+			}
+			next = len(a.nodes)
+			a.nodes[n].edges[key[i]] = next
+			a.nodes = append(a.nodes, automatonNode{output: -1})
+		}
+		n = next
+	}
+
+	a.nodes[n].ids = append(a.nodes[n].ids, id)
+}
+
+// build computes the fail and output links, breadth first from the root.
+func (a *automaton) build() {
+	queue := []int{0}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		for c, child := range a.nodes[n].edges {
+			fail := 0
+			if n != 0 {
+				fail = a.next(a.nodes[n].fail, c)
+			}
+
+			a.nodes[child].fail = fail
+			a.nodes[child].output = a.nodes[fail].output
+			if len(a.nodes[fail].ids) > 0 {
+				a.nodes[child].output = fail
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// next returns the node following n with c, through the fail links.
+func (a *automaton) next(n int, c byte) int {
+	for {
+		if next, ok := a.nodes[n].edges[c]; ok {
+			return next
+		}
+		if n == 0 {
+			return 0
+		}
+		n = a.nodes[n].fail
+	}
+}
+
+// scan calls fn with the IDs of every key contained in s.
+func (a *automaton) scan(s string, fn func(ids []int)) {
+	if len(a.nodes) == 1 {
+		return
+	}
+
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = a.next(n, s[i])
+		for o := n; o > 0; o = a.nodes[o].output {
+			fn(a.nodes[o].ids)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestPatternSet validates that a set matches like every pattern
+// matched one by one, whatever literal indexes it
+func TestPatternSet(t *testing.T) {
+	patterns := []string{
+		"",
+		"*",
+		"tenants/acme/buckets/logs",
+		"tenants/*/buckets/*",
+		"tenants/acme/*",
+		"*/buckets/logs",
+		"*.log",
+		"*acme*",
+		"*bucket?/*",
+		"?.?",
+		"[a-z]*",
+		"{logs,tmp}/*",
+		"*{.txt,.md}",
+		"*me*me*",
+		"*a.c*",
+	}
+	strs := []string{
+		"",
+		"a.b",
+		"tenants/acme/buckets/logs",
+		"tenants/other/buckets/tmp",
+		"tenants/acme/README.md",
+		"logs/app.log",
+		"tmp/notes.txt",
+		"memememe",
+		"Xabc",
+		"Ta.c",
+		"🤷🏾‍♂️",
+	}
+
+	set, err := CompileSet(patterns...)
+	if err != nil {
+		t.Fatalf("Unexpected error `%v`", err)
+	}
+	if set.Len() != len(patterns) {
+		t.Errorf("Expected `%d` patterns, found `%d`", len(patterns), set.Len())
+	}
+
+	for _, s := range strs {
+		var expected []int
+		for id, pattern := range patterns {
+			if Match(pattern, s) {
+				expected = append(expected, id)
+			}
+		}
+
+		if ids := set.Match(s); !reflect.DeepEqual(ids, expected) {
+			t.Errorf("Expected `%v`, found `%v`; With String: `%s`", expected, ids, s)
+		}
+
+		first := -1
+		if len(expected) > 0 {
+			first = expected[0]
+		}
+		if id := set.MatchFirst(s); id != first {
+			t.Errorf("MatchFirst expected `%d`, found `%d`; With String: `%s`", first, id, s)
+		}
+	}
+}
+
+func TestPatternSetIndex(t *testing.T) {
+	set := MustCompileSet("abc*", "*xyz", "*mid*", "*", "exact")
+	cases := []struct {
+		s   string
+		ids []int
+	}{
+		{"", []int{3}},
+		{"abc", []int{0, 3}},
+		{"xyz", []int{1, 3}},
+		{"a mid xyz", []int{1, 2, 3}},
+		{"abc mid", []int{0, 2, 3}},
+		{"exact", []int{3, 4}},
+		{"exactly", []int{3}},
+	}
+
+	for i, c := range cases {
+		if ids := set.Match(c.s); !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With String: `%s`", i+1, c.ids, ids, c.s)
+		}
+	}
+}
+
+func TestCompileSetError(t *testing.T) {
+	if _, err := CompileSet("a*", "[a"); !errors.Is(err, ErrBadPattern) {
+		t.Errorf("Expected `%v`, found `%v`", ErrBadPattern, err)
+	}
+}
+
+func TestPatternSetBest(t *testing.T) {
+	set := MustCompileSet("*", "api/*", "api/v?/users", "api/v1/*", "api/*", "api/v1/users")
+	cases := []struct {
+		s    string
+		best int
+	}{
+		{"index.html", 0},
+		{"api/v2/users", 2},
+		{"api/v1/users", 5},
+		{"api/v1/groups", 3},
+		{"api/health", 1},
+	}
+
+	for i, c := range cases {
+		if id := set.Best(c.s); id != c.best {
+			t.Errorf("Test %d: Expected `%d`, found `%d`; With String: `%s`", i+1, c.best, id, c.s)
+		}
+	}
+	if id := MustCompileSet("a*").Best("b"); id != -1 {
+		t.Errorf("Expected `-1`, found `%d`", id)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/IGLOU-EU/go-wildcard/v2/syntax"
+)
+
+// Simplify returns the canonical form of the pattern, matching exactly the same strings,
+// so equivalent patterns can be deduplicated or cached under the same key.
+//
+// Without separator, '*', '?' and '.' match any character, so a run of them only
+// matters by the number of characters it can match: k dots and e erotemes match
+// from k to k+e characters, and k or more with a star. Such a run is rewritten
+// as k dots followed by a star if there is one, or else by the e erotemes,
+// like "a***b" to "a*b", "*?*" to "*" and "?." to ".?".
+// The alternatives of a group are sorted, and the duplicated ones are removed along
+// with the ones absorbed by an alternative of k dots and a star, which matches every
+// string of k characters or more, like "{b,*,a}" to "*" and "{.*,ab,?}" to "{.*,?}".
+// A group with a single alternative is replaced with it, and the characters are only
+// escaped if needed, so equivalent groups written in any order get the same key.
+//
+// The pattern is returned as is if it is malformed or not valid UTF-8.
+// With a separator, "**" is a globstar, so Simplify must not be used for MatchPath.
+// The wildcards being merged, MatchSubmatch may capture differently.
+func Simplify(pattern string) string {
+	if !utf8.ValidString(pattern) {
+		return pattern
+	}
+
+	root, err := syntax.Parse(pattern)
+	if err != nil {
+		return pattern
+	}
+
+	return simplify(root).String()
+}
+
+// simplify rewrites in place the OpConcat n and the groups it contains, and returns it.
+func simplify(n *syntax.Node) *syntax.Node {
+	var subs []*syntax.Node
+	for _, sub := range n.Subs {
+		if sub.Op != syntax.OpGroup {
+			subs = append(subs, sub)
+			continue
+		}
+
+		// from is the least number of dots before the star of an alternative
+		// matching any string of at least that many characters, -1 if none.
+		keys := make(map[*syntax.Node]string, len(sub.Subs))
+		from := -1
+		for _, alternative := range sub.Subs {
+			keys[alternative] = simplify(alternative).String()
+			if dots, ok := anyFrom(alternative); ok && (from == -1 || dots < from) {
+				from = dots
+			}
+		}
+
+		alternatives := sub.Subs[:0]
+		for _, alternative := range sub.Subs {
+			if dots, ok := anyFrom(alternative); from != -1 && (!ok || dots != from) && minLength(alternative) >= from {
+				continue
+			}
+			alternatives = append(alternatives, alternative)
+		}
+		sort.Slice(alternatives, func(i, j int) bool {
+			return keys[alternatives[i]] < keys[alternatives[j]]
+		})
+
+		sub.Subs = alternatives[:0]
+		for i, alternative := range alternatives {
+			if i == 0 || keys[alternative] != keys[alternatives[i-1]] {
+				sub.Subs = append(sub.Subs, alternative)
+			}
+		}
+		alternatives = sub.Subs
+
+		if len(alternatives) == 1 {
+			subs = append(subs, alternatives[0].Subs...)
+			continue
+		}
+		subs = append(subs, sub)
+	}
+
+	n.Subs = n.Subs[:0]
+	for i := 0; i < len(subs); {
+		if !isWildcard(subs[i].Op) {
+			n.Subs = append(n.Subs, subs[i])
+			i++
+			continue
+		}
+
+		var dots, erotemes int
+		star := false
+		for ; i < len(subs) && isWildcard(subs[i].Op); i++ {
+			switch subs[i].Op {
+			case syntax.OpStar:
+				star = true
+			case syntax.OpEroteme:
+				erotemes++
+			case syntax.OpDot:
+				dots++
+			}
+		}
+
+		for ; dots > 0; dots-- {
+			n.Subs = append(n.Subs, &syntax.Node{Op: syntax.OpDot})
+		}
+		if star {
+			n.Subs = append(n.Subs, &syntax.Node{Op: syntax.OpStar})
+			continue
+		}
+		for ; erotemes > 0; erotemes-- {
+			n.Subs = append(n.Subs, &syntax.Node{Op: syntax.OpEroteme})
+		}
+	}
+
+	return n
+}
+
+// anyFrom returns the number of dots of the simplified OpConcat n if it is
+// only made of dots and a star, matching any string of at least that many characters.
+func anyFrom(n *syntax.Node) (int, bool) {
+	dots, star := 0, false
+	for _, sub := range n.Subs {
+		switch sub.Op {
+		case syntax.OpDot:
+			dots++
+		case syntax.OpStar:
+			star = true
+		default:
+			return 0, false
+		}
+	}
+
+	return dots, star
+}
+
+// minLength returns the least number of characters of the strings matched
+// by the OpConcat n, counted in runes, which is never more than in bytes.
+func minLength(n *syntax.Node) int {
+	length := 0
+	for _, sub := range n.Subs {
+		switch sub.Op {
+		case syntax.OpLiteral:
+			length += utf8.RuneCountInString(sub.Text)
+		case syntax.OpDot, syntax.OpClass:
+			length++
+		case syntax.OpGroup:
+			least := -1
+			for _, alternative := range sub.Subs {
+				if l := minLength(alternative); least == -1 || l < least {
+					least = l
+				}
+			}
+			length += least
+		}
+	}
+
+	return length
+}
+
+// isWildcard reports whether op is a wildcard matching any character.
+func isWildcard(op syntax.Op) bool {
+	return op == syntax.OpStar || op == syntax.OpEroteme || op == syntax.OpDot
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import "testing"
+
+// TestSimplify validates the canonical form of the patterns
+func TestSimplify(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		expected string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"a***b", "a*b"},
+		{"**", "*"},
+		{"*?*", "*"},
+		{"?*", "*"},
+		{"*?", "*"},
+		{"?.", ".?"},
+		{"?.?.", "..??"},
+		{"*..*", "..*"},
+		{"a?*b.*c", "a*b.*c"},
+		{`\a\*`, `a\*`},
+		{"[^a]", "[!a]"},
+		{"{a,a}", "a"},
+		{"{a,b,a}", "{a,b}"},
+		{"{*,**}x", "*x"},
+		{"*{*}*", "*"},
+		{"{,}", ""},
+		{"{a,{b,b}}", "{a,b}"},
+		{"{b,a}", "{a,b}"},
+		{"{b,a,c}x", "{a,b,c}x"},
+		{"{*,a}", "*"},
+		{"x{b,*,a}y", "x*y"},
+		{"{.*,ab,?}", "{.*,?}"},
+		{"{..*,a,.*}", ".*"},
+		{"{.*,}", "{,.*}"},
+		{"{..*,é}", "{..*,é}"},
+		{"[", "["},
+		{"{a", "{a"},
+		{"a\xffb**", "a\xffb**"},
+	}
+
+	for i, c := range cases {
+		if result := Simplify(c.pattern); result != c.expected {
+			t.Errorf("Test %d: Expected `%s`, found `%s`; With Pattern: `%s`", i+1, c.expected, result, c.pattern)
+		}
+	}
+}
+
+// TestSimplifyEquivalence proves on every pattern of up to 5 characters and every
+// string of up to 5 characters, over a small alphabet, that Simplify keeps the
+// matched strings and is idempotent.
+func TestSimplifyEquivalence(t *testing.T) {
+	patterns := words("ab*?.{},", 5)
+	inputs := words("ab", 5)
+
+	for _, pattern := range patterns {
+		simple := Simplify(pattern)
+		if again := Simplify(simple); again != simple {
+			t.Fatalf("Simplify(%q) = %q is not stable, found %q", pattern, simple, again)
+		}
+
+		for _, s := range inputs {
+			if Match(pattern, s) != Match(simple, s) {
+				t.Fatalf("Match(%q, %q) = %t but Match(%q, %q) = %t",
+					pattern, s, Match(pattern, s), simple, s, Match(simple, s))
+			}
+		}
+	}
+}
+
+// words returns every string of up to n characters from the alphabet.
+func words(alphabet string, n int) []string {
+	all := []string{""}
+	for last := all; n > 0; n-- {
+		var next []string
+		for _, w := range last {
+			for i := 0; i < len(alphabet); i++ {
+				next = append(next, w+alphabet[i:i+1])
+			}
+		}
+		all = append(all, next...)
+		last = next
+	}
+
+	return all
+}
+
+func FuzzSimplify(f *testing.F) {
+	f.Add("a**?{b,b*}[^c].?", "abbbcd")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		simple := Simplify(pattern)
+		if Match(pattern, s) != Match(simple, s) {
+			t.Fatalf("Match(%q, %q) = %t but Simplify returned %q", pattern, s, Match(pattern, s), simple)
+		}
+		if MatchByRune(pattern, s) != MatchByRune(simple, s) {
+			t.Fatalf("MatchByRune(%q, %q) = %t but Simplify returned %q", pattern, s, MatchByRune(pattern, s), simple)
+		}
+		if Simplify(simple) != simple {
+			t.Fatalf("Simplify(%q) = %q is not stable", pattern, simple)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import "math/bits"
+
+// TokenKind is the kind of a Token.
+type TokenKind uint8
+
+const (
+	// TokenLiteral matches its Value.
+	TokenLiteral TokenKind = iota
+	// TokenStar matches any sequence of elements, even empty, like '*'.
+	TokenStar
+	// TokenEroteme matches zero or one element, like '?'.
+	TokenEroteme
+	// TokenDot matches exactly one element, like '.'.
+	TokenDot
+	// TokenClass matches one element among its Values, or not among them
+	// if Negate is set, like a bracket expression.
+	TokenClass
+)
+
+// Token is an element of a pattern matched by MatchSlice,
+// where the wildcards are explicit instead of being special characters.
+type Token[T comparable] struct {
+	Kind   TokenKind
+	Value  T
+	Values []T
+	Negate bool
+}
+
+// Literal returns a token matching the element value.
+func Literal[T comparable](value T) Token[T] {
+	return Token[T]{Kind: TokenLiteral, Value: value}
+}
+
+// Literals returns a token matching each of the elements, in order.
+func Literals[T comparable](values ...T) []Token[T] {
+	tokens := make([]Token[T], len(values))
+	for i, value := range values {
+		tokens[i] = Literal(value)
+	}
+
+	return tokens
+}
+
+// Star returns a token matching any sequence of elements, even empty.
+func Star[T comparable]() Token[T] {
+	return Token[T]{Kind: TokenStar}
+}
+
+// Eroteme returns a token matching zero or one element.
+func Eroteme[T comparable]() Token[T] {
+	return Token[T]{Kind: TokenEroteme}
+}
+
+// Dot returns a token matching exactly one element.
+func Dot[T comparable]() Token[T] {
+	return Token[T]{Kind: TokenDot}
+}
+
+// OneOf returns a token matching one of the elements values.
+func OneOf[T comparable](values ...T) Token[T] {
+	return Token[T]{Kind: TokenClass, Values: values}
+}
+
+// NoneOf returns a token matching one element which is not among values.
+func NoneOf[T comparable](values ...T) Token[T] {
+	return Token[T]{Kind: TokenClass, Values: values, Negate: true}
+}
+
+func (t *Token[T]) kind() TokenKind {
+	return t.Kind
+}
+
+// matches reports whether the token matches the single element e.
+func (t *Token[T]) matches(e T) bool {
+	switch t.Kind {
+	case TokenLiteral:
+		return t.Value == e
+	case TokenStar, TokenEroteme, TokenDot:
+		return true
+	case TokenClass:
+		for _, value := range t.Values {
+			if value == e {
+				return !t.Negate
+			}
+		}
+		return t.Negate
+	}
+
+	return false
+}
+
+// MatchSlice returns true if the pattern matches the slice s, whatever the type of
+// its elements, like path segments, DNS labels, UTF-16 code units or event codes.
+// It follows the algorithm of Match, and runs in O(len(pattern) × len(s)) time.
+func MatchSlice[T comparable](pattern []Token[T], s []T) bool {
+	return matchSliceFunc(pattern, s, (*Token[T]).kind, (*Token[T]).matches)
+}
+
+// matchSliceFunc is MatchSlice for any pattern elements, whose kind is given by kind,
+// and the elements matched by the ones which are not a star or an eroteme by matches.
+func matchSliceFunc[P, T any](pattern []P, s []T, kind func(*P) TokenKind, matches func(*P, T) bool) bool {
+	patternIndex, sIndex := 0, 0
+	star, lastStar := -1, 0
+
+	for sIndex < len(s) {
+		if patternIndex < len(pattern) {
+			switch t := &pattern[patternIndex]; {
+			case kind(t) == TokenStar:
+				star, lastStar = patternIndex, sIndex
+				patternIndex++
+				continue
+			case kind(t) == TokenEroteme:
+				return matchSliceResume(pattern, s, kind, matches, patternIndex, sIndex, star, lastStar)
+			case matches(t, s[sIndex]):
+				patternIndex++
+				sIndex++
+				continue
+			}
+		}
+
+		if star == -1 {
+			return false
+		}
+		patternIndex = star + 1
+		lastStar++
+		sIndex = lastStar
+	}
+
+	for patternIndex < len(pattern) && kind(&pattern[patternIndex]) == TokenStar {
+		patternIndex++
+	}
+	if patternIndex < len(pattern) && kind(&pattern[patternIndex]) == TokenEroteme {
+		return matchSliceResume(pattern, s, kind, matches, patternIndex, sIndex, star, lastStar)
+	}
+
+	return patternIndex == len(pattern)
+}
+
+// matchSliceResume matches the rest of the pattern with matchSliceStates, from the
+// pattern[patternIndex] reached at s[sIndex], or from the last star if there is one.
+func matchSliceResume[P, T any](pattern []P, s []T, kind func(*P) TokenKind, matches func(*P, T) bool, patternIndex, sIndex, star, lastStar int) bool {
+	if star != -1 {
+		return matchSliceStates(pattern, s, kind, matches, star, lastStar)
+	}
+
+	return matchSliceStates(pattern, s, kind, matches, patternIndex, sIndex)
+}
+
+// matchSliceStates reports whether pattern[state:] matches s[sIndex:], by simulating
+// at once all the ways to match it, like matchByStringStates.
+func matchSliceStates[P, T any](pattern []P, s []T, kind func(*P) TokenKind, matches func(*P, T) bool, state, sIndex int) bool {
+	var setsBuf [8]uint64
+
+	words := len(pattern)/64 + 1
+	sets := setsBuf[:]
+	if 2*words > len(sets) {
+		sets = make([]uint64, 2*words)
+	}
+	current, next := sets[:words], sets[words:2*words]
+
+	current[state/64] = 1 << (state % 64)
+	matchSliceFollow(pattern, kind, current)
+	for ; sIndex < len(s); sIndex++ {
+		alive := false
+		for w := range current {
+			for word := current[w]; word != 0; word &= word - 1 {
+				i := w*64 + bits.TrailingZeros64(word)
+				if i == len(pattern) {
+					continue
+				}
+
+				to := i + 1
+				switch k := kind(&pattern[i]); {
+				case k == TokenStar:
+					to = i
+				case k != TokenEroteme && !matches(&pattern[i], s[sIndex]):
+					continue
+				}
+				next[to/64] |= 1 << (to % 64)
+				alive = true
+			}
+			current[w] = 0
+		}
+		if !alive {
+			return false
+		}
+
+		matchSliceFollow(pattern, kind, next)
+		current, next = next, current
+	}
+
+	end := len(pattern)
+	return current[end/64]&(1<<(end%64)) != 0
+}
+
+// matchSliceFollow adds to states the states following a star or an eroteme,
+// which can match nothing.
+func matchSliceFollow[P any](pattern []P, kind func(*P) TokenKind, states []uint64) {
+	for w := range states {
+		for word := states[w]; word != 0; {
+			bit := bits.TrailingZeros64(word)
+			if i := w*64 + bit; i < len(pattern) && (kind(&pattern[i]) == TokenStar || kind(&pattern[i]) == TokenEroteme) {
+				states[(i+1)/64] |= 1 << ((i + 1) % 64)
+			}
+
+			// The states added in this word are seen, as they are after i.
+			word = states[w] &^ (1<<(bit+1) - 1)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestMatchSlice validates the tokens over different element types
+func TestMatchSlice(t *testing.T) {
+	labels := []Token[string]{Star[string](), Literal("example"), Literal("com")}
+	if !MatchSlice(labels, []string{"api", "v1", "example", "com"}) || MatchSlice(labels, []string{"example", "org"}) {
+		t.Error("Expected the labels to match only under example.com")
+	}
+
+	codes := []Token[int]{Literal(401), Eroteme[int](), OneOf(200, 204), Star[int]()}
+	cases := []struct {
+		s      []int
+		result bool
+	}{
+		{[]int{401, 200}, true},
+		{[]int{401, 302, 204, 500}, true},
+		{[]int{401, 302, 302, 204}, false},
+		{[]int{401}, false},
+		{[]int{200}, false},
+	}
+	for i, c := range cases {
+		if result := MatchSlice(codes, c.s); result != c.result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Codes: `%v`", i+1, c.result, result, c.s)
+		}
+	}
+
+	units := append(Literals[uint16]('C', ':', '\\'), NoneOf[uint16]('\\'), Dot[uint16](), Star[uint16]())
+	if !MatchSlice(units, []uint16{'C', ':', '\\', 'a', 'b'}) || MatchSlice(units, []uint16{'C', ':', '\\', '\\', 'b'}) {
+		t.Error("Expected the code units to match only a name of two characters or more")
+	}
+
+	if !MatchSlice[byte](nil, nil) || MatchSlice(nil, []byte{0}) {
+		t.Error("Expected an empty pattern to match only an empty slice")
+	}
+}
+
+func FuzzMatchSlice(f *testing.F) {
+	f.Add("a*b?c.", "axxbc1")
+	f.Add("?a?a?a?ab", "aaaaaaaa")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		if strings.ContainsAny(pattern, `\[{`) {
+			return
+		}
+
+		tokens := make([]Token[byte], len(pattern))
+		for i := 0; i < len(pattern); i++ {
+			switch pattern[i] {
+			case '*':
+				tokens[i] = Star[byte]()
+			case '?':
+				tokens[i] = Eroteme[byte]()
+			case '.':
+				tokens[i] = Dot[byte]()
+			default:
+				tokens[i] = Literal(pattern[i])
+			}
+		}
+
+		if result := MatchSlice(tokens, []byte(s)); result != Match(pattern, s) {
+			t.Fatalf("Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", !result, result, pattern, s)
+		}
+	})
+}
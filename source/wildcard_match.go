@@ -8,95 +8,722 @@
 
 package source
 
+import (
+	"math/bits"
+	"unicode"
+)
+
 const (
 	__COMPARISON_DOT__      = '.'
 	__COMPARISON_QUESTION__ = '?'
 	__COMPARISON_STAR__     = '*'
+	__COMPARISON_ESCAPE__   = '\\'
+	__COMPARISON_CLASS__    = '['
+	__COMPARISON_BRACE__    = '{'
+	__CLUSTER_MAX__         = unicode.MaxASCII
 )
 
 type __ARG_TYPE__ string
 type __CLUSTER_TYPE__ byte
 
-func __FUNC_NAME__(pattern, s __ARG_TYPE__) bool {
-	var lastErotemeCluster __CLUSTER_TYPE__
-	var patternIndex, sIndex, lastStar, lastEroteme int
-	patternLen := len(pattern)
-	sLen := len(s)
+// __FUNC_NAME__ reports whether the pattern matches s. If separator is not -1,
+// only a literal in the pattern can match it, so wildcards stay within a path segment.
+// If fold is set, the characters are compared under simple case folding.
+//
+// It runs in O(len(pattern) × len(s)) time whatever the pattern is, without recursion,
+// so untrusted patterns can't make it backtrack exponentially.
+func __FUNC_NAME__(pattern, s __ARG_TYPE__, separator int, fold bool) bool {
+	var patternIndex, sIndex, lastStar int
 	star := -1
-	eroteme := -1
 
-Loop:
-	if sIndex >= sLen {
-		goto checkPattern
-	}
+	// Until a group or a globstar, only the last star seen is backtracked:
+	// once the text up to a star matched, any position where the rest can match
+	// is reachable from the leftmost one. The star restarts at most len(s) times
+	// the part of the pattern which follows it, hence O(len(pattern) × len(s)).
+	// A part holding a '?' is matched at once from all the positions instead.
+	for sIndex < len(s) {
+		if patternIndex < len(pattern) {
+			switch pattern[patternIndex] {
+			case __COMPARISON_QUESTION__:
+				next, end, ok := __FUNC_NAME__Erotemes(pattern, s, star, lastStar, separator, fold)
+				if !ok {
+					return __FUNC_NAME__Resume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+				if end == -1 || next == len(pattern) {
+					return end != -1
+				}
+
+				// The rest is matched from the earliest end, so the stars before are done.
+				patternIndex = next
+				sIndex = end
+				star = -1
+				continue
+			case __COMPARISON_BRACE__:
+				return __FUNC_NAME__Resume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+			case __COMPARISON_STAR__:
+				if __FUNC_NAME__Globstar(pattern, patternIndex, separator) != -1 {
+					return __FUNC_NAME__Resume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+
+				star = patternIndex
+				lastStar = sIndex
+				patternIndex++
+
+				// A trailing star takes the rest of s, unless it holds a separator.
+				if patternIndex == len(pattern) {
+					for ; sIndex < len(s); sIndex++ {
+						if int(s[sIndex]) == separator {
+							return false
+						}
+					}
+					return true
+				}
+				continue
+			case __COMPARISON_DOT__:
+				// It matches any single character but the separator.
+				if int(s[sIndex]) != separator {
+					patternIndex++
+					sIndex++
+					continue
+				}
+			case __COMPARISON_CLASS__:
+				// '[' matches one character of the class but the separator.
+				// A malformed class never matches, and every match would go through it.
+				end, matched := __FUNC_NAME__Class(pattern, patternIndex, __CLUSTER_TYPE__(s[sIndex]), fold)
+				if end == -1 {
+					return false
+				}
+				if matched && int(s[sIndex]) != separator {
+					patternIndex = end + 1
+					sIndex++
+					continue
+				}
+			default:
+				// '\' makes the next character a literal, a lone escape at the end never matches.
+				next := patternIndex + 1
+				if pattern[patternIndex] == __COMPARISON_ESCAPE__ {
+					if next == len(pattern) {
+						return false
+					}
+					patternIndex++
+					next++
+				}
 
-	if patternIndex >= patternLen {
-		if star != -1 {
-			patternIndex = star + 1
-			lastStar++
-			sIndex = lastStar
-			goto Loop
+				if pattern[patternIndex] == s[sIndex] || fold && __FUNC_NAME__Fold(__CLUSTER_TYPE__(pattern[patternIndex]), __CLUSTER_TYPE__(s[sIndex])) {
+					patternIndex = next
+					sIndex++
+					continue
+				}
+			}
 		}
-		return false
+
+		// The characters don't match, so the last star takes one more character,
+		// unless it is the separator.
+		if star == -1 || int(s[lastStar]) == separator {
+			return false
+		}
+		patternIndex = star + 1
+		lastStar = __FUNC_NAME__Skip(pattern, s, patternIndex, lastStar+1, separator, fold)
+		sIndex = lastStar
 	}
-	switch pattern[patternIndex] {
-	case __COMPARISON_DOT__:
-		// It matches any single character. So, we don't need to check anything.
-	case __COMPARISON_QUESTION__:
-		// '?' matches one character. Store its position and match exactly one character in the string.
-		eroteme = patternIndex
-		lastEroteme = sIndex
-		lastErotemeCluster = __CLUSTER_TYPE__(s[sIndex])
-	case __COMPARISON_STAR__:
-		// '*' matches zero or more characters. Store its position and increment the pattern index.
-		star = patternIndex
-		lastStar = sIndex
+
+	// Only stars can match the end of the string, and maybe '?' or groups.
+	for patternIndex < len(pattern) && pattern[patternIndex] == __COMPARISON_STAR__ {
+		if __FUNC_NAME__Globstar(pattern, patternIndex, separator) != -1 {
+			break
+		}
 		patternIndex++
-		goto Loop
+	}
+	if patternIndex < len(pattern) && (pattern[patternIndex] == __COMPARISON_QUESTION__ ||
+		pattern[patternIndex] == __COMPARISON_BRACE__ || pattern[patternIndex] == __COMPARISON_STAR__) {
+		return __FUNC_NAME__Resume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+	}
+
+	return patternIndex == len(pattern)
+}
+
+// __FUNC_NAME__Skip returns the first index of s from sIndex where the literal at
+// pattern[patternIndex] or a separator is, so that a star doesn't retry the rest
+// of the pattern at every character. It returns sIndex if it is not a literal.
+func __FUNC_NAME__Skip(pattern, s __ARG_TYPE__, patternIndex, sIndex, separator int, fold bool) int {
+	if fold || patternIndex == len(pattern) {
+		return sIndex
+	}
+
+	switch c := pattern[patternIndex]; c {
+	case __COMPARISON_DOT__, __COMPARISON_QUESTION__, __COMPARISON_STAR__, __COMPARISON_ESCAPE__,
+		__COMPARISON_CLASS__, __COMPARISON_BRACE__:
 	default:
-		// If the characters don't match, check if there was a previous '?' or '*' to backtrack.
-		if pattern[patternIndex] != s[sIndex] {
-			if eroteme != -1 {
-				patternIndex = eroteme + 1
-				sIndex = lastEroteme
-				eroteme = -1
-				goto Loop
+		for sIndex < len(s) && s[sIndex] != c && int(s[sIndex]) != separator {
+			sIndex++
+		}
+	}
+
+	return sIndex
+}
+
+// __FUNC_NAME__Erotemes matches the part of the pattern holding a '?' up to the next star,
+// starting after the star at pattern[star] and from any position of s[lastStar:] that
+// the star can reach, or at the start of both if star is -1. As the next star can take
+// any character the part could, the earliest end of the part is the best one.
+// Without a next star, the part must end with s. It returns the index of the next star
+// and the end of the part in s, or -1 if the part never matches. It returns false
+// if the part holds a group, or is too long for its states to fit in a word.
+func __FUNC_NAME__Erotemes(pattern, s __ARG_TYPE__, star, lastStar, separator int, fold bool) (int, int, bool) {
+	start, sIndex := 0, 0
+	if star != -1 {
+		start, sIndex = star+1, lastStar
+	}
+
+	// The state k of the bitset means that the part matched up to pattern[start+k].
+	// The erotemes, the dots and the literals, stepped here without a call, are masks of states.
+	var erotemes, dots, literals uint64
+	var tokens int
+	next := start
+	for ; next < len(pattern) && pattern[next] != __COMPARISON_STAR__; next++ {
+		tokens++
+		switch pattern[next] {
+		case __COMPARISON_BRACE__:
+			return 0, 0, false
+		case __COMPARISON_QUESTION__:
+			erotemes |= 1 << (next - start)
+		case __COMPARISON_DOT__:
+			dots |= 1 << (next - start)
+		case __COMPARISON_ESCAPE__:
+			next++
+		case __COMPARISON_CLASS__:
+			if end, _, _ := __FUNC_NAME__Set(pattern, next, 0); end != -1 {
+				next = end
 			}
+		default:
+			if !fold {
+				literals |= 1 << (next - start)
+			}
+		}
+	}
+	if next > len(pattern) {
+		next = len(pattern)
+	}
+	if next-start >= 64 {
+		return 0, 0, false
+	}
 
-			if star != -1 {
-				patternIndex = star + 1
-				lastStar++
-				sIndex = lastStar
-				goto Loop
+	// The star takes the characters until the first separator. At the end of the pattern,
+	// the part matches between tokens minus the erotemes and tokens characters, so it
+	// only starts in the last tokens characters of s.
+	inject := star != -1
+	until := len(s)
+	if inject && next == len(pattern) {
+		until -= tokens - bits.OnesCount64(erotemes)
+		for ; sIndex < len(s)-tokens; sIndex++ {
+			if int(s[sIndex]) == separator {
+				return next, -1, true
 			}
+		}
+	}
+
+	last := uint64(1) << (next - start)
+	states := uint64(1)
+	for {
+		if inject && sIndex <= until {
+			states |= 1
+		}
+		for word := states & erotemes; word != 0; word &= word - 1 {
+			bit := (word & -word) << 1
+			states |= bit
+			word |= bit & erotemes
+		}
 
+		if states&last != 0 && (next < len(pattern) || sIndex == len(s)) {
+			return next, sIndex, true
+		}
+		if sIndex == len(s) {
+			return next, -1, true
+		}
+
+		c := s[sIndex]
+		switch {
+		case states == 1 && literals&1 != 0 && inject:
+			// Until the part starts, skip to a character that can start it.
+			for c != pattern[start] && int(c) != separator {
+				if sIndex++; sIndex == len(s) || sIndex > until {
+					return next, -1, true
+				}
+				c = s[sIndex]
+			}
+		case states&(states-1) == 0 && literals&states != 0 && (!inject || sIndex >= until):
+			// A single state reads its literals at once.
+			k := bits.TrailingZeros64(states)
+			for literals&(1<<k) != 0 && sIndex < len(s) && pattern[start+k] == s[sIndex] {
+				k++
+				sIndex++
+			}
+			if literals&(1<<k) != 0 && sIndex < len(s) {
+				return next, -1, true
+			}
+			states = 1 << k
+			continue
+		}
+
+		if int(c) == separator {
+			inject = false
+		}
+
+		var to uint64
+		for word := states &^ last; word != 0; word &= word - 1 {
+			k := bits.TrailingZeros64(word)
+			if literals&(1<<k) != 0 {
+				if pattern[start+k] == c {
+					to |= 1 << (k + 1)
+				}
+			} else if (erotemes|dots)&(1<<k) != 0 {
+				if int(c) != separator {
+					to |= 1 << (k + 1)
+				}
+			} else if i := __FUNC_NAME__Step(pattern, start+k, __CLUSTER_TYPE__(c), nil, separator, fold); i != -1 {
+				to |= 1 << (i - start)
+			}
+		}
+		states = to
+		sIndex++
+		if states == 0 && !inject {
+			return next, -1, true
+		}
+	}
+}
+
+// __FUNC_NAME__Resume matches the rest of the pattern with __FUNC_NAME__States, from the
+// pattern[patternIndex] reached at s[sIndex], or from the last star if there is one.
+func __FUNC_NAME__Resume(pattern, s __ARG_TYPE__, patternIndex, sIndex, star, lastStar, separator int, fold bool) bool {
+	if star != -1 {
+		return __FUNC_NAME__States(pattern, s, star, lastStar, separator, fold)
+	}
+
+	return __FUNC_NAME__States(pattern, s, patternIndex, sIndex, separator, fold)
+}
+
+// __FUNC_NAME__States reports whether pattern[state:] matches s[sIndex:], by simulating
+// at once all the ways to match it. The state i means that the characters read so far
+// matched up to pattern[i], and the states are kept in a bitset. For every character,
+// each state steps to at most one state, then the states reached are followed through
+// the wildcards which can match nothing and the group delimiters, always forward
+// in the pattern. Both take O(len(pattern)), hence O(len(pattern) × len(s)) overall.
+func __FUNC_NAME__States(pattern, s __ARG_TYPE__, state, sIndex, separator int, fold bool) bool {
+	// The buffers keep the patterns up to 255 characters on the stack.
+	var setsBuf [8]uint64
+	var linksBuf [512]int32
+
+	words := len(pattern)/64 + 1
+	sets := setsBuf[:]
+	if 2*words > len(sets) {
+		sets = make([]uint64, 2*words)
+	}
+	current, next := sets[:words], sets[words:2*words]
+
+	var links []int32
+	for i := range pattern {
+		if pattern[i] == __COMPARISON_BRACE__ {
+			links = __FUNC_NAME__Links(pattern, linksBuf[:])
+			break
+		}
+	}
+
+	current[state/64] = 1 << (state % 64)
+	__FUNC_NAME__Follow(pattern, current, links, separator)
+	for ; sIndex < len(s); sIndex++ {
+		alive := false
+		for w := range current {
+			for word := current[w]; word != 0; word &= word - 1 {
+				i := w*64 + bits.TrailingZeros64(word)
+				if to := __FUNC_NAME__Step(pattern, i, __CLUSTER_TYPE__(s[sIndex]), links, separator, fold); to != -1 {
+					next[to/64] |= 1 << (to % 64)
+					alive = true
+				}
+			}
+			current[w] = 0
+		}
+		if !alive {
 			return false
 		}
 
-		// If the characters match, check if it was not the same to validate the eroteme.
-		if eroteme != -1 && lastErotemeCluster != __CLUSTER_TYPE__(s[sIndex]) {
-			eroteme = -1
+		__FUNC_NAME__Follow(pattern, next, links, separator)
+		current, next = next, current
+	}
+
+	end := len(pattern)
+	return current[end/64]&(1<<(end%64)) != 0
+}
+
+// __FUNC_NAME__Step returns the state following the state i with the character c,
+// or -1 if c can't be matched there. Group delimiters and unclosed groups never match.
+func __FUNC_NAME__Step(pattern __ARG_TYPE__, i int, c __CLUSTER_TYPE__, links []int32, separator int, fold bool) int {
+	if i == len(pattern) {
+		return -1
+	}
+
+	switch pattern[i] {
+	case __COMPARISON_STAR__:
+		switch __FUNC_NAME__Globstar(pattern, i, separator) {
+		case i + 2:
+			// A trailing "**" matches everything.
+			return i
+		case i + 3:
+			// "**/" is at a segment boundary, where a separator ends an empty segment.
+			// The second '*' is the state inside a segment.
+			if int(c) == separator {
+				return i
+			}
+			return i + 1
+		}
+
+		if int(c) != separator {
+			return i
+		}
+		if i > 0 && pattern[i-1] == __COMPARISON_STAR__ && __FUNC_NAME__Globstar(pattern, i-1, separator) == i+2 {
+			return i - 1
+		}
+	case __COMPARISON_QUESTION__, __COMPARISON_DOT__:
+		if int(c) != separator {
+			return i + 1
+		}
+	case __COMPARISON_CLASS__:
+		end, matched := __FUNC_NAME__Class(pattern, i, c, fold)
+		if end != -1 && matched && int(c) != separator {
+			return end + 1
+		}
+	case __COMPARISON_BRACE__:
+	default:
+		if links != nil && links[2*i+1] > 0 {
+			return -1
+		}
+
+		// '\' makes the next character a literal, a lone escape at the end never matches.
+		next := i + 1
+		if pattern[i] == __COMPARISON_ESCAPE__ {
+			if next == len(pattern) {
+				return -1
+			}
+			i++
+			next++
+		}
+		if __CLUSTER_TYPE__(pattern[i]) == c || fold && __FUNC_NAME__Fold(__CLUSTER_TYPE__(pattern[i]), c) {
+			return next
 		}
 	}
 
-	patternIndex++
-	sIndex++
-	goto Loop
+	return -1
+}
+
+// __FUNC_NAME__Follow adds to states the states reached from them without a character:
+// after a star, a '?' or a globstar, at the start of every alternative of a group,
+// and after the group at the end of an alternative.
+// All of them are after the state in the pattern, so a single forward pass is enough.
+func __FUNC_NAME__Follow(pattern __ARG_TYPE__, states []uint64, links []int32, separator int) {
+	add := func(i int) {
+		states[i/64] |= 1 << (i % 64)
+	}
+
+	for w := range states {
+		for word := states[w]; word != 0; {
+			bit := bits.TrailingZeros64(word)
+			if i := w*64 + bit; i < len(pattern) {
+				switch pattern[i] {
+				case __COMPARISON_STAR__:
+					if next := __FUNC_NAME__Globstar(pattern, i, separator); next != -1 {
+						add(next)
+					} else {
+						add(i + 1)
+					}
+				case __COMPARISON_QUESTION__:
+					add(i + 1)
+				case __COMPARISON_BRACE__:
+					if links[2*i+1] != -1 {
+						add(i + 1)
+						for d := int(links[2*i]); pattern[d] != '}'; d = int(links[2*d]) {
+							add(d + 1)
+						}
+					}
+				case ',', '}':
+					if links != nil && links[2*i+1] > 0 {
+						add(int(links[2*i+1]) + 1)
+					}
+				}
+			}
+
+			// The states added in this word are seen, as they are after i.
+			word = states[w] &^ (1<<(bit+1) - 1)
+		}
+	}
+}
+
+// __FUNC_NAME__Links returns for the groups of the pattern two links per character,
+// using buf when it is large enough. For a '{' or a ',' ending an alternative,
+// links[2*i] is the index of the ',' or '}' ending the next alternative.
+// For a '{', a ',' or a '}' of a group, links[2*i+1] is the index of the '}'
+// closing the group, or -1 for a '{' without one. The other links are 0.
+func __FUNC_NAME__Links(pattern __ARG_TYPE__, buf []int32) []int32 {
+	links := buf
+	if 2*len(pattern) > len(links) {
+		links = make([]int32, 2*len(pattern))
+	}
+	links = links[:2*len(pattern)]
+	for i := range links {
+		links[i] = 0
+	}
+
+	// While a group is open, links[2*open+1] is its last delimiter.
+	var stack [16]int32
+	groups := stack[:0]
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case __COMPARISON_ESCAPE__:
+			i++
+		case __COMPARISON_CLASS__:
+			if end, _, _ := __FUNC_NAME__Set(pattern, i, 0); end != -1 {
+				i = end
+			}
+		case __COMPARISON_BRACE__:
+			groups = append(groups, int32(i))
+			links[2*i+1] = int32(i)
+		case ',', '}':
+			if len(groups) == 0 {
+				break
+			}
+
+			open := groups[len(groups)-1]
+			links[2*links[2*open+1]] = int32(i)
+			links[2*open+1] = int32(i)
+			if pattern[i] == ',' {
+				break
+			}
+
+			groups = groups[:len(groups)-1]
+			for d := open; ; d = links[2*d] {
+				links[2*d+1] = int32(i)
+				if d == int32(i) {
+					break
+				}
+			}
+		}
+	}
+
+	for _, open := range groups {
+		links[2*open+1] = -1
+	}
+
+	return links
+}
+
+// __FUNC_NAME__Globstar returns the index following the "**" at pattern[i] and its
+// separator when it is a whole path segment, or -1 if it is not a globstar.
+func __FUNC_NAME__Globstar(pattern __ARG_TYPE__, i, separator int) int {
+	if separator == -1 || i+1 >= len(pattern) || pattern[i+1] != __COMPARISON_STAR__ ||
+		(i > 0 && int(pattern[i-1]) != separator) {
+		return -1
+	}
+
+	switch {
+	case i+2 == len(pattern):
+		return i + 2
+	case int(pattern[i+2]) == separator:
+		return i + 3
+	}
+
+	return -1
+}
+
+// __FUNC_NAME__Close returns the index of the '}' closing the group starting
+// at pattern[start], or -1 if the group is not closed.
+func __FUNC_NAME__Close(pattern __ARG_TYPE__, start int) int {
+	i := start
+	for i != -1 && (i == start || pattern[i] != '}') {
+		i = __FUNC_NAME__Next(pattern, i+1)
+	}
+
+	return i
+}
+
+// __FUNC_NAME__Next returns the index of the first ',' or '}' from pattern[i] that
+// belongs to the current group, skipping escapes, bracket expressions and nested groups.
+// It returns -1 if there is none.
+func __FUNC_NAME__Next(pattern __ARG_TYPE__, i int) int {
+	for i < len(pattern) {
+		switch pattern[i] {
+		case ',', '}':
+			return i
+		case __COMPARISON_ESCAPE__:
+			i += 2
+		case __COMPARISON_CLASS__:
+			if end, _, _ := __FUNC_NAME__Set(pattern, i, 0); end != -1 {
+				i = end
+			}
+			i++
+		case __COMPARISON_BRACE__:
+			i = __FUNC_NAME__Close(pattern, i)
+			if i == -1 {
+				return -1
+			}
+			i++
+		default:
+			i++
+		}
+	}
+
+	return -1
+}
 
-	// Check if the remaining pattern characters are '*' or '?', which can match the end of the string.
-checkPattern:
-	if patternIndex < patternLen {
-		if pattern[patternIndex] == __COMPARISON_STAR__ {
-			patternIndex++
-			goto checkPattern
-		} else if pattern[patternIndex] == __COMPARISON_QUESTION__ {
-			if sIndex >= sLen {
-				sIndex--
+// __FUNC_NAME__Class matches c against the bracket expression starting at pattern[start].
+// It supports ranges, negation with '!' or '^' and POSIX named classes like "[:alpha:]".
+// If fold is set, c is in the class if one of its case foldings is.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func __FUNC_NAME__Class(pattern __ARG_TYPE__, start int, c __CLUSTER_TYPE__, fold bool) (int, bool) {
+	end, in, negate := __FUNC_NAME__Set(pattern, start, c)
+	if fold && !in && end != -1 {
+		for r := unicode.SimpleFold(rune(c)); r != rune(c) && !in; r = unicode.SimpleFold(r) {
+			if r <= __CLUSTER_MAX__ {
+				_, in, _ = __FUNC_NAME__Set(pattern, start, __CLUSTER_TYPE__(r))
 			}
-			patternIndex++
-			goto checkPattern
 		}
 	}
 
-	return patternIndex == patternLen
+	return end, in != negate
+}
+
+// __FUNC_NAME__Set reports whether c is in the set of the bracket expression starting
+// at pattern[start], and if the expression is negated.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func __FUNC_NAME__Set(pattern __ARG_TYPE__, start int, c __CLUSTER_TYPE__) (int, bool, bool) {
+	var matched, negate bool
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
+	}
+
+	// A ']' right after the opening bracket is a literal.
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return i, matched, negate
+		}
+
+		if pattern[i] == '[' && i+1 < len(pattern) && pattern[i+1] == ':' {
+			end := i + 2
+			for end+1 < len(pattern) && (pattern[end] != ':' || pattern[end+1] != ']') {
+				end++
+			}
+			if end+1 >= len(pattern) {
+				return -1, false, false
+			}
+
+			in, ok := __FUNC_NAME__Named(pattern[i+2:end], c)
+			if !ok {
+				return -1, false, false
+			}
+			matched = matched || in
+			i = end + 2
+			continue
+		}
+
+		lo, next := __FUNC_NAME__ClassChar(pattern, i)
+		if next == -1 {
+			return -1, false, false
+		}
+		hi := lo
+		if next+1 < len(pattern) && pattern[next] == '-' && pattern[next+1] != ']' {
+			hi, next = __FUNC_NAME__ClassChar(pattern, next+1)
+			if next == -1 || hi < lo {
+				return -1, false, false
+			}
+		}
+
+		matched = matched || (lo <= c && c <= hi)
+		i = next
+	}
+
+	return -1, false, false
+}
+
+// __FUNC_NAME__Fold reports whether a and b are equal under simple case folding.
+// Only ASCII letters are folded with byte comparison.
+func __FUNC_NAME__Fold(a, b __CLUSTER_TYPE__) bool {
+	if rune(a) <= unicode.MaxASCII && rune(b) <= unicode.MaxASCII {
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		return a == b
+	}
+	if rune(a) > __CLUSTER_MAX__ || rune(b) > __CLUSTER_MAX__ {
+		return false
+	}
+
+	for r := unicode.SimpleFold(rune(a)); r != rune(a); r = unicode.SimpleFold(r) {
+		if r == rune(b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// __FUNC_NAME__ClassChar returns the possibly escaped character at pattern[i]
+// and the index following it, or -1 if the escape is the last character.
+func __FUNC_NAME__ClassChar(pattern __ARG_TYPE__, i int) (__CLUSTER_TYPE__, int) {
+	if pattern[i] == __COMPARISON_ESCAPE__ {
+		i++
+		if i >= len(pattern) {
+			return 0, -1
+		}
+	}
+
+	return __CLUSTER_TYPE__(pattern[i]), i + 1
+}
+
+// __FUNC_NAME__Named reports whether c is in the POSIX class called name,
+// and false for ok if there is no such class.
+// Characters above __CLUSTER_MAX__ are never part of a named class.
+func __FUNC_NAME__Named(name __ARG_TYPE__, c __CLUSTER_TYPE__) (matched, ok bool) {
+	var buf [6]byte
+	if len(name) > len(buf) {
+		return false, false
+	}
+	for i := range name {
+		if name[i] > unicode.MaxASCII {
+			return false, false
+		}
+		buf[i] = byte(name[i])
+	}
+
+	r := rune(c)
+	in := r <= __CLUSTER_MAX__
+	switch string(buf[:len(name)]) {
+	case "alnum":
+		return in && (unicode.IsLetter(r) || unicode.IsDigit(r)), true
+	case "alpha":
+		return in && unicode.IsLetter(r), true
+	case "blank":
+		return in && (r == '\t' || unicode.Is(unicode.Zs, r)), true
+	case "cntrl":
+		return in && unicode.IsControl(r), true
+	case "digit":
+		return in && unicode.IsDigit(r), true
+	case "graph":
+		return in && unicode.IsGraphic(r) && !unicode.IsSpace(r), true
+	case "lower":
+		return in && unicode.IsLower(r), true
+	case "print":
+		return in && unicode.IsPrint(r), true
+	case "punct":
+		return in && (unicode.IsPunct(r) || unicode.IsSymbol(r)), true
+	case "space":
+		return in && unicode.IsSpace(r), true
+	case "upper":
+		return in && unicode.IsUpper(r), true
+	case "xdigit":
+		return in && (r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'), true
+	}
+
+	return false, false
 }
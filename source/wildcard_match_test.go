@@ -9,11 +9,12 @@
 package source
 
 import (
+	"strings"
 	"testing"
 )
 
 // TestMatch validates the logic of wild card matching,
-// it need to support '*', '?' and '.' and only validate for byte comparison
+// it need to support '*', '?', '.', '[...]', '{...}' and '\\' escape and only validate for byte comparison
 // over string, not rune or grapheme cluster
 func TestMatch(t *testing.T) {
 	cases := []struct {
@@ -44,11 +45,14 @@ func TestMatch(t *testing.T) {
 		{"a", "??", true},
 		{"a", ".", true},
 		{"a", ".?", true},
-		{"a", "?.", false},
+		{"a", "?.", true},
 		{"a", ".*", true},
 		{"a", "*.", true},
 		{"a", "*.?", true},
-		{"a", "?.*", false},
+		{"a", "?.*", true},
+		{"aa", "??aa", true},
+		{"ab", "a??b", true},
+		{"ab", ".?b", true},
 
 		{"match the exact string", "match the exact string", true},
 		{"do not match a different string", "this is a different string", false},
@@ -77,12 +81,227 @@ func TestMatch(t *testing.T) {
 		{"match a string with two .", "match a ..ring with two .", true},
 		{"do not match a string with extra .", "do not match a string with extra ..", false},
 
+		{"match a string with a literal *", `match a string with a literal \*`, true},
+		{"do not match a string without a literal !", `do not match a string without a literal \*`, false},
+		{"match a string with a literal ?", `match a string with a literal \?`, true},
+		{"do not match a string without a literal ", `do not match a string without a literal \?`, false},
+		{"api.example.com", `api\.example\.com`, true},
+		{"apixexample.com", `api\.example\.com`, false},
+		{"a*b", `*\**`, true},
+		{"ab", `*\**`, false},
+		{`a\b`, `a\\b`, true},
+		{`a\b`, `a\b`, false},
+		{`a\`, `a\`, false},
+		{`a`, `a\`, false},
+		{`a\`, `*\`, false},
+
+		{"match a digit 7", "match a digit [0-9]", true},
+		{"do not match a digit x", "do not match a digit [0-9]", false},
+		{"match a vowel e", "match a vowel [aeiou]", true},
+		{"do not match a vowel z", "do not match a vowel [aeiou]", false},
+		{"match a negated class z", "match a negated class [!aeiou]", true},
+		{"do not match a negated class a", "do not match a negated class [^aeiou]", false},
+		{"match a class with a bracket ]", "match a class with a bracket []]", true},
+		{"match a negated class with a bracket a", "match a negated class with a bracket [!]]", true},
+		{"match a class with a dash -", "match a class with a dash [a-]", true},
+		{"match a class with escapes ]", `match a class with escapes [\]\-]`, true},
+		{"match a class with escapes -", `match a class with escapes [\]\-]`, true},
+		{"match a posix class A1", "match a posix class [[:upper:]][[:digit:]]", true},
+		{"match a posix class f", "match a posix class [[:xdigit:]]", true},
+		{"do not match a posix class g", "do not match a posix class [[:xdigit:]]", false},
+		{"match a posix class $", "match a posix class [[:punct:]]", true},
+		{"match many posix classes _", "match many posix classes [[:alpha:][:digit:]_]", true},
+		{"do not match a posix class é", "do not match a posix class [[:alpha:]]*", false},
+		{"match a class with a star 1234", "match a class with a star *[0-9][0-9]", true},
+		{"match a class with an eroteme 12", "match a class with an eroteme ?[0-9]", true},
+		{"do not match an unclosed class a", "do not match an unclosed class [a", false},
+		{"do not match an unclosed class [a", "do not match an unclosed class [a", false},
+		{"do not match a reversed range b", "do not match a reversed range [z-a]", false},
+		{"do not match an unknown class a", "do not match an unknown class [[:alfa:]]", false},
+		{"match a literal [", `match a literal \[`, true},
+
+		{"logs/app-1.log", "logs/{app,worker,cron}-*.log", true},
+		{"logs/cron-1.log", "logs/{app,worker,cron}-*.log", true},
+		{"logs/web-1.log", "logs/{app,worker,cron}-*.log", false},
+		{"match an empty alternative", "match an {empty,} alternative", true},
+		{"match an  alternative", "match an {empty,} alternative", true},
+		{"match a wildcard in alternatives", "match a {*card,none} in alternatives", true},
+		{"match a wildcard in alternatives", "match a {none,w?ldcard} in alternatives", true},
+		{"match nested alternatives", "match {nes{ted,ting},flat} alternatives", true},
+		{"match nesting alternatives", "match {nes{ted,ting},flat} alternatives", true},
+		{"match flat alternatives", "match {nes{ted,ting},flat} alternatives", true},
+		{"do not match nest alternatives", "do not match {nes{ted,ting},flat} alternatives", false},
+		{"match a star before alternatives", "match * {alternatives,groups}", true},
+		{"match alternatives at the end", "match alternatives {at the end,*}", true},
+		{"match alternatives", "match alternatives{*,?}", true},
+		{"do not match alternatives", "do not match alternatives{ at the end,.}", false},
+		{"match a class } in alternatives", `match a {class [,}],escape \,\}} in alternatives`, true},
+		{"match a escape ,} in alternatives", `match a {class [,}],escape \,\}} in alternatives`, true},
+		{"match a , and a } outside of alternatives", "match a , and a } outside of alternatives", true},
+		{"do not match an unclosed group", "do not match an {unclosed,group", false},
+		{"do not match an unclosed group", "do not match an {unclosed,group,*", false},
+		{"match a literal {a,b}", `match a literal \{a,b}`, true},
+
 		{"A big brown fox jumps over the lazy dog, with all there wildcards friends", ". big?brown fox jumps over * wildcard. friend??", true},
 		{"A big brown fox fails to jump over the lazy dog, with all there wildcards friends", ". big?brown fox jumps over * wildcard. friend??", false},
+
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "*a*a*a*a*a*a*a*a*a*a*b", false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "?a?a?a?a?a?a?a?a?a?a?a?a?a?a?a?ab", false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}b", false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}{a,aa}", true},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}{*,a}b", false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "{{*,a}{a,*}}{{*,a}{a,*}}{{*,a}{a,*}}{{*,a}{a,*}}{{*,a}{a,*}}{{*,a}{a,*}}{{*,a}{a,*}}{{*,a}{a,*}}", true},
+	}
+
+	for i, c := range cases {
+		result := __FUNC_NAME__(c.pattern, c.s, -1, false)
+		if c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+// TestMatchPath validates that, with a separator, the wildcards
+// and bracket expressions never match it
+func TestMatchPath(t *testing.T) {
+	cases := []struct {
+		s       __ARG_TYPE__
+		pattern __ARG_TYPE__
+		result  bool
+	}{
+		{"/", "/", true},
+		{"/", "*", false},
+		{"/", ".", false},
+		{"/", "?", false},
+		{"/", "?/", true},
+		{"/", "/?", true},
+		{"/", "[/]", false},
+		{"/", "[!a]", false},
+		{"a/b", "a/b", true},
+		{"a/b", "*", false},
+		{"a/b", "*/*", true},
+		{"a/b", "a*b", false},
+		{"a/b", "a.b", false},
+		{"a/b", "a?b", false},
+		{"a/b", "a?/b", true},
+		{"a/b", "a[!x]b", false},
+		{"a/b", "a{/,x}b", true},
+		{"a/b", "a{*,x}b", false},
+		{"src/pkg/main.go", "src/*/*.go", true},
+		{"src/pkg/main.go", "src/*.go", false},
+		{"src/pkg/main.go", "src/*", false},
+		{"src/pkg/sub/main.go", "src/*/*.go", false},
+		{"src/pkg/sub/main.go", "src/*/*/*.go", true},
+		{"src/pkg/main.go", "*/*/m??n.go", true},
+		{"src/pkg/main.go", "*/*/m.in.go", true},
+	}
+
+	for i, c := range cases {
+		result := __FUNC_NAME__(c.pattern, c.s, '/', false)
+		if c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+}
+
+// TestMatchGlobstar validates that, with a separator, a "**" path segment
+// matches zero or more segments, and that it is a simple '*' otherwise
+func TestMatchGlobstar(t *testing.T) {
+	cases := []struct {
+		s       __ARG_TYPE__
+		pattern __ARG_TYPE__
+		result  bool
+	}{
+		{"", "**", true},
+		{"a", "**", true},
+		{"a/b/c", "**", true},
+		{"/", "**", true},
+		{"a/b", "a/**", true},
+		{"a/", "a/**", true},
+		{"a/b/c", "a/**", true},
+		{"a", "a/**", false},
+		{"b/c", "a/**", false},
+		{"a", "**/a", true},
+		{"b/a", "**/a", true},
+		{"c/b/a", "**/a", true},
+		{"c/b/ba", "**/a", false},
+		{"/a", "**/a", true},
+		{"a/b", "a/**/b", true},
+		{"a/x/b", "a/**/b", true},
+		{"a/x/y/b", "a/**/b", true},
+		{"a/xb", "a/**/b", false},
+		{"a//b", "a/**/b", true},
+		{"a/", "a/**/", true},
+		{"a/b/", "a/**/", true},
+		{"src/test_main.go", "src/**/test_*.go", true},
+		{"src/a/b/c/test_main.go", "src/**/test_*.go", true},
+		{"src/a/b/c/test_main.go/x", "src/**/test_*.go", false},
+		{"src/a/b/c/main_test.go", "src/**/test_*.go", false},
+		{"x/src/a/b/test_main.go", "**/src/**/test_?*.go", true},
+		{"src/main.go", "src/**/*.go", true},
+		{"src/wildcard/match.go", "src/**/*.go", true},
+		{"src/wildcard/match.go/x", "src/**/*.go", false},
+		{"a/b/c.go", "**/*.go", true},
+		{"a/b/x/c/d", "**/x/**/d", true},
+		{"a/b/x/c/d", "**/x/*/d", true},
+		{"a/b/x/c/e/d", "**/x/*/d", false},
+		{"a/x/b/x/c/d", "**/x/*/d", true},
+		{"a/b/c/d", "a/**/c/**", true},
+		{"a/b/c/d", "a/**/d/**", false},
+		{"a/b/c", "a/**b/c", true},
+		{"a/xb/c", "a/**b/c", true},
+		{"a/x/b/c", "a/**b/c", false},
+		{"a/b/c", "a/b**/c", true},
+		{"a/b/c", "a/***/c", true},
+		{"a/b/x/c", "a/***/c", false},
+		{"a/b/c", "{a,x}/**/c", true},
 	}
 
 	for i, c := range cases {
-		result := __FUNC_NAME__(c.pattern, c.s)
+		result := __FUNC_NAME__(c.pattern, c.s, '/', false)
+		if c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+
+	// Without separator, "**" is a simple '*'
+	if !__FUNC_NAME__("a/**/b", "a/x/b", -1, false) || __FUNC_NAME__("a/**/b", "a/b", -1, false) {
+		t.Errorf("Expected `**` to be a simple `*` without separator")
+	}
+}
+
+// TestMatchFold validates the case-insensitive matching,
+// which only fold ASCII letters with byte comparison
+func TestMatchFold(t *testing.T) {
+	cases := []struct {
+		s       __ARG_TYPE__
+		pattern __ARG_TYPE__
+		result  bool
+	}{
+		{"", "", true},
+		{"a", "A", true},
+		{"A", "a", true},
+		{"@", "`", false},
+		{"[", "{", false},
+		{"Match The Exact String WITH DIFFERENT CASE", "match the exact string with different case", true},
+		{"Do Not Match The Exact String With Different Case", "do not match the exact string with different case", true},
+		{"do not match a different string WITH DIFFERENT CASE", "this is a different string with different case", false},
+		{"Content-Type", "content-*", true},
+		{"CONTENT-LENGTH", "content-?ength", true},
+		{"API.Example.COM", `api\.example\.com`, true},
+		{"API.Example.COM", "*.EXAMPLE.*", true},
+		{"HOST7", "host[0-9]", true},
+		{"HOSTa", "HOST[A-F]", true},
+		{"HOSTa", "HOST[!A-F]", false},
+		{"HOSTg", "HOST[!A-F]", true},
+		{"HOSTa", "HOST[[:upper:]]", true},
+		{"X-FORWARDED-FOR", "x-{forwarded,real}-*", true},
+		{"É", "é", false},
+		{"KELVIN", "kelvin", true},
+	}
+
+	for i, c := range cases {
+		result := __FUNC_NAME__(c.pattern, c.s, -1, true)
 		if c.result != result {
 			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
 		}
@@ -91,8 +310,33 @@ func TestMatch(t *testing.T) {
 
 func FuzzMatch(f *testing.F) {
 	f.Fuzz(func(t *testing.T, s string) {
-		if !__FUNC_NAME__(__ARG_TYPE__(s), __ARG_TYPE__(s)) {
+		// An escape, a bracket expression or a group doesn't match itself.
+		if strings.ContainsAny(s, `\[{`) {
+			return
+		}
+		if !__FUNC_NAME__(__ARG_TYPE__(s), __ARG_TYPE__(s), -1, false) {
 			t.Fatalf("%s does not match %s", s, s)
 		}
 	})
 }
+
+// FuzzMatchStates validates that the greedy matching agrees with the simulation
+// of all the ways to match the pattern.
+func FuzzMatchStates(f *testing.F) {
+	f.Add("*you?re*", "These aren't the wildcard you're looking for", false)
+	f.Add("a/*?b?/*c", "a/xbb/c", true)
+	f.Add("*a?b*?", "aab", false)
+	f.Fuzz(func(t *testing.T, pattern, s string, path bool) {
+		separator := -1
+		if path {
+			separator = '/'
+		}
+
+		for _, fold := range []bool{false, true} {
+			expected := __FUNC_NAME__States(__ARG_TYPE__(pattern), __ARG_TYPE__(s), 0, 0, separator, fold)
+			if result := __FUNC_NAME__(__ARG_TYPE__(pattern), __ARG_TYPE__(s), separator, fold); result != expected {
+				t.Fatalf("Expected `%v` with fold `%v`, found `%v`; With Pattern: `%s` and String: `%s`", expected, fold, result, pattern, s)
+			}
+		}
+	})
+}
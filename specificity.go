@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/IGLOU-EU/go-wildcard/v2/syntax"
+)
+
+// Compare orders the patterns by specificity, returning -1 if a is more specific than b,
+// +1 if it is less specific, and 0 only if they are equal.
+//
+// The most specific pattern has the most literal bytes, then the fewest wildcards,
+// then the fewest wildcards of the least specific kinds, from '*' to '?', '.' and
+// bracket expressions, like "api/v?/users" before "api/*" before "*".
+// A group counts as its least specific alternative, and malformed patterns come last.
+// The patterns equally specific are ordered like strings, so the order is total.
+func Compare(a, b string) int {
+	if c := measure(a).compare(measure(b)); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a, b)
+}
+
+// SortBySpecificity sorts the patterns from the most specific to the least specific, see Compare.
+func SortBySpecificity(patterns []string) {
+	measures := make(map[string]specificity, len(patterns))
+	for _, pattern := range patterns {
+		measures[pattern] = measure(pattern)
+	}
+
+	sort.Slice(patterns, func(i, j int) bool {
+		if c := measures[patterns[i]].compare(measures[patterns[j]]); c != 0 {
+			return c < 0
+		}
+		return patterns[i] < patterns[j]
+	})
+}
+
+// specificity counts what a pattern requires to match.
+type specificity struct {
+	malformed bool
+	literal   int
+
+	stars, erotemes, dots, classes int
+}
+
+// measure returns the specificity of the pattern.
+// Its bracket expressions are read by byte like Compile, and not by rune like
+// syntax.Parse, so each one is replaced with "[x]" before parsing the rest.
+func measure(pattern string) specificity {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 < len(pattern) {
+				b.WriteByte(pattern[i])
+				i++
+			}
+		case '[':
+			end, err := validateClass(pattern, i)
+			if err != nil {
+				return specificity{malformed: true}
+			}
+			b.WriteString("[x]")
+			i = end
+			continue
+		}
+		b.WriteByte(pattern[i])
+	}
+
+	root, err := syntax.Parse(b.String())
+	if err != nil {
+		return specificity{malformed: true}
+	}
+
+	return measureNode(root)
+}
+
+func measureNode(n *syntax.Node) specificity {
+	var m specificity
+	switch n.Op {
+	case syntax.OpLiteral:
+		m.literal = len(n.Text)
+	case syntax.OpStar:
+		m.stars = 1
+	case syntax.OpEroteme:
+		m.erotemes = 1
+	case syntax.OpDot:
+		m.dots = 1
+	case syntax.OpClass:
+		m.classes = 1
+	case syntax.OpConcat:
+		for _, sub := range n.Subs {
+			m = m.add(measureNode(sub))
+		}
+	case syntax.OpGroup:
+		for i, sub := range n.Subs {
+			if alternative := measureNode(sub); i == 0 || alternative.compare(m) > 0 {
+				m = alternative
+			}
+		}
+	}
+
+	return m
+}
+
+func (m specificity) add(o specificity) specificity {
+	m.literal += o.literal
+	m.stars += o.stars
+	m.erotemes += o.erotemes
+	m.dots += o.dots
+	m.classes += o.classes
+
+	return m
+}
+
+func (m specificity) wildcards() int {
+	return m.stars + m.erotemes + m.dots + m.classes
+}
+
+// compare returns -1 if m is more specific than o, +1 if it is less specific, or 0.
+func (m specificity) compare(o specificity) int {
+	if m.malformed || o.malformed {
+		return compareInts(boolInt(m.malformed), boolInt(o.malformed))
+	}
+
+	for _, c := range [...]int{
+		compareInts(o.literal, m.literal),
+		compareInts(m.wildcards(), o.wildcards()),
+		compareInts(m.stars, o.stars),
+		compareInts(m.erotemes, o.erotemes),
+		compareInts(m.dots, o.dots),
+	} {
+		if c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCompare validates the order of specificity, from the most specific pattern
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		result int
+	}{
+		{"abc", "abc", 0},
+		{"abc", "ab", -1},
+		{"api/v?/users", "api/*", -1},
+		{"api/*", "*", -1},
+		{"a.c", "a*c", -1},
+		{"a.c", "a?c", -1},
+		{"a?c", "a*c", -1},
+		{"a[bc]d", "a.d", -1},
+		{"a*b*", "a*", -1},
+		{"ab*", "a*b", 1},
+		{"{abc,de}*", "abc*", 1},
+		{"{abc,de}*", "de*", 1},
+		{"a*", "[a", -1},
+		{"[a", "[b", -1},
+		{`a\*`, "a*", -1},
+		{"[é-à]", "*", -1},
+		{"[é-à]", "[a", -1},
+	}
+
+	for i, c := range cases {
+		if result := Compare(c.a, c.b); result != c.result {
+			t.Errorf("Test %d: Expected `%d`, found `%d`; With Patterns: `%s` and `%s`", i+1, c.result, result, c.a, c.b)
+		}
+		if result := Compare(c.b, c.a); result != -c.result {
+			t.Errorf("Test %d: Expected `%d`, found `%d`; With Patterns: `%s` and `%s`", i+1, -c.result, result, c.b, c.a)
+		}
+	}
+}
+
+func TestSortBySpecificity(t *testing.T) {
+	patterns := []string{"*", "api/v?/users", "[", "api/*", "api/v1/users", "*/users", "api/v1/*"}
+	SortBySpecificity(patterns)
+
+	expected := []string{"api/v1/users", "api/v?/users", "api/v1/*", "*/users", "api/*", "*", "["}
+	if !reflect.DeepEqual(patterns, expected) {
+		t.Errorf("Expected `%v`, found `%v`", expected, patterns)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+// MatchSubmatch returns true if the pattern matches the string s, and the
+// substrings of s matched by every '*', '?' and '.' of the pattern, in pattern order.
+//
+// When there is more than one way to match, the wildcards are leftmost-shortest:
+// from left to right, each one matches the shortest substring allowing the rest
+// of the pattern to match. So "*-*" splits "a-b-c" into "a" and "b-c",
+// and a '?' matches the empty string when it can.
+// The wildcards of a group alternative which is not used match an empty string.
+// It uses byte comparison, like Match.
+func MatchSubmatch(pattern, s string) ([]string, bool) {
+	index, ok := MatchSubmatchIndex(pattern, s)
+	if !ok {
+		return nil, false
+	}
+
+	captures := make([]string, len(index)/2)
+	for i := range captures {
+		if index[2*i] >= 0 {
+			captures[i] = s[index[2*i]:index[2*i+1]]
+		}
+	}
+
+	return captures, true
+}
+
+// MatchSubmatchIndex is like MatchSubmatch, but returns the byte offsets
+// of the substrings: the i-th wildcard matched s[index[2*i]:index[2*i+1]].
+// The offsets of the wildcards of a group alternative which is not used are -1.
+func MatchSubmatchIndex(pattern, s string) ([]int, bool) {
+	if !Match(pattern, s) {
+		return nil, false
+	}
+
+	p := compileProgram(pattern, -1, false)
+	index := make([]int, 2*p.captures)
+	for i := range index {
+		index[i] = -1
+	}
+	if !p.match(s, index) {
+		return nil, false
+	}
+
+	return index, true
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestMatchSubmatch validates the substrings matched by the wildcards,
+// under the leftmost-shortest policy
+func TestMatchSubmatch(t *testing.T) {
+	cases := []struct {
+		s        string
+		pattern  string
+		captures []string
+		result   bool
+	}{
+		{"", "", []string{}, true},
+		{"", "*", []string{""}, true},
+		{"", "?", []string{""}, true},
+		{"", ".", nil, false},
+		{"abc", "abc", []string{}, true},
+		{"abc", "abd", nil, false},
+		{"tenants/acme/buckets/logs", "tenants/*/buckets/*", []string{"acme", "logs"}, true},
+		{"a-b-c", "*-*", []string{"a", "b-c"}, true},
+		{"a.b.c", "*.*", []string{"", "a", ".b.c"}, true},
+		{"a.b.c", `*\.*`, []string{"a", "b.c"}, true},
+		{"baab", "*a*", []string{"b", "ab"}, true},
+		{"abc", "a?c", []string{"b"}, true},
+		{"abc", "a?bc", []string{""}, true},
+		{"abc", "a**", []string{"", "bc"}, true},
+		{"file-1.txt", "file-?.txt", []string{"1", "."}, true},
+		{"file-1.txt", `file-.\.txt`, []string{"1"}, true},
+		{"1abc", "[0-9]*", []string{"abc"}, true},
+		{"bx", "{a*,b?}", []string{"", "x"}, true},
+		{"ax", "{a*,b?}", []string{"x", ""}, true},
+		{"a*b", `a\*b`, []string{}, true},
+		{"T🥵🤷🏾‍♂️🥓", "T*🤷🏾‍♂️*", []string{"🥵", "🥓"}, true},
+	}
+
+	for i, c := range cases {
+		captures, result := MatchSubmatch(c.pattern, c.s)
+		if c.result != result || !reflect.DeepEqual(c.captures, captures) {
+			t.Errorf("Test %d: Expected `%q` `%v`, found `%q` `%v`; With Pattern: `%s` and String: `%s`", i+1, c.captures, c.result, captures, result, c.pattern, c.s)
+		}
+	}
+}
+
+func TestMatchSubmatchIndex(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		index   []int
+	}{
+		{"a-b-c", "*-*", []int{0, 1, 2, 5}},
+		{"a.b.c", "*.*", []int{0, 0, 0, 1, 1, 5}},
+		{"bx", "{a*,b?}", []int{-1, -1, 1, 2}},
+		{"logs/app-12.log", "logs/{app,worker}-*.log", []int{9, 11, 11, 12}},
+		{strings.Repeat("a-", 100000) + "b", "*-?b", []int{0, 199999, 200000, 200000}},
+	}
+
+	for i, c := range cases {
+		index, ok := MatchSubmatchIndex(c.pattern, c.s)
+		if !ok || !reflect.DeepEqual(c.index, index) {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.index, index, c.pattern, c.s)
+		}
+	}
+}
+
+func FuzzMatchSubmatch(f *testing.F) {
+	f.Add("a*b?c.", "aXbc!")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		index, ok := MatchSubmatchIndex(pattern, s)
+		if ok != Match(pattern, s) {
+			t.Fatalf("MatchSubmatchIndex(%q, %q) differs from Match", pattern, s)
+		}
+
+		for i := 0; i < len(index); i += 2 {
+			if index[i] == -1 && index[i+1] == -1 {
+				continue
+			}
+			if index[i] < 0 || index[i] > index[i+1] || index[i+1] > len(s) {
+				t.Fatalf("MatchSubmatchIndex(%q, %q) returned invalid offsets %v", pattern, s, index)
+			}
+		}
+
+		// The simulation used above maxVisited finds the same captures as the backtracking.
+		p := compileProgram(pattern, -1, false)
+		backtrack, simulate := make([]int, 2*p.captures), make([]int, 2*p.captures)
+		for i := range backtrack {
+			backtrack[i], simulate[i] = -1, -1
+		}
+		if p.match(s, backtrack) != p.simulate(s, simulate) || !reflect.DeepEqual(backtrack, simulate) {
+			t.Fatalf("simulate(%q, %q) returned %v, expected %v", pattern, s, simulate, backtrack)
+		}
+	})
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Subsumes returns true if every string matched by the pattern b is matched by the pattern a,
+// like "logs/*" subsumes "logs/app-*". It uses byte comparison, like Match.
+func Subsumes(a, b string) bool {
+	return MatchOptions{}.Subsumes(a, b)
+}
+
+// Intersects returns true if a string is matched by both patterns,
+// like "logs/app-*" and `*\.log`. It uses byte comparison, like Match.
+func Intersects(a, b string) bool {
+	return MatchOptions{}.Intersects(a, b)
+}
+
+// IntersectsWitness is like Intersects, and also returns one of the shortest strings
+// matched by both patterns, like "logs/app-.log" for "logs/app-*" and `*\.log`.
+func IntersectsWitness(a, b string) (string, bool) {
+	return MatchOptions{}.IntersectsWitness(a, b)
+}
+
+// Subsumes returns true if every string matched by the pattern b is matched by the pattern a,
+// using byte comparison.
+//
+// It is decided exactly on the state sets of the matching algorithm, looking for
+// a string matched by b and not by a. The time needed can grow exponentially
+// with the wildcards of a, which is unlikely for real patterns.
+func (o MatchOptions) Subsumes(a, b string) bool {
+	_, found := counterexample(newNFA(a, o), newNFA(b, o))
+	return !found
+}
+
+// Intersects returns true if a string is matched by both patterns, using byte comparison.
+// It runs in polynomial time, exploring the pairs of states of the patterns.
+func (o MatchOptions) Intersects(a, b string) bool {
+	_, found := o.IntersectsWitness(a, b)
+	return found
+}
+
+// IntersectsWitness is like Intersects, and also returns one of the shortest strings
+// matched by both patterns. Its characters are chosen among the letters and digits
+// when possible.
+func (o MatchOptions) IntersectsWitness(a, b string) (string, bool) {
+	return intersection(newNFA(a, o), newNFA(b, o))
+}
+
+// nfa walks the states of the matching algorithm of a pattern, see matchByStringStates.
+type nfa struct {
+	pattern   string
+	links     []int32
+	separator int
+	fold      bool
+	words     int
+}
+
+func newNFA(pattern string, o MatchOptions) *nfa {
+	m := &nfa{
+		pattern:   pattern,
+		separator: o.separator(),
+		fold:      o.Fold,
+		words:     len(pattern)/64 + 1,
+	}
+	if strings.IndexByte(pattern, '{') != -1 {
+		m.links = matchByStringLinks(pattern, nil)
+	}
+
+	return m
+}
+
+// start returns the states before reading a character.
+func (m *nfa) start() []uint64 {
+	return m.closure(0)
+}
+
+// closure returns the state i and the states it reaches without a character.
+func (m *nfa) closure(i int) []uint64 {
+	states := make([]uint64, m.words)
+	states[i/64] = 1 << (i % 64)
+	matchByStringFollow(m.pattern, states, m.links, m.separator)
+
+	return states
+}
+
+// step returns the state following the state i with c, or -1 if there is none.
+func (m *nfa) step(i int, c byte) int {
+	return matchByStringStep(m.pattern, i, c, m.links, m.separator, m.fold)
+}
+
+// next returns the states following the states with c, and their closure.
+func (m *nfa) next(states []uint64, c byte) []uint64 {
+	next := make([]uint64, m.words)
+	forEachState(states, func(i int) {
+		if to := m.step(i, c); to != -1 {
+			next[to/64] |= 1 << (to % 64)
+		}
+	})
+	matchByStringFollow(m.pattern, next, m.links, m.separator)
+
+	return next
+}
+
+// accepts reports whether the states contain the end of the pattern.
+func (m *nfa) accepts(states []uint64) bool {
+	end := len(m.pattern)
+	return states[end/64]&(1<<(end%64)) != 0
+}
+
+func forEachState(states []uint64, f func(i int)) {
+	for w, word := range states {
+		for ; word != 0; word &= word - 1 {
+			f(w*64 + bits.TrailingZeros64(word))
+		}
+	}
+}
+
+// alphabet returns one character for each set of characters that no state of
+// the automata can distinguish, preferring letters, digits and printable ASCII.
+func alphabet(automata ...*nfa) []byte {
+	var order []byte
+	var listed [256]bool
+	for _, r := range [][2]int{{'a', 'z'}, {'A', 'Z'}, {'0', '9'}, {' ', '~'}, {0, 255}} {
+		for c := r[0]; c <= r[1]; c++ {
+			if !listed[c] {
+				listed[c] = true
+				order = append(order, byte(c))
+			}
+		}
+	}
+
+	// The signature of a character is the state following each state with it.
+	var chars []byte
+	var signatures []int
+	size := 0
+	for _, m := range automata {
+		size += len(m.pattern) + 1
+	}
+	signature := make([]int, 0, size)
+	for _, c := range order {
+		signature = signature[:0]
+		for _, m := range automata {
+			for i := 0; i <= len(m.pattern); i++ {
+				signature = append(signature, m.step(i, c))
+			}
+		}
+
+		known := false
+		for k := 0; k < len(chars) && !known; k++ {
+			known = equalInts(signatures[k*size:(k+1)*size], signature)
+		}
+		if !known {
+			chars = append(chars, c)
+			signatures = append(signatures, signature...)
+		}
+	}
+
+	return chars
+}
+
+func equalInts(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// intersection returns one of the shortest strings matched by both automata,
+// searched breadth first over the pairs of their states.
+func intersection(a, b *nfa) (string, bool) {
+	chars := alphabet(a, b)
+	closuresA := make([][]int, len(a.pattern)+1)
+	closuresB := make([][]int, len(b.pattern)+1)
+	closure := func(m *nfa, closures [][]int, i int) []int {
+		if closures[i] == nil {
+			forEachState(m.closure(i), func(j int) { closures[i] = append(closures[i], j) })
+		}
+		return closures[i]
+	}
+
+	// The pair (i, j) is the index i*width+j, its parent is -1 for the start.
+	width := len(b.pattern) + 1
+	parents := make([]int, len(closuresA)*width)
+	via := make([]byte, len(parents))
+	for i := range parents {
+		parents[i] = -2
+	}
+
+	var queue []int
+	visit := func(i, j, parent int, c byte) {
+		for _, ci := range closure(a, closuresA, i) {
+			for _, cj := range closure(b, closuresB, j) {
+				if pair := ci*width + cj; parents[pair] == -2 {
+					parents[pair], via[pair] = parent, c
+					queue = append(queue, pair)
+				}
+			}
+		}
+	}
+
+	end := len(a.pattern)*width + len(b.pattern)
+	for visit(0, 0, -1, 0); len(queue) > 0; queue = queue[1:] {
+		pair := queue[0]
+		if pair == end {
+			var witness []byte
+			for ; parents[pair] != -1; pair = parents[pair] {
+				witness = append(witness, via[pair])
+			}
+			return reversed(witness), true
+		}
+
+		for _, c := range chars {
+			i, j := a.step(pair/width, c), b.step(pair%width, c)
+			if i != -1 && j != -1 {
+				visit(i, j, pair, c)
+			}
+		}
+	}
+
+	return "", false
+}
+
+// counterexample returns one of the shortest strings matched by b and not by a,
+// searched breadth first over the states of b paired with the state sets of a.
+func counterexample(a, b *nfa) (string, bool) {
+	type node struct {
+		state  int
+		states []uint64
+		parent int
+		c      byte
+	}
+
+	chars := alphabet(a, b)
+	var nodes []node
+	seen := make(map[string]bool)
+	key := make([]byte, 0, 8*(a.words+1))
+	visit := func(i int, states []uint64, parent int, c byte) {
+		forEachState(b.closure(i), func(j int) {
+			key = append(key[:0], byte(j), byte(j>>8), byte(j>>16), byte(j>>24))
+			for _, word := range states {
+				for s := 0; s < 64; s += 8 {
+					key = append(key, byte(word>>s))
+				}
+			}
+			if !seen[string(key)] {
+				seen[string(key)] = true
+				nodes = append(nodes, node{j, states, parent, c})
+			}
+		})
+	}
+
+	// The nodes are appended in breadth first order.
+	visit(0, a.start(), -1, 0)
+	for n := 0; n < len(nodes); n++ {
+		current := nodes[n]
+		if current.state == len(b.pattern) && !a.accepts(current.states) {
+			var witness []byte
+			for ; current.parent != -1; current = nodes[current.parent] {
+				witness = append(witness, current.c)
+			}
+			return reversed(witness), true
+		}
+
+		for _, c := range chars {
+			if j := b.step(current.state, c); j != -1 {
+				visit(j, a.next(current.states, c), n, c)
+			}
+		}
+	}
+
+	return "", false
+}
+
+// reversed returns the characters of a path read from its end.
+func reversed(path []byte) string {
+	for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+		path[l], path[r] = path[r], path[l]
+	}
+
+	return string(path)
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import "testing"
+
+// TestSubsumes validates the inclusion and the overlap of the patterns
+func TestSubsumes(t *testing.T) {
+	cases := []struct {
+		a, b       string
+		subsumes   bool
+		intersects bool
+	}{
+		{"", "", true, true},
+		{"*", "", true, true},
+		{"", "*", false, true},
+		{"logs/*", "logs/app-*", true, true},
+		{"logs/app-*", "logs/*", false, true},
+		{"logs/app-*", `*\.log`, false, true},
+		{"logs/app-*", "logs/worker-*", false, false},
+		{"*", "any{thing,[0-9]?}", true, true},
+		{"a?", "a", true, true},
+		{"a?", "a.", true, true},
+		{"a.", "a?", false, true},
+		{"a*", "a.*", true, true},
+		{"[a-z]*", "[!a-z]*", false, false},
+		{"[[:alpha:]]", "[a-f]", true, true},
+		{"{a,b}c", "[ab]c", true, true},
+		{"[ab]c", "{a,b,ab}c", false, true},
+		{"*a*", "*b*", false, true},
+		{"x*", "*y", false, true},
+		{`\*`, "?", false, true},
+		{"a{b,", "a{b,", true, false},
+	}
+
+	for i, c := range cases {
+		if result := Subsumes(c.a, c.b); result != c.subsumes {
+			t.Errorf("Test %d: Expected Subsumes `%v`, found `%v`; With Patterns: `%s` and `%s`", i+1, c.subsumes, result, c.a, c.b)
+		}
+		if result := Intersects(c.a, c.b); result != c.intersects {
+			t.Errorf("Test %d: Expected Intersects `%v`, found `%v`; With Patterns: `%s` and `%s`", i+1, c.intersects, result, c.a, c.b)
+		}
+	}
+}
+
+func TestIntersectsWitness(t *testing.T) {
+	if witness, ok := IntersectsWitness("logs/app-*", `*\.log`); !ok || witness != "logs/app-.log" {
+		t.Errorf("Expected `logs/app-.log`, found `%s`", witness)
+	}
+	if witness, ok := IntersectsWitness("*[0-9]?", "v.*"); !ok || witness != "v0" {
+		t.Errorf("Expected `v0`, found `%s`", witness)
+	}
+}
+
+func TestSubsumesOptions(t *testing.T) {
+	path := MatchOptions{PathSeparator: '/'}
+	if path.Subsumes("logs/*", "logs/**") || !path.Subsumes("logs/**", "logs/*/*.log") {
+		t.Error("Expected '*' not to match the separator, unlike a globstar")
+	}
+	if witness, ok := path.IntersectsWitness("**/b", "a/*"); !ok || witness != "a/b" {
+		t.Errorf("Expected `a/b`, found `%s`", witness)
+	}
+
+	fold := MatchOptions{Fold: true}
+	if !fold.Subsumes("abc*", "ABC") || Subsumes("abc*", "ABC") {
+		t.Error("Expected the case to be ignored only with Fold")
+	}
+}
+
+// TestSubsumesStrings compares the results with the strings of up to 4 characters,
+// on every pair of patterns of up to 3 characters over a small alphabet.
+func TestSubsumesStrings(t *testing.T) {
+	patterns := words("a*?{,}", 3)
+	inputs := words("ab", 4)
+
+	for _, a := range patterns {
+		for _, b := range patterns {
+			checkSubsumes(t, a, b, inputs)
+		}
+	}
+}
+
+func FuzzSubsumes(f *testing.F) {
+	f.Add("a*[b-d]{x,y?}", "a.c*")
+	f.Fuzz(func(t *testing.T, a, b string) {
+		if len(a) > 12 || len(b) > 12 {
+			return
+		}
+		checkSubsumes(t, a, b, words("ab{c", 4))
+	})
+}
+
+// checkSubsumes validates the witnesses, and that none of the inputs contradicts the results.
+func checkSubsumes(t *testing.T, a, b string, inputs []string) {
+	t.Helper()
+
+	witness, intersects := IntersectsWitness(a, b)
+	if intersects && (!Match(a, witness) || !Match(b, witness)) {
+		t.Fatalf("Expected `%s` and `%s` to match the witness `%s`", a, b, witness)
+	}
+	s, found := counterexample(newNFA(a, MatchOptions{}), newNFA(b, MatchOptions{}))
+	if found && (Match(a, s) || !Match(b, s)) {
+		t.Fatalf("Expected only `%s` and not `%s` to match the counterexample `%s`", b, a, s)
+	}
+
+	for _, s := range inputs {
+		if !intersects && Match(a, s) && Match(b, s) {
+			t.Fatalf("Expected no intersection of `%s` and `%s`, found `%s`", a, b, s)
+		}
+		if !found && !Match(a, s) && Match(b, s) {
+			t.Fatalf("Expected `%s` to subsume `%s`, found `%s`", a, b, s)
+		}
+	}
+}
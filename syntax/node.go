@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package syntax
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Op is the kind of a Node.
+type Op uint8
+
+const (
+	OpConcat  Op = iota + 1 // matches Subs in sequence
+	OpLiteral               // matches Text
+	OpStar                  // '*', matches zero or more characters
+	OpEroteme               // '?', matches zero or one character
+	OpDot                   // '.', matches exactly one character
+	OpClass                 // '[...]', matches one character of Items, or not in Items if Negate
+	OpGroup                 // '{...}', matches one of Subs, which are OpConcat
+)
+
+// String returns the name of the operation.
+func (op Op) String() string {
+	switch op {
+	case OpConcat:
+		return "Concat"
+	case OpLiteral:
+		return "Literal"
+	case OpStar:
+		return "Star"
+	case OpEroteme:
+		return "Eroteme"
+	case OpDot:
+		return "Dot"
+	case OpClass:
+		return "Class"
+	case OpGroup:
+		return "Group"
+	}
+
+	return "Op(" + strconv.Itoa(int(op)) + ")"
+}
+
+// Node is a node of the syntax tree of a pattern.
+type Node struct {
+	Op Op
+
+	// Text is the unescaped text of an OpLiteral.
+	Text string
+
+	// Negate and Items describe an OpClass.
+	Negate bool
+	Items  []ClassItem
+
+	// Subs holds the nodes of an OpConcat, or the alternatives of an OpGroup.
+	Subs []*Node
+}
+
+// ClassItem is a member of a bracket expression: the characters from Lo to Hi,
+// or the POSIX class called Name, like "alpha", when it is set.
+type ClassItem struct {
+	Lo, Hi rune
+	Name   string
+}
+
+// String returns the canonical text of the pattern of the node.
+// Parsing it gives back the same tree. The characters are only escaped
+// where they would have a special meaning.
+func (n *Node) String() string {
+	var b strings.Builder
+	n.write(&b, 0)
+
+	return b.String()
+}
+
+// write writes the node to b, where depth is the number of groups it is inside of.
+func (n *Node) write(b *strings.Builder, depth int) {
+	switch n.Op {
+	case OpConcat:
+		for _, sub := range n.Subs {
+			sub.write(b, depth)
+		}
+	case OpLiteral:
+		for i := 0; i < len(n.Text); i++ {
+			switch c := n.Text[i]; {
+			case c == '*' || c == '?' || c == '.' || c == '\\' || c == '[' || c == '{':
+				b.WriteByte('\\')
+			case depth > 0 && (c == ',' || c == '}'):
+				b.WriteByte('\\')
+			}
+			b.WriteByte(n.Text[i])
+		}
+	case OpStar:
+		b.WriteByte('*')
+	case OpEroteme:
+		b.WriteByte('?')
+	case OpDot:
+		b.WriteByte('.')
+	case OpClass:
+		b.WriteByte('[')
+		if n.Negate {
+			b.WriteByte('!')
+		}
+		for i, item := range n.Items {
+			switch {
+			case item.Name != "":
+				b.WriteString("[:" + item.Name + ":]")
+			case item.Lo == item.Hi:
+				writeClassChar(b, item.Lo, i == 0 && !n.Negate)
+			default:
+				writeClassChar(b, item.Lo, i == 0 && !n.Negate)
+				b.WriteByte('-')
+				writeClassChar(b, item.Hi, false)
+			}
+		}
+		b.WriteByte(']')
+	case OpGroup:
+		b.WriteByte('{')
+		for i, sub := range n.Subs {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			sub.write(b, depth+1)
+		}
+		b.WriteByte('}')
+	}
+}
+
+// writeClassChar writes a character of a bracket expression, escaped if it would
+// close it, start a range or a POSIX class, or negate it when it is the first one.
+func writeClassChar(b *strings.Builder, r rune, first bool) {
+	switch {
+	case r == ']' || r == '\\' || r == '-' || r == '[':
+		b.WriteByte('\\')
+	case first && (r == '!' || r == '^'):
+		b.WriteByte('\\')
+	}
+	b.WriteRune(r)
+}
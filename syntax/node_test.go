@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package syntax_test
+
+import (
+	"testing"
+
+	"github.com/IGLOU-EU/go-wildcard/v2"
+	"github.com/IGLOU-EU/go-wildcard/v2/syntax"
+)
+
+// TestNodeString validates the canonical text of the patterns
+func TestNodeString(t *testing.T) {
+	cases := []struct {
+		pattern   string
+		canonical string
+	}{
+		{"", ""},
+		{"a*b?c.", "a*b?c."},
+		{`\a\b`, "ab"},
+		{`a\*\?\.\\`, `a\*\?\.\\`},
+		{"a,b}", "a,b}"},
+		{`\,\}`, ",}"},
+		{`{\,,\}}`, `{\,,\}}`},
+		{"[^a-z]", "[!a-z]"},
+		{"[]a-]", `[\]a\-]`},
+		{`[\!!]`, `[\!!]`},
+		{`[!!]`, `[!!]`},
+		{"[[:alpha:]x]", "[[:alpha:]x]"},
+		{`[\[]`, `[\[]`},
+		{"{a,{b,c}}*", "{a,{b,c}}*"},
+	}
+
+	for i, c := range cases {
+		n, err := syntax.Parse(c.pattern)
+		if err != nil {
+			t.Errorf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+			continue
+		}
+		if canonical := n.String(); canonical != c.canonical {
+			t.Errorf("Test %d: Expected `%s`, found `%s`; With Pattern: `%s`", i+1, c.canonical, canonical, c.pattern)
+		}
+	}
+}
+
+func FuzzNodeString(f *testing.F) {
+	f.Add(`{a,[!\]-]*}\.?`, "b]x.")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		n, err := syntax.Parse(pattern)
+		if err != nil {
+			return
+		}
+
+		canonical := n.String()
+		again, err := syntax.Parse(canonical)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed on the canonical text of %q: %v", canonical, pattern, err)
+		}
+		if again.String() != canonical {
+			t.Fatalf("The canonical text of %q is not stable: %q then %q", pattern, canonical, again.String())
+		}
+		if wildcard.MatchByRune(canonical, s) != wildcard.MatchByRune(pattern, s) {
+			t.Fatalf("The canonical text %q of %q doesn't match %q the same", canonical, pattern, s)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+// Package syntax parses wildcard patterns into syntax trees,
+// to analyze them or print them back in a canonical form.
+package syntax
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// Error describes a mistake preventing to parse a pattern.
+type Error struct {
+	// Offset is the index of the byte where the mistake starts.
+	Offset int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return "wildcard/syntax: " + e.Msg + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// Parse parses the pattern into a syntax tree, whose root is an OpConcat.
+// It follows the matching of the wildcard package: outside of a group,
+// ',' and '}' are literals, and the members of a bracket expression are runes,
+// like with MatchByRune. Compile and Validate read them by byte, like Match,
+// so a pattern like "[é-à]" is well formed for them, but not for Parse.
+// It returns an *Error if the pattern ends with a lone escape,
+// contains a malformed bracket expression or an unclosed group.
+func Parse(pattern string) (*Node, error) {
+	p := parser{pattern: pattern}
+	root, err := p.concat(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+type parser struct {
+	pattern string
+	pos     int
+}
+
+// concat parses the nodes up to the end of the pattern, or up to the end of
+// the alternative when depth, the number of open groups, is not 0.
+func (p *parser) concat(depth int) (*Node, error) {
+	concat := &Node{Op: OpConcat}
+	var literal []byte
+	flush := func() {
+		if len(literal) > 0 {
+			concat.Subs = append(concat.Subs, &Node{Op: OpLiteral, Text: string(literal)})
+			literal = literal[:0]
+		}
+	}
+
+	for p.pos < len(p.pattern) {
+		var node *Node
+		switch c := p.pattern[p.pos]; {
+		case depth > 0 && (c == ',' || c == '}'):
+			flush()
+			return concat, nil
+		case c == '\\':
+			if p.pos+1 == len(p.pattern) {
+				return nil, &Error{Offset: p.pos, Msg: `'\' at the end of the pattern escapes nothing`}
+			}
+			literal = append(literal, p.pattern[p.pos+1])
+			p.pos += 2
+			continue
+		case c == '*':
+			node = &Node{Op: OpStar}
+			p.pos++
+		case c == '?':
+			node = &Node{Op: OpEroteme}
+			p.pos++
+		case c == '.':
+			node = &Node{Op: OpDot}
+			p.pos++
+		case c == '[':
+			var err error
+			if node, err = p.class(); err != nil {
+				return nil, err
+			}
+		case c == '{':
+			var err error
+			if node, err = p.group(depth); err != nil {
+				return nil, err
+			}
+		default:
+			literal = append(literal, c)
+			p.pos++
+			continue
+		}
+
+		flush()
+		concat.Subs = append(concat.Subs, node)
+	}
+	flush()
+
+	return concat, nil
+}
+
+// group parses the group starting at the current position.
+func (p *parser) group(depth int) (*Node, error) {
+	start := p.pos
+	group := &Node{Op: OpGroup}
+	for p.pos < len(p.pattern) && p.pattern[p.pos] != '}' {
+		p.pos++
+		alternative, err := p.concat(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		group.Subs = append(group.Subs, alternative)
+	}
+	if p.pos == len(p.pattern) {
+		return nil, &Error{Offset: start, Msg: "'{' is not closed by '}'"}
+	}
+	p.pos++
+
+	return group, nil
+}
+
+// class parses the bracket expression starting at the current position.
+func (p *parser) class() (*Node, error) {
+	start := p.pos
+	class := &Node{Op: OpClass}
+	i := start + 1
+	if i < len(p.pattern) && (p.pattern[i] == '!' || p.pattern[i] == '^') {
+		class.Negate = true
+		i++
+	}
+
+	// A ']' right after the opening bracket is a literal.
+	for first := true; i < len(p.pattern); first = false {
+		if p.pattern[i] == ']' && !first {
+			p.pos = i + 1
+			return class, nil
+		}
+
+		if p.pattern[i] == '[' && i+1 < len(p.pattern) && p.pattern[i+1] == ':' {
+			end := i + 2
+			for end+1 < len(p.pattern) && (p.pattern[end] != ':' || p.pattern[end+1] != ']') {
+				end++
+			}
+			if end+1 >= len(p.pattern) {
+				return nil, &Error{Offset: i, Msg: `"[:" is not closed by ":]"`}
+			}
+			if !namedClasses[p.pattern[i+2:end]] {
+				return nil, &Error{Offset: i, Msg: "unknown class " + strconv.Quote(p.pattern[i:end+2])}
+			}
+			class.Items = append(class.Items, ClassItem{Name: p.pattern[i+2 : end]})
+			i = end + 2
+			continue
+		}
+
+		lo, next, err := p.classChar(i)
+		if err != nil {
+			return nil, err
+		}
+		hi := lo
+		if next+1 < len(p.pattern) && p.pattern[next] == '-' && p.pattern[next+1] != ']' {
+			var end int
+			if hi, end, err = p.classChar(next + 1); err != nil {
+				return nil, err
+			}
+			if hi < lo {
+				return nil, &Error{Offset: i, Msg: "range " + strconv.Quote(p.pattern[i:end]) + " ends before it starts"}
+			}
+			next = end
+		}
+		class.Items = append(class.Items, ClassItem{Lo: lo, Hi: hi})
+		i = next
+	}
+
+	return nil, &Error{Offset: start, Msg: "'[' is not closed by ']'"}
+}
+
+// classChar returns the possibly escaped rune at pattern[i] and the index following it.
+func (p *parser) classChar(i int) (rune, int, error) {
+	if p.pattern[i] == '\\' {
+		if i+1 == len(p.pattern) {
+			return 0, -1, &Error{Offset: i, Msg: `'\' at the end of the pattern escapes nothing`}
+		}
+		i++
+	}
+	r, size := utf8.DecodeRuneInString(p.pattern[i:])
+
+	return r, i + size, nil
+}
+
+// namedClasses holds the names of the POSIX classes.
+var namedClasses = map[string]bool{
+	"alnum": true, "alpha": true, "blank": true, "cntrl": true,
+	"digit": true, "graph": true, "lower": true, "print": true,
+	"punct": true, "space": true, "upper": true, "xdigit": true,
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package syntax
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// dump returns a compact description of the tree, to compare it in tests.
+func dump(n *Node) string {
+	var b strings.Builder
+	b.WriteString(n.Op.String())
+	switch n.Op {
+	case OpLiteral:
+		fmt.Fprintf(&b, "%q", n.Text)
+	case OpClass:
+		b.WriteByte('{')
+		if n.Negate {
+			b.WriteByte('!')
+		}
+		for _, item := range n.Items {
+			if item.Name != "" {
+				fmt.Fprintf(&b, "%s;", item.Name)
+				continue
+			}
+			fmt.Fprintf(&b, "%q-%q;", item.Lo, item.Hi)
+		}
+		b.WriteByte('}')
+	case OpConcat, OpGroup:
+		b.WriteByte('{')
+		for i, sub := range n.Subs {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(dump(sub))
+		}
+		b.WriteByte('}')
+	}
+
+	return b.String()
+}
+
+// TestParse validates the tree of the patterns
+func TestParse(t *testing.T) {
+	cases := []struct {
+		pattern string
+		tree    string
+	}{
+		{"", "Concat{}"},
+		{"abc", `Concat{Literal"abc"}`},
+		{"a*b?c.", `Concat{Literal"a" Star Literal"b" Eroteme Literal"c" Dot}`},
+		{"**", "Concat{Star Star}"},
+		{`a\*b\\`, `Concat{Literal"a*b\\"}`},
+		{"a,b}", `Concat{Literal"a,b}"}`},
+		{"[a-z]", `Concat{Class{'a'-'z';}}`},
+		{"[!a-cx]", `Concat{Class{!'a'-'c';'x'-'x';}}`},
+		{"[^]]", `Concat{Class{!']'-']';}}`},
+		{"[]a-]", `Concat{Class{']'-']';'a'-'a';'-'-'-';}}`},
+		{`[\]\-]`, `Concat{Class{']'-']';'-'-'-';}}`},
+		{"[[:alpha:][:digit:]_]", `Concat{Class{alpha;digit;'_'-'_';}}`},
+		{"[à-ÿ]", `Concat{Class{'à'-'ÿ';}}`},
+		{"{a,b*}", `Concat{Group{Concat{Literal"a"} Concat{Literal"b" Star}}}`},
+		{"x{,}", `Concat{Literal"x" Group{Concat{} Concat{}}}`},
+		{"{}", `Concat{Group{Concat{}}}`},
+		{"{a,{b,c}d}", `Concat{Group{Concat{Literal"a"} Concat{Group{Concat{Literal"b"} Concat{Literal"c"}} Literal"d"}}}`},
+		{`{[,}],\,\}}`, `Concat{Group{Concat{Class{','-',';'}'-'}';}} Concat{Literal",}"}}}`},
+	}
+
+	for i, c := range cases {
+		n, err := Parse(c.pattern)
+		if err != nil {
+			t.Errorf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+			continue
+		}
+		if tree := dump(n); tree != c.tree {
+			t.Errorf("Test %d: Expected `%s`, found `%s`; With Pattern: `%s`", i+1, c.tree, tree, c.pattern)
+		}
+	}
+}
+
+// TestParseError validates the offset of the mistakes
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		pattern string
+		offset  int
+	}{
+		{`\`, 0},
+		{`a*\`, 2},
+		{"[", 0},
+		{"a[]", 1},
+		{"[!]", 0},
+		{`[a\]`, 0},
+		{`[a\`, 2},
+		{"[z-a]", 1},
+		{"[é-à]", 1},
+		{"[[:alfa:]]", 1},
+		{"[[:alpha]", 1},
+		{"{", 0},
+		{"x{a,b", 1},
+		{"a{b,{c}", 1},
+		{"{a,[b}", 3},
+	}
+
+	for i, c := range cases {
+		_, err := Parse(c.pattern)
+		syntaxErr, ok := err.(*Error)
+		if !ok {
+			t.Errorf("Test %d: Expected an *Error for Pattern: `%s`, found `%v`", i+1, c.pattern, err)
+			continue
+		}
+		if syntaxErr.Offset != c.offset {
+			t.Errorf("Test %d: Expected offset %d, found %d; With Pattern: `%s`", i+1, c.offset, syntaxErr.Offset, c.pattern)
+		}
+	}
+}
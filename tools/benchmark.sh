@@ -19,6 +19,11 @@ while read -r line ; do
     echo "$line" >> "${result_raw}"
     _name=$(echo "$line" | awk '{print $1}')
 
+    # Only the benchmarks of the numbered TestSet are plotted.
+    if [[ ! "${_name#*/}" =~ ^[0-9]+(-[0-9]+)?$ ]]; then
+        continue
+    fi
+
     bench_name+=("${_name%/*}")
     bench_set+=("${_name#*/}")
     bench_ns+=("$(echo "$line" | awk '{print $3}')")
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// SyntaxErrorKind identifies the mistake reported by a SyntaxError.
+type SyntaxErrorKind uint8
+
+const (
+	// TrailingEscape is a '\' ending the pattern or a bracket expression, with nothing to escape.
+	TrailingEscape SyntaxErrorKind = iota + 1
+	// UnclosedClass is a '[' without its ']', or a "[:" without its ":]".
+	UnclosedClass
+	// InvalidRange is a range of a bracket expression ending before its start, like "z-a".
+	InvalidRange
+	// UnknownClass is a POSIX class which doesn't exist, like "[:alfa:]".
+	UnknownClass
+	// UnclosedGroup is a '{' without its '}'.
+	UnclosedGroup
+	// MisplacedEroteme is a '?' followed by a '.', matching one or two characters
+	// like the clearer ".?".
+	MisplacedEroteme
+)
+
+// String returns the name of the kind.
+func (k SyntaxErrorKind) String() string {
+	switch k {
+	case TrailingEscape:
+		return "trailing escape"
+	case UnclosedClass:
+		return "unclosed bracket expression"
+	case InvalidRange:
+		return "invalid range"
+	case UnknownClass:
+		return "unknown class"
+	case UnclosedGroup:
+		return "unclosed group"
+	case MisplacedEroteme:
+		return "misplaced '?'"
+	}
+
+	return fmt.Sprintf("SyntaxErrorKind(%d)", uint8(k))
+}
+
+// SyntaxError describes a mistake in a pattern and where it is.
+// It wraps ErrBadPattern.
+type SyntaxError struct {
+	// Offset is the index of the byte where the mistake starts.
+	Offset int
+	// Column is the position of the same character counted in runes, starting at 1.
+	Column int
+	Kind   SyntaxErrorKind
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at column %d: %s", ErrBadPattern, e.Column, e.Msg)
+}
+
+// Unwrap returns ErrBadPattern.
+func (e *SyntaxError) Unwrap() error {
+	return ErrBadPattern
+}
+
+// Validate returns a *SyntaxError for the first mistake of the pattern, or nil if there is none.
+// It reports the patterns rejected by Compile, and also the '?' followed by a '.',
+// which is most likely an error.
+func Validate(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) {
+				return syntaxError(pattern, i, TrailingEscape, `'\' at the end of the pattern escapes nothing`)
+			}
+			i++
+		case '?':
+			if i+1 < len(pattern) && pattern[i+1] == '.' {
+				return syntaxError(pattern, i, MisplacedEroteme, `"?." matches one or two characters, write ".?"`)
+			}
+		case '[':
+			end, err := validateClass(pattern, i)
+			if err != nil {
+				return err
+			}
+			i = end
+		case '{':
+			if matchByStringClose(pattern, i) == -1 {
+				return syntaxError(pattern, i, UnclosedGroup, "'{' is not closed by '}'")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateClass returns the index of the ']' closing the bracket expression
+// starting at pattern[start], or the mistake making it malformed.
+// It follows the parsing of matchByStringSet.
+func validateClass(pattern string, start int) (int, error) {
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		i++
+	}
+
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return i, nil
+		}
+
+		if pattern[i] == '[' && i+1 < len(pattern) && pattern[i+1] == ':' {
+			end := i + 2
+			for end+1 < len(pattern) && (pattern[end] != ':' || pattern[end+1] != ']') {
+				end++
+			}
+			if end+1 >= len(pattern) {
+				return -1, syntaxError(pattern, i, UnclosedClass, `"[:" is not closed by ":]"`)
+			}
+			if _, ok := matchByStringNamed(pattern[i+2:end], 0); !ok {
+				return -1, syntaxError(pattern, i, UnknownClass, fmt.Sprintf("unknown class %q", pattern[i:end+2]))
+			}
+			i = end + 2
+			continue
+		}
+
+		lo, next := matchByStringClassChar(pattern, i)
+		if next == -1 {
+			return -1, syntaxError(pattern, i, TrailingEscape, `'\' at the end of the pattern escapes nothing`)
+		}
+		if next+1 < len(pattern) && pattern[next] == '-' && pattern[next+1] != ']' {
+			hi, end := matchByStringClassChar(pattern, next+1)
+			if end == -1 {
+				return -1, syntaxError(pattern, next+1, TrailingEscape, `'\' at the end of the pattern escapes nothing`)
+			}
+			if hi < lo {
+				return -1, syntaxError(pattern, i, InvalidRange, fmt.Sprintf("range %q ends before it starts", pattern[i:end]))
+			}
+			next = end
+		}
+		i = next
+	}
+
+	return -1, syntaxError(pattern, start, UnclosedClass, "'[' is not closed by ']'")
+}
+
+func syntaxError(pattern string, offset int, kind SyntaxErrorKind, msg string) *SyntaxError {
+	return &SyntaxError{
+		Offset: offset,
+		Column: utf8.RuneCountInString(pattern[:offset]) + 1,
+		Kind:   kind,
+		Msg:    msg,
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestValidate validates the kind and the position of the reported mistakes
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		pattern string
+		offset  int
+		column  int
+		kind    SyntaxErrorKind
+	}{
+		{"", -1, 0, 0},
+		{"a*b?c.", -1, 0, 0},
+		{".?", -1, 0, 0},
+		{`\?.`, -1, 0, 0},
+		{"[?].", -1, 0, 0},
+		{"{a,b}[[:alpha:]][!a-z]", -1, 0, 0},
+
+		{`\`, 0, 1, TrailingEscape},
+		{`a*\`, 2, 3, TrailingEscape},
+		{`é\`, 2, 2, TrailingEscape},
+		{`[a\`, 2, 3, TrailingEscape},
+		{`[a-\`, 3, 4, TrailingEscape},
+		{"[", 0, 1, UnclosedClass},
+		{"a[]", 1, 2, UnclosedClass},
+		{"[!]", 0, 1, UnclosedClass},
+		{`[a\]`, 0, 1, UnclosedClass},
+		{"[[:alpha:]", 0, 1, UnclosedClass},
+		{"[[:alpha]", 1, 2, UnclosedClass},
+		{"日本[z-a]", 7, 4, InvalidRange},
+		{"[a-cz-a]", 4, 5, InvalidRange},
+		{"[[:alfa:]]", 1, 2, UnknownClass},
+		{"{", 0, 1, UnclosedGroup},
+		{"x{a,b", 1, 2, UnclosedGroup},
+		{"a{b,{c}", 1, 2, UnclosedGroup},
+		{`{a,\}`, 0, 1, UnclosedGroup},
+		{"{a,[b}", 3, 4, UnclosedClass},
+		{"?.", 0, 1, MisplacedEroteme},
+		{"é*?.txt", 3, 3, MisplacedEroteme},
+	}
+
+	for i, c := range cases {
+		err := Validate(c.pattern)
+		if c.offset == -1 {
+			if err != nil {
+				t.Errorf("Test %d: Unexpected error `%v` for Pattern: `%s`", i+1, err, c.pattern)
+			}
+			continue
+		}
+
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Test %d: Expected a SyntaxError for Pattern: `%s`, found `%v`", i+1, c.pattern, err)
+			continue
+		}
+		if syntaxErr.Offset != c.offset || syntaxErr.Column != c.column || syntaxErr.Kind != c.kind {
+			t.Errorf("Test %d: Expected %s at offset %d, column %d, found %s at offset %d, column %d; With Pattern: `%s`",
+				i+1, c.kind, c.offset, c.column, syntaxErr.Kind, syntaxErr.Offset, syntaxErr.Column, c.pattern)
+		}
+		if !errors.Is(err, ErrBadPattern) {
+			t.Errorf("Test %d: Expected `%v` to wrap `%v`", i+1, err, ErrBadPattern)
+		}
+	}
+}
+
+func TestSyntaxErrorMessage(t *testing.T) {
+	err := Validate("logs/[z-a]*")
+	if expected := `syntax error in pattern at column 7: range "z-a" ends before it starts`; err.Error() != expected {
+		t.Errorf("Expected `%s`, found `%s`", expected, err.Error())
+	}
+}
+
+func FuzzValidate(f *testing.F) {
+	f.Add("a{b,[c-d]}?.")
+	f.Fuzz(func(t *testing.T, pattern string) {
+		err := Validate(pattern)
+		_, compileErr := Compile(pattern)
+
+		var syntaxErr *SyntaxError
+		switch {
+		case err == nil:
+			if compileErr != nil {
+				t.Fatalf("Validate(%q) accepts a pattern rejected by Compile", pattern)
+			}
+		case !errors.As(err, &syntaxErr):
+			t.Fatalf("Validate(%q) returned `%v`, not a SyntaxError", pattern, err)
+		case syntaxErr.Offset < 0 || syntaxErr.Offset >= len(pattern):
+			t.Fatalf("Validate(%q) returned the invalid offset %d", pattern, syntaxErr.Offset)
+		case syntaxErr.Kind != MisplacedEroteme && compileErr == nil:
+			t.Fatalf("Validate(%q) rejects a pattern accepted by Compile: %v", pattern, err)
+		}
+	})
+}
@@ -10,34 +10,44 @@
 
 package wildcard
 
-import "bytes"
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+// ErrBadPattern indicates a pattern was malformed.
+var ErrBadPattern = errors.New("syntax error in pattern")
 
 // Match returns true if the pattern matches the string s.
 // It uses byte comparison rather than rune or grapheme cluster comparison.
-// For matching complex Unicode, only the "*" wildcard or exact equality is supported.
+// For matching complex Unicode, only the "*" wildcard or exact equality is supported,
+// and bracket expressions like "[a-z]" or "[[:alpha:]]" only contain ASCII bytes.
+// Whatever the pattern, it runs in O(len(pattern) × len(s)) time.
 func Match(pattern, s string) bool {
 	if pattern == "" {
 		return s == pattern
 	}
-	if pattern == "*" || s == pattern {
+	if pattern == "*" || s == pattern && literalSelf(pattern) {
 		return true
 	}
 
-	return matchByString(pattern, s)
+	return matchByString(pattern, s, -1, false)
 }
 
 // MatchByRune returns true if the pattern matches the string s.
-// It supports complex Unicode matching with wildcards such as "*", "?", and ".".
+// It supports complex Unicode matching with wildcards such as "*", "?", and ".",
+// and bracket expressions with Unicode ranges and classes.
 // Note that it incurs allocation and more CPU usage.
 func MatchByRune(pattern, s string) bool {
 	if pattern == "" {
 		return s == pattern
 	}
-	if pattern == "*" || s == pattern {
+	if pattern == "*" || s == pattern && literalSelf(pattern) {
 		return true
 	}
 
-	return matchByRunes([]rune(pattern), []rune(s))
+	return matchByRunes([]rune(pattern), []rune(s), -1, false)
 }
 
 // MatchFromByte returns true if the pattern matches the byte slice s.
@@ -46,9 +56,56 @@ func MatchFromByte(pattern, s []byte) bool {
 	if len(pattern) == 0 {
 		return len(s) == 0
 	}
-	if pattern[0] == '*' || bytes.Equal(pattern, s) {
+	if len(pattern) == 1 && pattern[0] == '*' || bytes.Equal(s, pattern) && literalSelf(pattern) {
 		return true
 	}
 
-	return matchByByte(pattern, s)
+	return matchByByte(pattern, s, -1, false)
+}
+
+// literalSelf reports whether the pattern matches itself, which is true
+// unless an escape, a bracket expression or a group changes its meaning.
+//
+// It is on the path of every exact match, so it reads the pattern once,
+// eight bytes at a time, looking for '\\' and for '[' or '{' which only
+// differ by the 0x20 bit.
+func literalSelf[T string | []byte](pattern T) bool {
+	const ones, highs = 0x0101010101010101, 0x8080808080808080
+
+	for len(pattern) >= 8 {
+		w := uint64(pattern[0]) | uint64(pattern[1])<<8 | uint64(pattern[2])<<16 | uint64(pattern[3])<<24 |
+			uint64(pattern[4])<<32 | uint64(pattern[5])<<40 | uint64(pattern[6])<<48 | uint64(pattern[7])<<56
+		escape, open := w^'\\'*ones, (w|' '*ones)^'{'*ones
+		if ((escape-ones)&^escape|(open-ones)&^open)&highs != 0 {
+			return false
+		}
+		pattern = pattern[8:]
+	}
+	for i := 0; i < len(pattern); i++ {
+		if c := pattern[i]; c == '\\' || c == '[' || c == '{' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Escape returns a pattern matching exactly the string s,
+// by escaping with '\' every character that has a special meaning.
+func Escape(s string) string {
+	if !strings.ContainsAny(s, `*?.\[{},`) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + 4)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '*', '?', '.', '\\', '[', '{', '}', ',':
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+
+	return b.String()
 }
@@ -1,258 +1,2098 @@
 // Code generated with go generate; DO NOT EDIT.
 // This file was generated by cmd/build/build.go at
-// 2025-02-07 17:37:01.573285599 +0100 CET
+// 2026-10-18 06:27:55.412960843 +0000 UTC
 // using source from source/wildcard_match.go
 package wildcard
 
-func matchByString(pattern, s string) bool {
-	var lastErotemeCluster byte
-	var patternIndex, sIndex, lastStar, lastEroteme int
-	patternLen := len(pattern)
-	sLen := len(s)
+import (
+	"math/bits"
+	"unicode"
+)
+func matchByString(pattern, s string, separator int, fold bool) bool {
+	var patternIndex, sIndex, lastStar int
 	star := -1
-	eroteme := -1
 
-Loop:
-	if sIndex >= sLen {
-		goto checkPattern
-	}
+	// Until a group or a globstar, only the last star seen is backtracked:
+	// once the text up to a star matched, any position where the rest can match
+	// is reachable from the leftmost one. The star restarts at most len(s) times
+	// the part of the pattern which follows it, hence O(len(pattern) × len(s)).
+	// A part holding a '?' is matched at once from all the positions instead.
+	for sIndex < len(s) {
+		if patternIndex < len(pattern) {
+			switch pattern[patternIndex] {
+			case '?':
+				next, end, ok := matchByStringErotemes(pattern, s, star, lastStar, separator, fold)
+				if !ok {
+					return matchByStringResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+				if end == -1 || next == len(pattern) {
+					return end != -1
+				}
+
+				// The rest is matched from the earliest end, so the stars before are done.
+				patternIndex = next
+				sIndex = end
+				star = -1
+				continue
+			case '{':
+				return matchByStringResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+			case '*':
+				if matchByStringGlobstar(pattern, patternIndex, separator) != -1 {
+					return matchByStringResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+
+				star = patternIndex
+				lastStar = sIndex
+				patternIndex++
+
+				// A trailing star takes the rest of s, unless it holds a separator.
+				if patternIndex == len(pattern) {
+					for ; sIndex < len(s); sIndex++ {
+						if int(s[sIndex]) == separator {
+							return false
+						}
+					}
+					return true
+				}
+				continue
+			case '.':
+				// It matches any single character but the separator.
+				if int(s[sIndex]) != separator {
+					patternIndex++
+					sIndex++
+					continue
+				}
+			case '[':
+				// '[' matches one character of the class but the separator.
+				// A malformed class never matches, and every match would go through it.
+				end, matched := matchByStringClass(pattern, patternIndex, byte(s[sIndex]), fold)
+				if end == -1 {
+					return false
+				}
+				if matched && int(s[sIndex]) != separator {
+					patternIndex = end + 1
+					sIndex++
+					continue
+				}
+			default:
+				// '\' makes the next character a literal, a lone escape at the end never matches.
+				next := patternIndex + 1
+				if pattern[patternIndex] == '\\' {
+					if next == len(pattern) {
+						return false
+					}
+					patternIndex++
+					next++
+				}
 
-	if patternIndex >= patternLen {
-		if star != -1 {
-			patternIndex = star + 1
-			lastStar++
-			sIndex = lastStar
-			goto Loop
+				if pattern[patternIndex] == s[sIndex] || fold && matchByStringFold(byte(pattern[patternIndex]), byte(s[sIndex])) {
+					patternIndex = next
+					sIndex++
+					continue
+				}
+			}
 		}
-		return false
+
+		// The characters don't match, so the last star takes one more character,
+		// unless it is the separator.
+		if star == -1 || int(s[lastStar]) == separator {
+			return false
+		}
+		patternIndex = star + 1
+		lastStar = matchByStringSkip(pattern, s, patternIndex, lastStar+1, separator, fold)
+		sIndex = lastStar
 	}
-	switch pattern[patternIndex] {
-	case '.':
-		// It matches any single character. So, we don't need to check anything.
-	case '?':
-		// '?' matches one character. Store its position and match exactly one character in the string.
-		eroteme = patternIndex
-		lastEroteme = sIndex
-		lastErotemeCluster = byte(s[sIndex])
-	case '*':
-		// '*' matches zero or more characters. Store its position and increment the pattern index.
-		star = patternIndex
-		lastStar = sIndex
+
+	// Only stars can match the end of the string, and maybe '?' or groups.
+	for patternIndex < len(pattern) && pattern[patternIndex] == '*' {
+		if matchByStringGlobstar(pattern, patternIndex, separator) != -1 {
+			break
+		}
 		patternIndex++
-		goto Loop
+	}
+	if patternIndex < len(pattern) && (pattern[patternIndex] == '?' ||
+		pattern[patternIndex] == '{' || pattern[patternIndex] == '*') {
+		return matchByStringResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+	}
+
+	return patternIndex == len(pattern)
+}
+
+// matchByStringSkip returns the first index of s from sIndex where the literal at
+// pattern[patternIndex] or a separator is, so that a star doesn't retry the rest
+// of the pattern at every character. It returns sIndex if it is not a literal.
+func matchByStringSkip(pattern, s string, patternIndex, sIndex, separator int, fold bool) int {
+	if fold || patternIndex == len(pattern) {
+		return sIndex
+	}
+
+	switch c := pattern[patternIndex]; c {
+	case '.', '?', '*', '\\',
+		'[', '{':
 	default:
-		// If the characters don't match, check if there was a previous '?' or '*' to backtrack.
-		if pattern[patternIndex] != s[sIndex] {
-			if eroteme != -1 {
-				patternIndex = eroteme + 1
-				sIndex = lastEroteme
-				eroteme = -1
-				goto Loop
+		for sIndex < len(s) && s[sIndex] != c && int(s[sIndex]) != separator {
+			sIndex++
+		}
+	}
+
+	return sIndex
+}
+
+// matchByStringErotemes matches the part of the pattern holding a '?' up to the next star,
+// starting after the star at pattern[star] and from any position of s[lastStar:] that
+// the star can reach, or at the start of both if star is -1. As the next star can take
+// any character the part could, the earliest end of the part is the best one.
+// Without a next star, the part must end with s. It returns the index of the next star
+// and the end of the part in s, or -1 if the part never matches. It returns false
+// if the part holds a group, or is too long for its states to fit in a word.
+func matchByStringErotemes(pattern, s string, star, lastStar, separator int, fold bool) (int, int, bool) {
+	start, sIndex := 0, 0
+	if star != -1 {
+		start, sIndex = star+1, lastStar
+	}
+
+	// The state k of the bitset means that the part matched up to pattern[start+k].
+	// The erotemes, the dots and the literals, stepped here without a call, are masks of states.
+	var erotemes, dots, literals uint64
+	var tokens int
+	next := start
+	for ; next < len(pattern) && pattern[next] != '*'; next++ {
+		tokens++
+		switch pattern[next] {
+		case '{':
+			return 0, 0, false
+		case '?':
+			erotemes |= 1 << (next - start)
+		case '.':
+			dots |= 1 << (next - start)
+		case '\\':
+			next++
+		case '[':
+			if end, _, _ := matchByStringSet(pattern, next, 0); end != -1 {
+				next = end
 			}
+		default:
+			if !fold {
+				literals |= 1 << (next - start)
+			}
+		}
+	}
+	if next > len(pattern) {
+		next = len(pattern)
+	}
+	if next-start >= 64 {
+		return 0, 0, false
+	}
 
-			if star != -1 {
-				patternIndex = star + 1
-				lastStar++
-				sIndex = lastStar
-				goto Loop
+	// The star takes the characters until the first separator. At the end of the pattern,
+	// the part matches between tokens minus the erotemes and tokens characters, so it
+	// only starts in the last tokens characters of s.
+	inject := star != -1
+	until := len(s)
+	if inject && next == len(pattern) {
+		until -= tokens - bits.OnesCount64(erotemes)
+		for ; sIndex < len(s)-tokens; sIndex++ {
+			if int(s[sIndex]) == separator {
+				return next, -1, true
 			}
+		}
+	}
+
+	last := uint64(1) << (next - start)
+	states := uint64(1)
+	for {
+		if inject && sIndex <= until {
+			states |= 1
+		}
+		for word := states & erotemes; word != 0; word &= word - 1 {
+			bit := (word & -word) << 1
+			states |= bit
+			word |= bit & erotemes
+		}
+
+		if states&last != 0 && (next < len(pattern) || sIndex == len(s)) {
+			return next, sIndex, true
+		}
+		if sIndex == len(s) {
+			return next, -1, true
+		}
+
+		c := s[sIndex]
+		switch {
+		case states == 1 && literals&1 != 0 && inject:
+			// Until the part starts, skip to a character that can start it.
+			for c != pattern[start] && int(c) != separator {
+				if sIndex++; sIndex == len(s) || sIndex > until {
+					return next, -1, true
+				}
+				c = s[sIndex]
+			}
+		case states&(states-1) == 0 && literals&states != 0 && (!inject || sIndex >= until):
+			// A single state reads its literals at once.
+			k := bits.TrailingZeros64(states)
+			for literals&(1<<k) != 0 && sIndex < len(s) && pattern[start+k] == s[sIndex] {
+				k++
+				sIndex++
+			}
+			if literals&(1<<k) != 0 && sIndex < len(s) {
+				return next, -1, true
+			}
+			states = 1 << k
+			continue
+		}
 
+		if int(c) == separator {
+			inject = false
+		}
+
+		var to uint64
+		for word := states &^ last; word != 0; word &= word - 1 {
+			k := bits.TrailingZeros64(word)
+			if literals&(1<<k) != 0 {
+				if pattern[start+k] == c {
+					to |= 1 << (k + 1)
+				}
+			} else if (erotemes|dots)&(1<<k) != 0 {
+				if int(c) != separator {
+					to |= 1 << (k + 1)
+				}
+			} else if i := matchByStringStep(pattern, start+k, byte(c), nil, separator, fold); i != -1 {
+				to |= 1 << (i - start)
+			}
+		}
+		states = to
+		sIndex++
+		if states == 0 && !inject {
+			return next, -1, true
+		}
+	}
+}
+
+// matchByStringResume matches the rest of the pattern with matchByStringStates, from the
+// pattern[patternIndex] reached at s[sIndex], or from the last star if there is one.
+func matchByStringResume(pattern, s string, patternIndex, sIndex, star, lastStar, separator int, fold bool) bool {
+	if star != -1 {
+		return matchByStringStates(pattern, s, star, lastStar, separator, fold)
+	}
+
+	return matchByStringStates(pattern, s, patternIndex, sIndex, separator, fold)
+}
+
+// matchByStringStates reports whether pattern[state:] matches s[sIndex:], by simulating
+// at once all the ways to match it. The state i means that the characters read so far
+// matched up to pattern[i], and the states are kept in a bitset. For every character,
+// each state steps to at most one state, then the states reached are followed through
+// the wildcards which can match nothing and the group delimiters, always forward
+// in the pattern. Both take O(len(pattern)), hence O(len(pattern) × len(s)) overall.
+func matchByStringStates(pattern, s string, state, sIndex, separator int, fold bool) bool {
+	// The buffers keep the patterns up to 255 characters on the stack.
+	var setsBuf [8]uint64
+	var linksBuf [512]int32
+
+	words := len(pattern)/64 + 1
+	sets := setsBuf[:]
+	if 2*words > len(sets) {
+		sets = make([]uint64, 2*words)
+	}
+	current, next := sets[:words], sets[words:2*words]
+
+	var links []int32
+	for i := range pattern {
+		if pattern[i] == '{' {
+			links = matchByStringLinks(pattern, linksBuf[:])
+			break
+		}
+	}
+
+	current[state/64] = 1 << (state % 64)
+	matchByStringFollow(pattern, current, links, separator)
+	for ; sIndex < len(s); sIndex++ {
+		alive := false
+		for w := range current {
+			for word := current[w]; word != 0; word &= word - 1 {
+				i := w*64 + bits.TrailingZeros64(word)
+				if to := matchByStringStep(pattern, i, byte(s[sIndex]), links, separator, fold); to != -1 {
+					next[to/64] |= 1 << (to % 64)
+					alive = true
+				}
+			}
+			current[w] = 0
+		}
+		if !alive {
 			return false
 		}
 
-		// If the characters match, check if it was not the same to validate the eroteme.
-		if eroteme != -1 && lastErotemeCluster != byte(s[sIndex]) {
-			eroteme = -1
+		matchByStringFollow(pattern, next, links, separator)
+		current, next = next, current
+	}
+
+	end := len(pattern)
+	return current[end/64]&(1<<(end%64)) != 0
+}
+
+// matchByStringStep returns the state following the state i with the character c,
+// or -1 if c can't be matched there. Group delimiters and unclosed groups never match.
+func matchByStringStep(pattern string, i int, c byte, links []int32, separator int, fold bool) int {
+	if i == len(pattern) {
+		return -1
+	}
+
+	switch pattern[i] {
+	case '*':
+		switch matchByStringGlobstar(pattern, i, separator) {
+		case i + 2:
+			// A trailing "**" matches everything.
+			return i
+		case i + 3:
+			// "**/" is at a segment boundary, where a separator ends an empty segment.
+			// The second '*' is the state inside a segment.
+			if int(c) == separator {
+				return i
+			}
+			return i + 1
+		}
+
+		if int(c) != separator {
+			return i
+		}
+		if i > 0 && pattern[i-1] == '*' && matchByStringGlobstar(pattern, i-1, separator) == i+2 {
+			return i - 1
+		}
+	case '?', '.':
+		if int(c) != separator {
+			return i + 1
+		}
+	case '[':
+		end, matched := matchByStringClass(pattern, i, c, fold)
+		if end != -1 && matched && int(c) != separator {
+			return end + 1
+		}
+	case '{':
+	default:
+		if links != nil && links[2*i+1] > 0 {
+			return -1
+		}
+
+		// '\' makes the next character a literal, a lone escape at the end never matches.
+		next := i + 1
+		if pattern[i] == '\\' {
+			if next == len(pattern) {
+				return -1
+			}
+			i++
+			next++
+		}
+		if byte(pattern[i]) == c || fold && matchByStringFold(byte(pattern[i]), c) {
+			return next
+		}
+	}
+
+	return -1
+}
+
+// matchByStringFollow adds to states the states reached from them without a character:
+// after a star, a '?' or a globstar, at the start of every alternative of a group,
+// and after the group at the end of an alternative.
+// All of them are after the state in the pattern, so a single forward pass is enough.
+func matchByStringFollow(pattern string, states []uint64, links []int32, separator int) {
+	add := func(i int) {
+		states[i/64] |= 1 << (i % 64)
+	}
+
+	for w := range states {
+		for word := states[w]; word != 0; {
+			bit := bits.TrailingZeros64(word)
+			if i := w*64 + bit; i < len(pattern) {
+				switch pattern[i] {
+				case '*':
+					if next := matchByStringGlobstar(pattern, i, separator); next != -1 {
+						add(next)
+					} else {
+						add(i + 1)
+					}
+				case '?':
+					add(i + 1)
+				case '{':
+					if links[2*i+1] != -1 {
+						add(i + 1)
+						for d := int(links[2*i]); pattern[d] != '}'; d = int(links[2*d]) {
+							add(d + 1)
+						}
+					}
+				case ',', '}':
+					if links != nil && links[2*i+1] > 0 {
+						add(int(links[2*i+1]) + 1)
+					}
+				}
+			}
+
+			// The states added in this word are seen, as they are after i.
+			word = states[w] &^ (1<<(bit+1) - 1)
+		}
+	}
+}
+
+// matchByStringLinks returns for the groups of the pattern two links per character,
+// using buf when it is large enough. For a '{' or a ',' ending an alternative,
+// links[2*i] is the index of the ',' or '}' ending the next alternative.
+// For a '{', a ',' or a '}' of a group, links[2*i+1] is the index of the '}'
+// closing the group, or -1 for a '{' without one. The other links are 0.
+func matchByStringLinks(pattern string, buf []int32) []int32 {
+	links := buf
+	if 2*len(pattern) > len(links) {
+		links = make([]int32, 2*len(pattern))
+	}
+	links = links[:2*len(pattern)]
+	for i := range links {
+		links[i] = 0
+	}
+
+	// While a group is open, links[2*open+1] is its last delimiter.
+	var stack [16]int32
+	groups := stack[:0]
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '[':
+			if end, _, _ := matchByStringSet(pattern, i, 0); end != -1 {
+				i = end
+			}
+		case '{':
+			groups = append(groups, int32(i))
+			links[2*i+1] = int32(i)
+		case ',', '}':
+			if len(groups) == 0 {
+				break
+			}
+
+			open := groups[len(groups)-1]
+			links[2*links[2*open+1]] = int32(i)
+			links[2*open+1] = int32(i)
+			if pattern[i] == ',' {
+				break
+			}
+
+			groups = groups[:len(groups)-1]
+			for d := open; ; d = links[2*d] {
+				links[2*d+1] = int32(i)
+				if d == int32(i) {
+					break
+				}
+			}
 		}
 	}
 
-	patternIndex++
-	sIndex++
-	goto Loop
+	for _, open := range groups {
+		links[2*open+1] = -1
+	}
+
+	return links
+}
+
+// matchByStringGlobstar returns the index following the "**" at pattern[i] and its
+// separator when it is a whole path segment, or -1 if it is not a globstar.
+func matchByStringGlobstar(pattern string, i, separator int) int {
+	if separator == -1 || i+1 >= len(pattern) || pattern[i+1] != '*' ||
+		(i > 0 && int(pattern[i-1]) != separator) {
+		return -1
+	}
 
-	// Check if the remaining pattern characters are '*' or '?', which can match the end of the string.
-checkPattern:
-	if patternIndex < patternLen {
-		if pattern[patternIndex] == '*' {
-			patternIndex++
-			goto checkPattern
-		} else if pattern[patternIndex] == '?' {
-			if sIndex >= sLen {
-				sIndex--
+	switch {
+	case i+2 == len(pattern):
+		return i + 2
+	case int(pattern[i+2]) == separator:
+		return i + 3
+	}
+
+	return -1
+}
+
+// matchByStringClose returns the index of the '}' closing the group starting
+// at pattern[start], or -1 if the group is not closed.
+func matchByStringClose(pattern string, start int) int {
+	i := start
+	for i != -1 && (i == start || pattern[i] != '}') {
+		i = matchByStringNext(pattern, i+1)
+	}
+
+	return i
+}
+
+// matchByStringNext returns the index of the first ',' or '}' from pattern[i] that
+// belongs to the current group, skipping escapes, bracket expressions and nested groups.
+// It returns -1 if there is none.
+func matchByStringNext(pattern string, i int) int {
+	for i < len(pattern) {
+		switch pattern[i] {
+		case ',', '}':
+			return i
+		case '\\':
+			i += 2
+		case '[':
+			if end, _, _ := matchByStringSet(pattern, i, 0); end != -1 {
+				i = end
 			}
-			patternIndex++
-			goto checkPattern
+			i++
+		case '{':
+			i = matchByStringClose(pattern, i)
+			if i == -1 {
+				return -1
+			}
+			i++
+		default:
+			i++
 		}
 	}
 
-	return patternIndex == patternLen
+	return -1
 }
 
-func matchByByte(pattern, s []byte) bool {
-	var lastErotemeCluster byte
-	var patternIndex, sIndex, lastStar, lastEroteme int
-	patternLen := len(pattern)
-	sLen := len(s)
-	star := -1
-	eroteme := -1
+// matchByStringClass matches c against the bracket expression starting at pattern[start].
+// It supports ranges, negation with '!' or '^' and POSIX named classes like "[:alpha:]".
+// If fold is set, c is in the class if one of its case foldings is.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func matchByStringClass(pattern string, start int, c byte, fold bool) (int, bool) {
+	end, in, negate := matchByStringSet(pattern, start, c)
+	if fold && !in && end != -1 {
+		for r := unicode.SimpleFold(rune(c)); r != rune(c) && !in; r = unicode.SimpleFold(r) {
+			if r <= unicode.MaxASCII {
+				_, in, _ = matchByStringSet(pattern, start, byte(r))
+			}
+		}
+	}
+
+	return end, in != negate
+}
 
-Loop:
-	if sIndex >= sLen {
-		goto checkPattern
+// matchByStringSet reports whether c is in the set of the bracket expression starting
+// at pattern[start], and if the expression is negated.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func matchByStringSet(pattern string, start int, c byte) (int, bool, bool) {
+	var matched, negate bool
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
 	}
 
-	if patternIndex >= patternLen {
-		if star != -1 {
-			patternIndex = star + 1
-			lastStar++
-			sIndex = lastStar
-			goto Loop
+	// A ']' right after the opening bracket is a literal.
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return i, matched, negate
 		}
+
+		if pattern[i] == '[' && i+1 < len(pattern) && pattern[i+1] == ':' {
+			end := i + 2
+			for end+1 < len(pattern) && (pattern[end] != ':' || pattern[end+1] != ']') {
+				end++
+			}
+			if end+1 >= len(pattern) {
+				return -1, false, false
+			}
+
+			in, ok := matchByStringNamed(pattern[i+2:end], c)
+			if !ok {
+				return -1, false, false
+			}
+			matched = matched || in
+			i = end + 2
+			continue
+		}
+
+		lo, next := matchByStringClassChar(pattern, i)
+		if next == -1 {
+			return -1, false, false
+		}
+		hi := lo
+		if next+1 < len(pattern) && pattern[next] == '-' && pattern[next+1] != ']' {
+			hi, next = matchByStringClassChar(pattern, next+1)
+			if next == -1 || hi < lo {
+				return -1, false, false
+			}
+		}
+
+		matched = matched || (lo <= c && c <= hi)
+		i = next
+	}
+
+	return -1, false, false
+}
+
+// matchByStringFold reports whether a and b are equal under simple case folding.
+// Only ASCII letters are folded with byte comparison.
+func matchByStringFold(a, b byte) bool {
+	if rune(a) <= unicode.MaxASCII && rune(b) <= unicode.MaxASCII {
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		return a == b
+	}
+	if rune(a) > unicode.MaxASCII || rune(b) > unicode.MaxASCII {
 		return false
 	}
-	switch pattern[patternIndex] {
-	case '.':
-		// It matches any single character. So, we don't need to check anything.
-	case '?':
-		// '?' matches one character. Store its position and match exactly one character in the string.
-		eroteme = patternIndex
-		lastEroteme = sIndex
-		lastErotemeCluster = byte(s[sIndex])
-	case '*':
-		// '*' matches zero or more characters. Store its position and increment the pattern index.
-		star = patternIndex
-		lastStar = sIndex
+
+	for r := unicode.SimpleFold(rune(a)); r != rune(a); r = unicode.SimpleFold(r) {
+		if r == rune(b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchByStringClassChar returns the possibly escaped character at pattern[i]
+// and the index following it, or -1 if the escape is the last character.
+func matchByStringClassChar(pattern string, i int) (byte, int) {
+	if pattern[i] == '\\' {
+		i++
+		if i >= len(pattern) {
+			return 0, -1
+		}
+	}
+
+	return byte(pattern[i]), i + 1
+}
+
+// matchByStringNamed reports whether c is in the POSIX class called name,
+// and false for ok if there is no such class.
+// Characters above unicode.MaxASCII are never part of a named class.
+func matchByStringNamed(name string, c byte) (matched, ok bool) {
+	var buf [6]byte
+	if len(name) > len(buf) {
+		return false, false
+	}
+	for i := range name {
+		if name[i] > unicode.MaxASCII {
+			return false, false
+		}
+		buf[i] = byte(name[i])
+	}
+
+	r := rune(c)
+	in := r <= unicode.MaxASCII
+	switch string(buf[:len(name)]) {
+	case "alnum":
+		return in && (unicode.IsLetter(r) || unicode.IsDigit(r)), true
+	case "alpha":
+		return in && unicode.IsLetter(r), true
+	case "blank":
+		return in && (r == '\t' || unicode.Is(unicode.Zs, r)), true
+	case "cntrl":
+		return in && unicode.IsControl(r), true
+	case "digit":
+		return in && unicode.IsDigit(r), true
+	case "graph":
+		return in && unicode.IsGraphic(r) && !unicode.IsSpace(r), true
+	case "lower":
+		return in && unicode.IsLower(r), true
+	case "print":
+		return in && unicode.IsPrint(r), true
+	case "punct":
+		return in && (unicode.IsPunct(r) || unicode.IsSymbol(r)), true
+	case "space":
+		return in && unicode.IsSpace(r), true
+	case "upper":
+		return in && unicode.IsUpper(r), true
+	case "xdigit":
+		return in && (r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'), true
+	}
+
+	return false, false
+}
+
+func matchByByte(pattern, s []byte, separator int, fold bool) bool {
+	var patternIndex, sIndex, lastStar int
+	star := -1
+
+	// Until a group or a globstar, only the last star seen is backtracked:
+	// once the text up to a star matched, any position where the rest can match
+	// is reachable from the leftmost one. The star restarts at most len(s) times
+	// the part of the pattern which follows it, hence O(len(pattern) × len(s)).
+	// A part holding a '?' is matched at once from all the positions instead.
+	for sIndex < len(s) {
+		if patternIndex < len(pattern) {
+			switch pattern[patternIndex] {
+			case '?':
+				next, end, ok := matchByByteErotemes(pattern, s, star, lastStar, separator, fold)
+				if !ok {
+					return matchByByteResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+				if end == -1 || next == len(pattern) {
+					return end != -1
+				}
+
+				// The rest is matched from the earliest end, so the stars before are done.
+				patternIndex = next
+				sIndex = end
+				star = -1
+				continue
+			case '{':
+				return matchByByteResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+			case '*':
+				if matchByByteGlobstar(pattern, patternIndex, separator) != -1 {
+					return matchByByteResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+
+				star = patternIndex
+				lastStar = sIndex
+				patternIndex++
+
+				// A trailing star takes the rest of s, unless it holds a separator.
+				if patternIndex == len(pattern) {
+					for ; sIndex < len(s); sIndex++ {
+						if int(s[sIndex]) == separator {
+							return false
+						}
+					}
+					return true
+				}
+				continue
+			case '.':
+				// It matches any single character but the separator.
+				if int(s[sIndex]) != separator {
+					patternIndex++
+					sIndex++
+					continue
+				}
+			case '[':
+				// '[' matches one character of the class but the separator.
+				// A malformed class never matches, and every match would go through it.
+				end, matched := matchByByteClass(pattern, patternIndex, byte(s[sIndex]), fold)
+				if end == -1 {
+					return false
+				}
+				if matched && int(s[sIndex]) != separator {
+					patternIndex = end + 1
+					sIndex++
+					continue
+				}
+			default:
+				// '\' makes the next character a literal, a lone escape at the end never matches.
+				next := patternIndex + 1
+				if pattern[patternIndex] == '\\' {
+					if next == len(pattern) {
+						return false
+					}
+					patternIndex++
+					next++
+				}
+
+				if pattern[patternIndex] == s[sIndex] || fold && matchByByteFold(byte(pattern[patternIndex]), byte(s[sIndex])) {
+					patternIndex = next
+					sIndex++
+					continue
+				}
+			}
+		}
+
+		// The characters don't match, so the last star takes one more character,
+		// unless it is the separator.
+		if star == -1 || int(s[lastStar]) == separator {
+			return false
+		}
+		patternIndex = star + 1
+		lastStar = matchByByteSkip(pattern, s, patternIndex, lastStar+1, separator, fold)
+		sIndex = lastStar
+	}
+
+	// Only stars can match the end of the string, and maybe '?' or groups.
+	for patternIndex < len(pattern) && pattern[patternIndex] == '*' {
+		if matchByByteGlobstar(pattern, patternIndex, separator) != -1 {
+			break
+		}
 		patternIndex++
-		goto Loop
+	}
+	if patternIndex < len(pattern) && (pattern[patternIndex] == '?' ||
+		pattern[patternIndex] == '{' || pattern[patternIndex] == '*') {
+		return matchByByteResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+	}
+
+	return patternIndex == len(pattern)
+}
+
+// matchByByteSkip returns the first index of s from sIndex where the literal at
+// pattern[patternIndex] or a separator is, so that a star doesn't retry the rest
+// of the pattern at every character. It returns sIndex if it is not a literal.
+func matchByByteSkip(pattern, s []byte, patternIndex, sIndex, separator int, fold bool) int {
+	if fold || patternIndex == len(pattern) {
+		return sIndex
+	}
+
+	switch c := pattern[patternIndex]; c {
+	case '.', '?', '*', '\\',
+		'[', '{':
 	default:
-		// If the characters don't match, check if there was a previous '?' or '*' to backtrack.
-		if pattern[patternIndex] != s[sIndex] {
-			if eroteme != -1 {
-				patternIndex = eroteme + 1
-				sIndex = lastEroteme
-				eroteme = -1
-				goto Loop
+		for sIndex < len(s) && s[sIndex] != c && int(s[sIndex]) != separator {
+			sIndex++
+		}
+	}
+
+	return sIndex
+}
+
+// matchByByteErotemes matches the part of the pattern holding a '?' up to the next star,
+// starting after the star at pattern[star] and from any position of s[lastStar:] that
+// the star can reach, or at the start of both if star is -1. As the next star can take
+// any character the part could, the earliest end of the part is the best one.
+// Without a next star, the part must end with s. It returns the index of the next star
+// and the end of the part in s, or -1 if the part never matches. It returns false
+// if the part holds a group, or is too long for its states to fit in a word.
+func matchByByteErotemes(pattern, s []byte, star, lastStar, separator int, fold bool) (int, int, bool) {
+	start, sIndex := 0, 0
+	if star != -1 {
+		start, sIndex = star+1, lastStar
+	}
+
+	// The state k of the bitset means that the part matched up to pattern[start+k].
+	// The erotemes, the dots and the literals, stepped here without a call, are masks of states.
+	var erotemes, dots, literals uint64
+	var tokens int
+	next := start
+	for ; next < len(pattern) && pattern[next] != '*'; next++ {
+		tokens++
+		switch pattern[next] {
+		case '{':
+			return 0, 0, false
+		case '?':
+			erotemes |= 1 << (next - start)
+		case '.':
+			dots |= 1 << (next - start)
+		case '\\':
+			next++
+		case '[':
+			if end, _, _ := matchByByteSet(pattern, next, 0); end != -1 {
+				next = end
 			}
+		default:
+			if !fold {
+				literals |= 1 << (next - start)
+			}
+		}
+	}
+	if next > len(pattern) {
+		next = len(pattern)
+	}
+	if next-start >= 64 {
+		return 0, 0, false
+	}
 
-			if star != -1 {
-				patternIndex = star + 1
-				lastStar++
-				sIndex = lastStar
-				goto Loop
+	// The star takes the characters until the first separator. At the end of the pattern,
+	// the part matches between tokens minus the erotemes and tokens characters, so it
+	// only starts in the last tokens characters of s.
+	inject := star != -1
+	until := len(s)
+	if inject && next == len(pattern) {
+		until -= tokens - bits.OnesCount64(erotemes)
+		for ; sIndex < len(s)-tokens; sIndex++ {
+			if int(s[sIndex]) == separator {
+				return next, -1, true
 			}
+		}
+	}
+
+	last := uint64(1) << (next - start)
+	states := uint64(1)
+	for {
+		if inject && sIndex <= until {
+			states |= 1
+		}
+		for word := states & erotemes; word != 0; word &= word - 1 {
+			bit := (word & -word) << 1
+			states |= bit
+			word |= bit & erotemes
+		}
+
+		if states&last != 0 && (next < len(pattern) || sIndex == len(s)) {
+			return next, sIndex, true
+		}
+		if sIndex == len(s) {
+			return next, -1, true
+		}
+
+		c := s[sIndex]
+		switch {
+		case states == 1 && literals&1 != 0 && inject:
+			// Until the part starts, skip to a character that can start it.
+			for c != pattern[start] && int(c) != separator {
+				if sIndex++; sIndex == len(s) || sIndex > until {
+					return next, -1, true
+				}
+				c = s[sIndex]
+			}
+		case states&(states-1) == 0 && literals&states != 0 && (!inject || sIndex >= until):
+			// A single state reads its literals at once.
+			k := bits.TrailingZeros64(states)
+			for literals&(1<<k) != 0 && sIndex < len(s) && pattern[start+k] == s[sIndex] {
+				k++
+				sIndex++
+			}
+			if literals&(1<<k) != 0 && sIndex < len(s) {
+				return next, -1, true
+			}
+			states = 1 << k
+			continue
+		}
+
+		if int(c) == separator {
+			inject = false
+		}
+
+		var to uint64
+		for word := states &^ last; word != 0; word &= word - 1 {
+			k := bits.TrailingZeros64(word)
+			if literals&(1<<k) != 0 {
+				if pattern[start+k] == c {
+					to |= 1 << (k + 1)
+				}
+			} else if (erotemes|dots)&(1<<k) != 0 {
+				if int(c) != separator {
+					to |= 1 << (k + 1)
+				}
+			} else if i := matchByByteStep(pattern, start+k, byte(c), nil, separator, fold); i != -1 {
+				to |= 1 << (i - start)
+			}
+		}
+		states = to
+		sIndex++
+		if states == 0 && !inject {
+			return next, -1, true
+		}
+	}
+}
+
+// matchByByteResume matches the rest of the pattern with matchByByteStates, from the
+// pattern[patternIndex] reached at s[sIndex], or from the last star if there is one.
+func matchByByteResume(pattern, s []byte, patternIndex, sIndex, star, lastStar, separator int, fold bool) bool {
+	if star != -1 {
+		return matchByByteStates(pattern, s, star, lastStar, separator, fold)
+	}
+
+	return matchByByteStates(pattern, s, patternIndex, sIndex, separator, fold)
+}
+
+// matchByByteStates reports whether pattern[state:] matches s[sIndex:], by simulating
+// at once all the ways to match it. The state i means that the characters read so far
+// matched up to pattern[i], and the states are kept in a bitset. For every character,
+// each state steps to at most one state, then the states reached are followed through
+// the wildcards which can match nothing and the group delimiters, always forward
+// in the pattern. Both take O(len(pattern)), hence O(len(pattern) × len(s)) overall.
+func matchByByteStates(pattern, s []byte, state, sIndex, separator int, fold bool) bool {
+	// The buffers keep the patterns up to 255 characters on the stack.
+	var setsBuf [8]uint64
+	var linksBuf [512]int32
+
+	words := len(pattern)/64 + 1
+	sets := setsBuf[:]
+	if 2*words > len(sets) {
+		sets = make([]uint64, 2*words)
+	}
+	current, next := sets[:words], sets[words:2*words]
+
+	var links []int32
+	for i := range pattern {
+		if pattern[i] == '{' {
+			links = matchByByteLinks(pattern, linksBuf[:])
+			break
+		}
+	}
 
+	current[state/64] = 1 << (state % 64)
+	matchByByteFollow(pattern, current, links, separator)
+	for ; sIndex < len(s); sIndex++ {
+		alive := false
+		for w := range current {
+			for word := current[w]; word != 0; word &= word - 1 {
+				i := w*64 + bits.TrailingZeros64(word)
+				if to := matchByByteStep(pattern, i, byte(s[sIndex]), links, separator, fold); to != -1 {
+					next[to/64] |= 1 << (to % 64)
+					alive = true
+				}
+			}
+			current[w] = 0
+		}
+		if !alive {
 			return false
 		}
 
-		// If the characters match, check if it was not the same to validate the eroteme.
-		if eroteme != -1 && lastErotemeCluster != byte(s[sIndex]) {
-			eroteme = -1
+		matchByByteFollow(pattern, next, links, separator)
+		current, next = next, current
+	}
+
+	end := len(pattern)
+	return current[end/64]&(1<<(end%64)) != 0
+}
+
+// matchByByteStep returns the state following the state i with the character c,
+// or -1 if c can't be matched there. Group delimiters and unclosed groups never match.
+func matchByByteStep(pattern []byte, i int, c byte, links []int32, separator int, fold bool) int {
+	if i == len(pattern) {
+		return -1
+	}
+
+	switch pattern[i] {
+	case '*':
+		switch matchByByteGlobstar(pattern, i, separator) {
+		case i + 2:
+			// A trailing "**" matches everything.
+			return i
+		case i + 3:
+			// "**/" is at a segment boundary, where a separator ends an empty segment.
+			// The second '*' is the state inside a segment.
+			if int(c) == separator {
+				return i
+			}
+			return i + 1
+		}
+
+		if int(c) != separator {
+			return i
+		}
+		if i > 0 && pattern[i-1] == '*' && matchByByteGlobstar(pattern, i-1, separator) == i+2 {
+			return i - 1
+		}
+	case '?', '.':
+		if int(c) != separator {
+			return i + 1
+		}
+	case '[':
+		end, matched := matchByByteClass(pattern, i, c, fold)
+		if end != -1 && matched && int(c) != separator {
+			return end + 1
+		}
+	case '{':
+	default:
+		if links != nil && links[2*i+1] > 0 {
+			return -1
+		}
+
+		// '\' makes the next character a literal, a lone escape at the end never matches.
+		next := i + 1
+		if pattern[i] == '\\' {
+			if next == len(pattern) {
+				return -1
+			}
+			i++
+			next++
+		}
+		if byte(pattern[i]) == c || fold && matchByByteFold(byte(pattern[i]), c) {
+			return next
 		}
 	}
 
-	patternIndex++
-	sIndex++
-	goto Loop
+	return -1
+}
 
-	// Check if the remaining pattern characters are '*' or '?', which can match the end of the string.
-checkPattern:
-	if patternIndex < patternLen {
-		if pattern[patternIndex] == '*' {
-			patternIndex++
-			goto checkPattern
-		} else if pattern[patternIndex] == '?' {
-			if sIndex >= sLen {
-				sIndex--
+// matchByByteFollow adds to states the states reached from them without a character:
+// after a star, a '?' or a globstar, at the start of every alternative of a group,
+// and after the group at the end of an alternative.
+// All of them are after the state in the pattern, so a single forward pass is enough.
+func matchByByteFollow(pattern []byte, states []uint64, links []int32, separator int) {
+	add := func(i int) {
+		states[i/64] |= 1 << (i % 64)
+	}
+
+	for w := range states {
+		for word := states[w]; word != 0; {
+			bit := bits.TrailingZeros64(word)
+			if i := w*64 + bit; i < len(pattern) {
+				switch pattern[i] {
+				case '*':
+					if next := matchByByteGlobstar(pattern, i, separator); next != -1 {
+						add(next)
+					} else {
+						add(i + 1)
+					}
+				case '?':
+					add(i + 1)
+				case '{':
+					if links[2*i+1] != -1 {
+						add(i + 1)
+						for d := int(links[2*i]); pattern[d] != '}'; d = int(links[2*d]) {
+							add(d + 1)
+						}
+					}
+				case ',', '}':
+					if links != nil && links[2*i+1] > 0 {
+						add(int(links[2*i+1]) + 1)
+					}
+				}
+			}
+
+			// The states added in this word are seen, as they are after i.
+			word = states[w] &^ (1<<(bit+1) - 1)
+		}
+	}
+}
+
+// matchByByteLinks returns for the groups of the pattern two links per character,
+// using buf when it is large enough. For a '{' or a ',' ending an alternative,
+// links[2*i] is the index of the ',' or '}' ending the next alternative.
+// For a '{', a ',' or a '}' of a group, links[2*i+1] is the index of the '}'
+// closing the group, or -1 for a '{' without one. The other links are 0.
+func matchByByteLinks(pattern []byte, buf []int32) []int32 {
+	links := buf
+	if 2*len(pattern) > len(links) {
+		links = make([]int32, 2*len(pattern))
+	}
+	links = links[:2*len(pattern)]
+	for i := range links {
+		links[i] = 0
+	}
+
+	// While a group is open, links[2*open+1] is its last delimiter.
+	var stack [16]int32
+	groups := stack[:0]
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '[':
+			if end, _, _ := matchByByteSet(pattern, i, 0); end != -1 {
+				i = end
+			}
+		case '{':
+			groups = append(groups, int32(i))
+			links[2*i+1] = int32(i)
+		case ',', '}':
+			if len(groups) == 0 {
+				break
+			}
+
+			open := groups[len(groups)-1]
+			links[2*links[2*open+1]] = int32(i)
+			links[2*open+1] = int32(i)
+			if pattern[i] == ',' {
+				break
+			}
+
+			groups = groups[:len(groups)-1]
+			for d := open; ; d = links[2*d] {
+				links[2*d+1] = int32(i)
+				if d == int32(i) {
+					break
+				}
 			}
-			patternIndex++
-			goto checkPattern
 		}
 	}
 
-	return patternIndex == patternLen
+	for _, open := range groups {
+		links[2*open+1] = -1
+	}
+
+	return links
 }
 
-func matchByRunes(pattern, s []rune) bool {
-	var lastErotemeCluster rune
-	var patternIndex, sIndex, lastStar, lastEroteme int
-	patternLen := len(pattern)
-	sLen := len(s)
-	star := -1
-	eroteme := -1
+// matchByByteGlobstar returns the index following the "**" at pattern[i] and its
+// separator when it is a whole path segment, or -1 if it is not a globstar.
+func matchByByteGlobstar(pattern []byte, i, separator int) int {
+	if separator == -1 || i+1 >= len(pattern) || pattern[i+1] != '*' ||
+		(i > 0 && int(pattern[i-1]) != separator) {
+		return -1
+	}
+
+	switch {
+	case i+2 == len(pattern):
+		return i + 2
+	case int(pattern[i+2]) == separator:
+		return i + 3
+	}
+
+	return -1
+}
+
+// matchByByteClose returns the index of the '}' closing the group starting
+// at pattern[start], or -1 if the group is not closed.
+func matchByByteClose(pattern []byte, start int) int {
+	i := start
+	for i != -1 && (i == start || pattern[i] != '}') {
+		i = matchByByteNext(pattern, i+1)
+	}
+
+	return i
+}
+
+// matchByByteNext returns the index of the first ',' or '}' from pattern[i] that
+// belongs to the current group, skipping escapes, bracket expressions and nested groups.
+// It returns -1 if there is none.
+func matchByByteNext(pattern []byte, i int) int {
+	for i < len(pattern) {
+		switch pattern[i] {
+		case ',', '}':
+			return i
+		case '\\':
+			i += 2
+		case '[':
+			if end, _, _ := matchByByteSet(pattern, i, 0); end != -1 {
+				i = end
+			}
+			i++
+		case '{':
+			i = matchByByteClose(pattern, i)
+			if i == -1 {
+				return -1
+			}
+			i++
+		default:
+			i++
+		}
+	}
+
+	return -1
+}
+
+// matchByByteClass matches c against the bracket expression starting at pattern[start].
+// It supports ranges, negation with '!' or '^' and POSIX named classes like "[:alpha:]".
+// If fold is set, c is in the class if one of its case foldings is.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func matchByByteClass(pattern []byte, start int, c byte, fold bool) (int, bool) {
+	end, in, negate := matchByByteSet(pattern, start, c)
+	if fold && !in && end != -1 {
+		for r := unicode.SimpleFold(rune(c)); r != rune(c) && !in; r = unicode.SimpleFold(r) {
+			if r <= unicode.MaxASCII {
+				_, in, _ = matchByByteSet(pattern, start, byte(r))
+			}
+		}
+	}
 
-Loop:
-	if sIndex >= sLen {
-		goto checkPattern
+	return end, in != negate
+}
+
+// matchByByteSet reports whether c is in the set of the bracket expression starting
+// at pattern[start], and if the expression is negated.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func matchByByteSet(pattern []byte, start int, c byte) (int, bool, bool) {
+	var matched, negate bool
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
 	}
 
-	if patternIndex >= patternLen {
-		if star != -1 {
-			patternIndex = star + 1
-			lastStar++
-			sIndex = lastStar
-			goto Loop
+	// A ']' right after the opening bracket is a literal.
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return i, matched, negate
+		}
+
+		if pattern[i] == '[' && i+1 < len(pattern) && pattern[i+1] == ':' {
+			end := i + 2
+			for end+1 < len(pattern) && (pattern[end] != ':' || pattern[end+1] != ']') {
+				end++
+			}
+			if end+1 >= len(pattern) {
+				return -1, false, false
+			}
+
+			in, ok := matchByByteNamed(pattern[i+2:end], c)
+			if !ok {
+				return -1, false, false
+			}
+			matched = matched || in
+			i = end + 2
+			continue
 		}
+
+		lo, next := matchByByteClassChar(pattern, i)
+		if next == -1 {
+			return -1, false, false
+		}
+		hi := lo
+		if next+1 < len(pattern) && pattern[next] == '-' && pattern[next+1] != ']' {
+			hi, next = matchByByteClassChar(pattern, next+1)
+			if next == -1 || hi < lo {
+				return -1, false, false
+			}
+		}
+
+		matched = matched || (lo <= c && c <= hi)
+		i = next
+	}
+
+	return -1, false, false
+}
+
+// matchByByteFold reports whether a and b are equal under simple case folding.
+// Only ASCII letters are folded with byte comparison.
+func matchByByteFold(a, b byte) bool {
+	if rune(a) <= unicode.MaxASCII && rune(b) <= unicode.MaxASCII {
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		return a == b
+	}
+	if rune(a) > unicode.MaxASCII || rune(b) > unicode.MaxASCII {
 		return false
 	}
-	switch pattern[patternIndex] {
-	case '.':
-		// It matches any single character. So, we don't need to check anything.
-	case '?':
-		// '?' matches one character. Store its position and match exactly one character in the string.
-		eroteme = patternIndex
-		lastEroteme = sIndex
-		lastErotemeCluster = rune(s[sIndex])
-	case '*':
-		// '*' matches zero or more characters. Store its position and increment the pattern index.
-		star = patternIndex
-		lastStar = sIndex
+
+	for r := unicode.SimpleFold(rune(a)); r != rune(a); r = unicode.SimpleFold(r) {
+		if r == rune(b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchByByteClassChar returns the possibly escaped character at pattern[i]
+// and the index following it, or -1 if the escape is the last character.
+func matchByByteClassChar(pattern []byte, i int) (byte, int) {
+	if pattern[i] == '\\' {
+		i++
+		if i >= len(pattern) {
+			return 0, -1
+		}
+	}
+
+	return byte(pattern[i]), i + 1
+}
+
+// matchByByteNamed reports whether c is in the POSIX class called name,
+// and false for ok if there is no such class.
+// Characters above unicode.MaxASCII are never part of a named class.
+func matchByByteNamed(name []byte, c byte) (matched, ok bool) {
+	var buf [6]byte
+	if len(name) > len(buf) {
+		return false, false
+	}
+	for i := range name {
+		if name[i] > unicode.MaxASCII {
+			return false, false
+		}
+		buf[i] = byte(name[i])
+	}
+
+	r := rune(c)
+	in := r <= unicode.MaxASCII
+	switch string(buf[:len(name)]) {
+	case "alnum":
+		return in && (unicode.IsLetter(r) || unicode.IsDigit(r)), true
+	case "alpha":
+		return in && unicode.IsLetter(r), true
+	case "blank":
+		return in && (r == '\t' || unicode.Is(unicode.Zs, r)), true
+	case "cntrl":
+		return in && unicode.IsControl(r), true
+	case "digit":
+		return in && unicode.IsDigit(r), true
+	case "graph":
+		return in && unicode.IsGraphic(r) && !unicode.IsSpace(r), true
+	case "lower":
+		return in && unicode.IsLower(r), true
+	case "print":
+		return in && unicode.IsPrint(r), true
+	case "punct":
+		return in && (unicode.IsPunct(r) || unicode.IsSymbol(r)), true
+	case "space":
+		return in && unicode.IsSpace(r), true
+	case "upper":
+		return in && unicode.IsUpper(r), true
+	case "xdigit":
+		return in && (r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'), true
+	}
+
+	return false, false
+}
+
+func matchByRunes(pattern, s []rune, separator int, fold bool) bool {
+	var patternIndex, sIndex, lastStar int
+	star := -1
+
+	// Until a group or a globstar, only the last star seen is backtracked:
+	// once the text up to a star matched, any position where the rest can match
+	// is reachable from the leftmost one. The star restarts at most len(s) times
+	// the part of the pattern which follows it, hence O(len(pattern) × len(s)).
+	// A part holding a '?' is matched at once from all the positions instead.
+	for sIndex < len(s) {
+		if patternIndex < len(pattern) {
+			switch pattern[patternIndex] {
+			case '?':
+				next, end, ok := matchByRunesErotemes(pattern, s, star, lastStar, separator, fold)
+				if !ok {
+					return matchByRunesResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+				if end == -1 || next == len(pattern) {
+					return end != -1
+				}
+
+				// The rest is matched from the earliest end, so the stars before are done.
+				patternIndex = next
+				sIndex = end
+				star = -1
+				continue
+			case '{':
+				return matchByRunesResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+			case '*':
+				if matchByRunesGlobstar(pattern, patternIndex, separator) != -1 {
+					return matchByRunesResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+				}
+
+				star = patternIndex
+				lastStar = sIndex
+				patternIndex++
+
+				// A trailing star takes the rest of s, unless it holds a separator.
+				if patternIndex == len(pattern) {
+					for ; sIndex < len(s); sIndex++ {
+						if int(s[sIndex]) == separator {
+							return false
+						}
+					}
+					return true
+				}
+				continue
+			case '.':
+				// It matches any single character but the separator.
+				if int(s[sIndex]) != separator {
+					patternIndex++
+					sIndex++
+					continue
+				}
+			case '[':
+				// '[' matches one character of the class but the separator.
+				// A malformed class never matches, and every match would go through it.
+				end, matched := matchByRunesClass(pattern, patternIndex, rune(s[sIndex]), fold)
+				if end == -1 {
+					return false
+				}
+				if matched && int(s[sIndex]) != separator {
+					patternIndex = end + 1
+					sIndex++
+					continue
+				}
+			default:
+				// '\' makes the next character a literal, a lone escape at the end never matches.
+				next := patternIndex + 1
+				if pattern[patternIndex] == '\\' {
+					if next == len(pattern) {
+						return false
+					}
+					patternIndex++
+					next++
+				}
+
+				if pattern[patternIndex] == s[sIndex] || fold && matchByRunesFold(rune(pattern[patternIndex]), rune(s[sIndex])) {
+					patternIndex = next
+					sIndex++
+					continue
+				}
+			}
+		}
+
+		// The characters don't match, so the last star takes one more character,
+		// unless it is the separator.
+		if star == -1 || int(s[lastStar]) == separator {
+			return false
+		}
+		patternIndex = star + 1
+		lastStar = matchByRunesSkip(pattern, s, patternIndex, lastStar+1, separator, fold)
+		sIndex = lastStar
+	}
+
+	// Only stars can match the end of the string, and maybe '?' or groups.
+	for patternIndex < len(pattern) && pattern[patternIndex] == '*' {
+		if matchByRunesGlobstar(pattern, patternIndex, separator) != -1 {
+			break
+		}
 		patternIndex++
-		goto Loop
+	}
+	if patternIndex < len(pattern) && (pattern[patternIndex] == '?' ||
+		pattern[patternIndex] == '{' || pattern[patternIndex] == '*') {
+		return matchByRunesResume(pattern, s, patternIndex, sIndex, star, lastStar, separator, fold)
+	}
+
+	return patternIndex == len(pattern)
+}
+
+// matchByRunesSkip returns the first index of s from sIndex where the literal at
+// pattern[patternIndex] or a separator is, so that a star doesn't retry the rest
+// of the pattern at every character. It returns sIndex if it is not a literal.
+func matchByRunesSkip(pattern, s []rune, patternIndex, sIndex, separator int, fold bool) int {
+	if fold || patternIndex == len(pattern) {
+		return sIndex
+	}
+
+	switch c := pattern[patternIndex]; c {
+	case '.', '?', '*', '\\',
+		'[', '{':
 	default:
-		// If the characters don't match, check if there was a previous '?' or '*' to backtrack.
-		if pattern[patternIndex] != s[sIndex] {
-			if eroteme != -1 {
-				patternIndex = eroteme + 1
-				sIndex = lastEroteme
-				eroteme = -1
-				goto Loop
+		for sIndex < len(s) && s[sIndex] != c && int(s[sIndex]) != separator {
+			sIndex++
+		}
+	}
+
+	return sIndex
+}
+
+// matchByRunesErotemes matches the part of the pattern holding a '?' up to the next star,
+// starting after the star at pattern[star] and from any position of s[lastStar:] that
+// the star can reach, or at the start of both if star is -1. As the next star can take
+// any character the part could, the earliest end of the part is the best one.
+// Without a next star, the part must end with s. It returns the index of the next star
+// and the end of the part in s, or -1 if the part never matches. It returns false
+// if the part holds a group, or is too long for its states to fit in a word.
+func matchByRunesErotemes(pattern, s []rune, star, lastStar, separator int, fold bool) (int, int, bool) {
+	start, sIndex := 0, 0
+	if star != -1 {
+		start, sIndex = star+1, lastStar
+	}
+
+	// The state k of the bitset means that the part matched up to pattern[start+k].
+	// The erotemes, the dots and the literals, stepped here without a call, are masks of states.
+	var erotemes, dots, literals uint64
+	var tokens int
+	next := start
+	for ; next < len(pattern) && pattern[next] != '*'; next++ {
+		tokens++
+		switch pattern[next] {
+		case '{':
+			return 0, 0, false
+		case '?':
+			erotemes |= 1 << (next - start)
+		case '.':
+			dots |= 1 << (next - start)
+		case '\\':
+			next++
+		case '[':
+			if end, _, _ := matchByRunesSet(pattern, next, 0); end != -1 {
+				next = end
+			}
+		default:
+			if !fold {
+				literals |= 1 << (next - start)
+			}
+		}
+	}
+	if next > len(pattern) {
+		next = len(pattern)
+	}
+	if next-start >= 64 {
+		return 0, 0, false
+	}
+
+	// The star takes the characters until the first separator. At the end of the pattern,
+	// the part matches between tokens minus the erotemes and tokens characters, so it
+	// only starts in the last tokens characters of s.
+	inject := star != -1
+	until := len(s)
+	if inject && next == len(pattern) {
+		until -= tokens - bits.OnesCount64(erotemes)
+		for ; sIndex < len(s)-tokens; sIndex++ {
+			if int(s[sIndex]) == separator {
+				return next, -1, true
+			}
+		}
+	}
+
+	last := uint64(1) << (next - start)
+	states := uint64(1)
+	for {
+		if inject && sIndex <= until {
+			states |= 1
+		}
+		for word := states & erotemes; word != 0; word &= word - 1 {
+			bit := (word & -word) << 1
+			states |= bit
+			word |= bit & erotemes
+		}
+
+		if states&last != 0 && (next < len(pattern) || sIndex == len(s)) {
+			return next, sIndex, true
+		}
+		if sIndex == len(s) {
+			return next, -1, true
+		}
+
+		c := s[sIndex]
+		switch {
+		case states == 1 && literals&1 != 0 && inject:
+			// Until the part starts, skip to a character that can start it.
+			for c != pattern[start] && int(c) != separator {
+				if sIndex++; sIndex == len(s) || sIndex > until {
+					return next, -1, true
+				}
+				c = s[sIndex]
 			}
+		case states&(states-1) == 0 && literals&states != 0 && (!inject || sIndex >= until):
+			// A single state reads its literals at once.
+			k := bits.TrailingZeros64(states)
+			for literals&(1<<k) != 0 && sIndex < len(s) && pattern[start+k] == s[sIndex] {
+				k++
+				sIndex++
+			}
+			if literals&(1<<k) != 0 && sIndex < len(s) {
+				return next, -1, true
+			}
+			states = 1 << k
+			continue
+		}
+
+		if int(c) == separator {
+			inject = false
+		}
 
-			if star != -1 {
-				patternIndex = star + 1
-				lastStar++
-				sIndex = lastStar
-				goto Loop
+		var to uint64
+		for word := states &^ last; word != 0; word &= word - 1 {
+			k := bits.TrailingZeros64(word)
+			if literals&(1<<k) != 0 {
+				if pattern[start+k] == c {
+					to |= 1 << (k + 1)
+				}
+			} else if (erotemes|dots)&(1<<k) != 0 {
+				if int(c) != separator {
+					to |= 1 << (k + 1)
+				}
+			} else if i := matchByRunesStep(pattern, start+k, rune(c), nil, separator, fold); i != -1 {
+				to |= 1 << (i - start)
 			}
+		}
+		states = to
+		sIndex++
+		if states == 0 && !inject {
+			return next, -1, true
+		}
+	}
+}
+
+// matchByRunesResume matches the rest of the pattern with matchByRunesStates, from the
+// pattern[patternIndex] reached at s[sIndex], or from the last star if there is one.
+func matchByRunesResume(pattern, s []rune, patternIndex, sIndex, star, lastStar, separator int, fold bool) bool {
+	if star != -1 {
+		return matchByRunesStates(pattern, s, star, lastStar, separator, fold)
+	}
+
+	return matchByRunesStates(pattern, s, patternIndex, sIndex, separator, fold)
+}
+
+// matchByRunesStates reports whether pattern[state:] matches s[sIndex:], by simulating
+// at once all the ways to match it. The state i means that the characters read so far
+// matched up to pattern[i], and the states are kept in a bitset. For every character,
+// each state steps to at most one state, then the states reached are followed through
+// the wildcards which can match nothing and the group delimiters, always forward
+// in the pattern. Both take O(len(pattern)), hence O(len(pattern) × len(s)) overall.
+func matchByRunesStates(pattern, s []rune, state, sIndex, separator int, fold bool) bool {
+	// The buffers keep the patterns up to 255 characters on the stack.
+	var setsBuf [8]uint64
+	var linksBuf [512]int32
+
+	words := len(pattern)/64 + 1
+	sets := setsBuf[:]
+	if 2*words > len(sets) {
+		sets = make([]uint64, 2*words)
+	}
+	current, next := sets[:words], sets[words:2*words]
 
+	var links []int32
+	for i := range pattern {
+		if pattern[i] == '{' {
+			links = matchByRunesLinks(pattern, linksBuf[:])
+			break
+		}
+	}
+
+	current[state/64] = 1 << (state % 64)
+	matchByRunesFollow(pattern, current, links, separator)
+	for ; sIndex < len(s); sIndex++ {
+		alive := false
+		for w := range current {
+			for word := current[w]; word != 0; word &= word - 1 {
+				i := w*64 + bits.TrailingZeros64(word)
+				if to := matchByRunesStep(pattern, i, rune(s[sIndex]), links, separator, fold); to != -1 {
+					next[to/64] |= 1 << (to % 64)
+					alive = true
+				}
+			}
+			current[w] = 0
+		}
+		if !alive {
 			return false
 		}
 
-		// If the characters match, check if it was not the same to validate the eroteme.
-		if eroteme != -1 && lastErotemeCluster != rune(s[sIndex]) {
-			eroteme = -1
+		matchByRunesFollow(pattern, next, links, separator)
+		current, next = next, current
+	}
+
+	end := len(pattern)
+	return current[end/64]&(1<<(end%64)) != 0
+}
+
+// matchByRunesStep returns the state following the state i with the character c,
+// or -1 if c can't be matched there. Group delimiters and unclosed groups never match.
+func matchByRunesStep(pattern []rune, i int, c rune, links []int32, separator int, fold bool) int {
+	if i == len(pattern) {
+		return -1
+	}
+
+	switch pattern[i] {
+	case '*':
+		switch matchByRunesGlobstar(pattern, i, separator) {
+		case i + 2:
+			// A trailing "**" matches everything.
+			return i
+		case i + 3:
+			// "**/" is at a segment boundary, where a separator ends an empty segment.
+			// The second '*' is the state inside a segment.
+			if int(c) == separator {
+				return i
+			}
+			return i + 1
+		}
+
+		if int(c) != separator {
+			return i
+		}
+		if i > 0 && pattern[i-1] == '*' && matchByRunesGlobstar(pattern, i-1, separator) == i+2 {
+			return i - 1
+		}
+	case '?', '.':
+		if int(c) != separator {
+			return i + 1
+		}
+	case '[':
+		end, matched := matchByRunesClass(pattern, i, c, fold)
+		if end != -1 && matched && int(c) != separator {
+			return end + 1
+		}
+	case '{':
+	default:
+		if links != nil && links[2*i+1] > 0 {
+			return -1
+		}
+
+		// '\' makes the next character a literal, a lone escape at the end never matches.
+		next := i + 1
+		if pattern[i] == '\\' {
+			if next == len(pattern) {
+				return -1
+			}
+			i++
+			next++
+		}
+		if rune(pattern[i]) == c || fold && matchByRunesFold(rune(pattern[i]), c) {
+			return next
+		}
+	}
+
+	return -1
+}
+
+// matchByRunesFollow adds to states the states reached from them without a character:
+// after a star, a '?' or a globstar, at the start of every alternative of a group,
+// and after the group at the end of an alternative.
+// All of them are after the state in the pattern, so a single forward pass is enough.
+func matchByRunesFollow(pattern []rune, states []uint64, links []int32, separator int) {
+	add := func(i int) {
+		states[i/64] |= 1 << (i % 64)
+	}
+
+	for w := range states {
+		for word := states[w]; word != 0; {
+			bit := bits.TrailingZeros64(word)
+			if i := w*64 + bit; i < len(pattern) {
+				switch pattern[i] {
+				case '*':
+					if next := matchByRunesGlobstar(pattern, i, separator); next != -1 {
+						add(next)
+					} else {
+						add(i + 1)
+					}
+				case '?':
+					add(i + 1)
+				case '{':
+					if links[2*i+1] != -1 {
+						add(i + 1)
+						for d := int(links[2*i]); pattern[d] != '}'; d = int(links[2*d]) {
+							add(d + 1)
+						}
+					}
+				case ',', '}':
+					if links != nil && links[2*i+1] > 0 {
+						add(int(links[2*i+1]) + 1)
+					}
+				}
+			}
+
+			// The states added in this word are seen, as they are after i.
+			word = states[w] &^ (1<<(bit+1) - 1)
+		}
+	}
+}
+
+// matchByRunesLinks returns for the groups of the pattern two links per character,
+// using buf when it is large enough. For a '{' or a ',' ending an alternative,
+// links[2*i] is the index of the ',' or '}' ending the next alternative.
+// For a '{', a ',' or a '}' of a group, links[2*i+1] is the index of the '}'
+// closing the group, or -1 for a '{' without one. The other links are 0.
+func matchByRunesLinks(pattern []rune, buf []int32) []int32 {
+	links := buf
+	if 2*len(pattern) > len(links) {
+		links = make([]int32, 2*len(pattern))
+	}
+	links = links[:2*len(pattern)]
+	for i := range links {
+		links[i] = 0
+	}
+
+	// While a group is open, links[2*open+1] is its last delimiter.
+	var stack [16]int32
+	groups := stack[:0]
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '[':
+			if end, _, _ := matchByRunesSet(pattern, i, 0); end != -1 {
+				i = end
+			}
+		case '{':
+			groups = append(groups, int32(i))
+			links[2*i+1] = int32(i)
+		case ',', '}':
+			if len(groups) == 0 {
+				break
+			}
+
+			open := groups[len(groups)-1]
+			links[2*links[2*open+1]] = int32(i)
+			links[2*open+1] = int32(i)
+			if pattern[i] == ',' {
+				break
+			}
+
+			groups = groups[:len(groups)-1]
+			for d := open; ; d = links[2*d] {
+				links[2*d+1] = int32(i)
+				if d == int32(i) {
+					break
+				}
+			}
+		}
+	}
+
+	for _, open := range groups {
+		links[2*open+1] = -1
+	}
+
+	return links
+}
+
+// matchByRunesGlobstar returns the index following the "**" at pattern[i] and its
+// separator when it is a whole path segment, or -1 if it is not a globstar.
+func matchByRunesGlobstar(pattern []rune, i, separator int) int {
+	if separator == -1 || i+1 >= len(pattern) || pattern[i+1] != '*' ||
+		(i > 0 && int(pattern[i-1]) != separator) {
+		return -1
+	}
+
+	switch {
+	case i+2 == len(pattern):
+		return i + 2
+	case int(pattern[i+2]) == separator:
+		return i + 3
+	}
+
+	return -1
+}
+
+// matchByRunesClose returns the index of the '}' closing the group starting
+// at pattern[start], or -1 if the group is not closed.
+func matchByRunesClose(pattern []rune, start int) int {
+	i := start
+	for i != -1 && (i == start || pattern[i] != '}') {
+		i = matchByRunesNext(pattern, i+1)
+	}
+
+	return i
+}
+
+// matchByRunesNext returns the index of the first ',' or '}' from pattern[i] that
+// belongs to the current group, skipping escapes, bracket expressions and nested groups.
+// It returns -1 if there is none.
+func matchByRunesNext(pattern []rune, i int) int {
+	for i < len(pattern) {
+		switch pattern[i] {
+		case ',', '}':
+			return i
+		case '\\':
+			i += 2
+		case '[':
+			if end, _, _ := matchByRunesSet(pattern, i, 0); end != -1 {
+				i = end
+			}
+			i++
+		case '{':
+			i = matchByRunesClose(pattern, i)
+			if i == -1 {
+				return -1
+			}
+			i++
+		default:
+			i++
 		}
 	}
 
-	patternIndex++
-	sIndex++
-	goto Loop
+	return -1
+}
+
+// matchByRunesClass matches c against the bracket expression starting at pattern[start].
+// It supports ranges, negation with '!' or '^' and POSIX named classes like "[:alpha:]".
+// If fold is set, c is in the class if one of its case foldings is.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func matchByRunesClass(pattern []rune, start int, c rune, fold bool) (int, bool) {
+	end, in, negate := matchByRunesSet(pattern, start, c)
+	if fold && !in && end != -1 {
+		for r := unicode.SimpleFold(rune(c)); r != rune(c) && !in; r = unicode.SimpleFold(r) {
+			if r <= unicode.MaxRune {
+				_, in, _ = matchByRunesSet(pattern, start, rune(r))
+			}
+		}
+	}
+
+	return end, in != negate
+}
+
+// matchByRunesSet reports whether c is in the set of the bracket expression starting
+// at pattern[start], and if the expression is negated.
+// It returns the index of the closing ']', or -1 if the expression is malformed.
+func matchByRunesSet(pattern []rune, start int, c rune) (int, bool, bool) {
+	var matched, negate bool
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
+	}
+
+	// A ']' right after the opening bracket is a literal.
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return i, matched, negate
+		}
+
+		if pattern[i] == '[' && i+1 < len(pattern) && pattern[i+1] == ':' {
+			end := i + 2
+			for end+1 < len(pattern) && (pattern[end] != ':' || pattern[end+1] != ']') {
+				end++
+			}
+			if end+1 >= len(pattern) {
+				return -1, false, false
+			}
+
+			in, ok := matchByRunesNamed(pattern[i+2:end], c)
+			if !ok {
+				return -1, false, false
+			}
+			matched = matched || in
+			i = end + 2
+			continue
+		}
 
-	// Check if the remaining pattern characters are '*' or '?', which can match the end of the string.
-checkPattern:
-	if patternIndex < patternLen {
-		if pattern[patternIndex] == '*' {
-			patternIndex++
-			goto checkPattern
-		} else if pattern[patternIndex] == '?' {
-			if sIndex >= sLen {
-				sIndex--
+		lo, next := matchByRunesClassChar(pattern, i)
+		if next == -1 {
+			return -1, false, false
+		}
+		hi := lo
+		if next+1 < len(pattern) && pattern[next] == '-' && pattern[next+1] != ']' {
+			hi, next = matchByRunesClassChar(pattern, next+1)
+			if next == -1 || hi < lo {
+				return -1, false, false
 			}
-			patternIndex++
-			goto checkPattern
 		}
+
+		matched = matched || (lo <= c && c <= hi)
+		i = next
+	}
+
+	return -1, false, false
+}
+
+// matchByRunesFold reports whether a and b are equal under simple case folding.
+// Only ASCII letters are folded with byte comparison.
+func matchByRunesFold(a, b rune) bool {
+	if rune(a) <= unicode.MaxASCII && rune(b) <= unicode.MaxASCII {
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		return a == b
+	}
+	if rune(a) > unicode.MaxRune || rune(b) > unicode.MaxRune {
+		return false
+	}
+
+	for r := unicode.SimpleFold(rune(a)); r != rune(a); r = unicode.SimpleFold(r) {
+		if r == rune(b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchByRunesClassChar returns the possibly escaped character at pattern[i]
+// and the index following it, or -1 if the escape is the last character.
+func matchByRunesClassChar(pattern []rune, i int) (rune, int) {
+	if pattern[i] == '\\' {
+		i++
+		if i >= len(pattern) {
+			return 0, -1
+		}
+	}
+
+	return rune(pattern[i]), i + 1
+}
+
+// matchByRunesNamed reports whether c is in the POSIX class called name,
+// and false for ok if there is no such class.
+// Characters above unicode.MaxRune are never part of a named class.
+func matchByRunesNamed(name []rune, c rune) (matched, ok bool) {
+	var buf [6]byte
+	if len(name) > len(buf) {
+		return false, false
+	}
+	for i := range name {
+		if name[i] > unicode.MaxASCII {
+			return false, false
+		}
+		buf[i] = byte(name[i])
+	}
+
+	r := rune(c)
+	in := r <= unicode.MaxRune
+	switch string(buf[:len(name)]) {
+	case "alnum":
+		return in && (unicode.IsLetter(r) || unicode.IsDigit(r)), true
+	case "alpha":
+		return in && unicode.IsLetter(r), true
+	case "blank":
+		return in && (r == '\t' || unicode.Is(unicode.Zs, r)), true
+	case "cntrl":
+		return in && unicode.IsControl(r), true
+	case "digit":
+		return in && unicode.IsDigit(r), true
+	case "graph":
+		return in && unicode.IsGraphic(r) && !unicode.IsSpace(r), true
+	case "lower":
+		return in && unicode.IsLower(r), true
+	case "print":
+		return in && unicode.IsPrint(r), true
+	case "punct":
+		return in && (unicode.IsPunct(r) || unicode.IsSymbol(r)), true
+	case "space":
+		return in && unicode.IsSpace(r), true
+	case "upper":
+		return in && unicode.IsUpper(r), true
+	case "xdigit":
+		return in && (r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'), true
 	}
 
-	return patternIndex == patternLen
+	return false, false
 }
 
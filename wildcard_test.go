@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2025 Iglou.eu <contact@iglou.eu>
+ * Copyright (c) 2025 Adrien Kara <adrien@iglou.eu>
+ *
+ * Licensed under the BSD 3-Clause License,
+ * see LICENSE.md for more details.
+ */
+
+package wildcard
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestMatchByRuneClass validates that bracket expressions
+// compare full runes and use Unicode for named classes
+func TestMatchByRuneClass(t *testing.T) {
+	cases := []struct {
+		s       string
+		pattern string
+		result  bool
+	}{
+		{"é", "[é]", true},
+		{"é", "[a-z]", false},
+		{"é", "[à-ÿ]", true},
+		{"é", "[!à-ÿ]", false},
+		{"日本", "[一-龥][一-龥]", true},
+		{"é", "[[:alpha:]]", true},
+		{"É", "[[:upper:]]", true},
+		{"٣", "[[:digit:]]", true},
+		{"🤷", "[[:alpha:]]", false},
+		{"🤷", "[!a-z]", true},
+	}
+
+	for i, c := range cases {
+		if result := MatchByRune(c.pattern, c.s); c.result != result {
+			t.Errorf("Test %d: Expected `%v`, found `%v`; With Pattern: `%s` and String: `%s`", i+1, c.result, result, c.pattern, c.s)
+		}
+	}
+
+	// In byte mode, the members of a class are bytes
+	if Match("[é]", "é") || !Match("[é][é]", "é") {
+		t.Errorf("Expected byte comparison for Match with Pattern: `[é]`")
+	}
+}
+
+// TestExpand validates the literal expansion of brace groups
+func TestExpand(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		expanded []string
+	}{
+		{"", []string{""}},
+		{"no group", []string{"no group"}},
+		{"logs/{app,worker,cron}-*.log", []string{"logs/app-*.log", "logs/worker-*.log", "logs/cron-*.log"}},
+		{"{a,b}{1,2}", []string{"a1", "a2", "b1", "b2"}},
+		{"{a,b{1,2}}c", []string{"ac", "b1c", "b2c"}},
+		{"{a,}", []string{"a", ""}},
+		{"{*.go,[a-z]?}", []string{"*.go", "[a-z]?"}},
+		{`\{a,b}`, []string{`\{a,b}`}},
+		{"[{]{a,b}", []string{"[{]a", "[{]b"}},
+		{`{a\,b,c}`, []string{`a\,b`, "c"}},
+		{"{a,b", []string{"{a,b"}},
+		{"{a,b}{c", []string{"a{c", "b{c"}},
+	}
+
+	for i, c := range cases {
+		expanded := Expand(c.pattern)
+		if len(expanded) != len(c.expanded) {
+			t.Errorf("Test %d: Expected `%q`, found `%q`", i+1, c.expanded, expanded)
+			continue
+		}
+		for j := range expanded {
+			if expanded[j] != c.expanded[j] {
+				t.Errorf("Test %d: Expected `%q`, found `%q`", i+1, c.expanded, expanded)
+				break
+			}
+		}
+	}
+}
+
+// TestEscape validates that an escaped string is a pattern
+// matching exactly itself, whatever the matching mode
+func TestEscape(t *testing.T) {
+	cases := []struct {
+		s       string
+		escaped string
+	}{
+		{"", ""},
+		{"no special character", "no special character"},
+		{"api.example.com", `api\.example\.com`},
+		{"*?.", `\*\?\.`},
+		{"[a-z]", `\[a-z]`},
+		{"{a,b}", `\{a\,b\}`},
+		{`C:\Windows`, `C:\\Windows`},
+		{"🤷🏾‍♂️.*", `🤷🏾‍♂️\.\*`},
+	}
+
+	for i, c := range cases {
+		escaped := Escape(c.s)
+		if escaped != c.escaped {
+			t.Errorf("Test %d: Expected `%s`, found `%s`", i+1, c.escaped, escaped)
+		}
+
+		if !Match(escaped, c.s) || !MatchByRune(escaped, c.s) || !MatchFromByte([]byte(escaped), []byte(c.s)) {
+			t.Errorf("Test %d: Escaped pattern `%s` does not match `%s`", i+1, escaped, c.s)
+		}
+		if c.s != "" && Match(escaped, c.s+"x") {
+			t.Errorf("Test %d: Escaped pattern `%s` matches `%s`", i+1, escaped, c.s+"x")
+		}
+	}
+}
+
+// TestLiteralSelf validates that every byte of the pattern is read,
+// at every offset of the eight bytes words
+func TestLiteralSelf(t *testing.T) {
+	for c := 0; c < 256; c++ {
+		for i := 0; i < 20; i++ {
+			pattern := []byte(strings.Repeat("z", 20))
+			pattern[i] = byte(c)
+
+			expected := c != '\\' && c != '[' && c != '{'
+			if literalSelf(string(pattern)) != expected || literalSelf(pattern) != expected {
+				t.Errorf("Byte %#x at %d: Expected %v", c, i, expected)
+			}
+		}
+	}
+}
+
+func FuzzEscape(f *testing.F) {
+	f.Add(`a*b?c.d\e`)
+	f.Fuzz(func(t *testing.T, s string) {
+		if !Match(Escape(s), s) {
+			t.Fatalf("Escape(%q) does not match %q", s, s)
+		}
+	})
+}
+
+// FuzzMatchProgram validates that the matching agrees with the
+// instructions compiled for MatchSubmatch, which try every way to match
+func FuzzMatchProgram(f *testing.F) {
+	f.Add("{a,b*}?[0-9].c", "bxx1.c")
+	f.Add("src/**/*.go", "src/a/b/c.go")
+	f.Fuzz(func(t *testing.T, pattern, s string) {
+		for _, separator := range []int{-1, '/'} {
+			p := compileProgram(pattern, separator, false)
+			want := p.match(s, make([]int, 2*p.captures))
+			if result := matchByString(pattern, s, separator, false); result != want {
+				t.Fatalf("Pattern %q with separator %d: Expected `%v`, found `%v` for %q", pattern, separator, want, result, s)
+			}
+		}
+	})
+}